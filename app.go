@@ -18,6 +18,8 @@ type App struct {
 	dbFileService *services.DatabaseFileService
 	menuService   *services.MenuService
 	*handlers.Handlers
+
+	milestoneService *services.MilestoneService // Keeps the last milestone forecasts of the current database
 }
 
 // NewApp creates a new App application struct
@@ -49,6 +51,7 @@ func (a *App) startup(ctx context.Context, dbFileService *services.DatabaseFileS
 
 	// Set up callback to re-wire handlers when database changes
 	services.SetOnDBChanged(dbFileService, func(newDB *gorm.DB) {
+		a.milestoneService.ResetForecasts()
 		a.wireHandlers(ctx, newDB)
 	})
 }
@@ -109,25 +112,101 @@ func (a *App) wireHandlers(ctx context.Context, db *gorm.DB) {
 	hrHandler := handlers.NewHumanResourceHandler(ctx, hrService)
 
 	projectRepo := repositories.NewProjectRepository(db)
-	projectService := services.NewProjectService(projectRepo)
-	projectHandler := handlers.NewProjectHandler(ctx, projectService)
-
 	projectResourceRepo := repositories.NewProjectResourceRepository(db)
-	projectResourceService := services.NewProjectResourceService(projectResourceRepo)
-	projectResourceHandler := handlers.NewProjectResourceHandler(ctx, projectResourceService)
-
 	projectRoleRepo := repositories.NewProjectRoleRepository(db)
-	projectRoleService := services.NewProjectRoleService(projectRoleRepo)
-	projectRoleHandler := handlers.NewProjectRoleHandler(ctx, projectRoleService)
+	taskRepo := repositories.NewTaskRepository(db)
+	taskAssignmentRepo := repositories.NewTaskAssignmentRepository(db)
+	calendarRepo := repositories.NewCalendarRepository(db)
 
+	// Milestone forecasts are refreshed by the handlers that change the plan of a project
 	milestoneRepo := repositories.NewMilestoneRepository(db)
-	milestoneService := services.NewMilestoneService(milestoneRepo)
+	milestoneService := services.NewMilestoneService(milestoneRepo, projectRepo, taskRepo, projectResourceRepo, taskAssignmentRepo, calendarRepo)
 	milestoneHandler := handlers.NewMilestoneHandler(ctx, milestoneService)
+	a.milestoneService = milestoneService
 
-	taskRepo := repositories.NewTaskRepository(db)
-	taskService := services.NewTaskService(taskRepo)
-	taskHandler := handlers.NewTaskHandler(ctx, taskService)
+	// Labor costs are saved again by the handlers that change allocations, rates or calendars
+	rateCardRepo := repositories.NewRateCardRepository(db)
+	rateCardService := services.NewRateCardService(rateCardRepo, projectRepo, hrRepo, projectResourceRepo, projectRoleRepo)
+	laborCostService := services.NewLaborCostService(projectRepo, projectResourceRepo, hrRepo, calendarRepo, taskRepo, milestoneRepo, taskAssignmentRepo, rateCardService)
+	rateCardHandler := handlers.NewRateCardHandler(ctx, rateCardService, laborCostService)
+
+	projectResourceService := services.NewProjectResourceService(projectResourceRepo, projectRepo)
+	projectResourceHandler := handlers.NewProjectResourceHandler(ctx, projectResourceService, milestoneService, laborCostService)
+
+	projectRoleService := services.NewProjectRoleService(projectRoleRepo)
+	projectRoleHandler := handlers.NewProjectRoleHandler(ctx, projectRoleService)
+
+	taskDependencyRepo := repositories.NewTaskDependencyRepository(db)
+	taskService := services.NewTaskService(taskRepo, projectRepo, taskDependencyRepo)
+	taskHandler := handlers.NewTaskHandler(ctx, taskService, milestoneService)
+
+	taskDependencyService := services.NewTaskDependencyService(taskDependencyRepo, taskRepo)
+	taskDependencyHandler := handlers.NewTaskDependencyHandler(ctx, taskDependencyService, milestoneService)
+
+	taskAssignmentService := services.NewTaskAssignmentService(taskAssignmentRepo, taskRepo, projectResourceRepo)
+	taskAssignmentHandler := handlers.NewTaskAssignmentHandler(ctx, taskAssignmentService, milestoneService)
+
+	calendarExceptionRepo := repositories.NewCalendarExceptionRepository(db)
+	calendarService := services.NewCalendarService(calendarRepo, calendarExceptionRepo, projectRepo)
+	calendarHandler := handlers.NewCalendarHandler(ctx, calendarService, laborCostService)
+
+	schedulingService := services.NewSchedulingService(projectRepo, taskRepo, taskDependencyRepo, calendarRepo)
+	schedulingHandler := handlers.NewSchedulingHandler(ctx, schedulingService)
+
+	levelingService := services.NewLevelingService(projectRepo, taskRepo, taskDependencyRepo, calendarRepo, projectResourceRepo, taskAssignmentService)
+	levelingHandler := handlers.NewLevelingHandler(ctx, levelingService)
+
+	timeEntryRepo := repositories.NewTimeEntryRepository(db)
+	timeEntryService := services.NewTimeEntryService(timeEntryRepo, projectRepo, taskRepo, hrRepo)
+	timeEntryHandler := handlers.NewTimeEntryHandler(ctx, timeEntryService)
+
+	rollupService := services.NewRollupService(projectRepo, taskRepo, milestoneRepo, timeEntryRepo)
+	rollupHandler := handlers.NewRollupHandler(ctx, rollupService)
+
+	scenarioRepo := repositories.NewScenarioRepository(db)
+	scenarioService := services.NewScenarioService(scenarioRepo, projectRepo, taskRepo, taskDependencyRepo, calendarRepo, milestoneRepo, projectResourceRepo, taskAssignmentRepo)
+	scenarioHandler := handlers.NewScenarioHandler(ctx, scenarioService)
+
+	simulationService := services.NewSimulationService(projectRepo, taskRepo, taskDependencyRepo, calendarRepo, milestoneRepo, projectResourceRepo, taskAssignmentRepo)
+	simulationHandler := handlers.NewSimulationHandler(ctx, simulationService)
+
+	taskRoleEstimateRepo := repositories.NewTaskRoleEstimateRepository(db)
+	taskRoleEstimateService := services.NewTaskRoleEstimateService(taskRoleEstimateRepo, projectRepo, taskRepo, taskDependencyRepo, calendarRepo, projectRoleRepo)
+	taskRoleEstimateHandler := handlers.NewTaskRoleEstimateHandler(ctx, taskRoleEstimateService)
+
+	calibrationService := services.NewCalibrationService(projectRepo, taskRepo, timeEntryRepo, taskAssignmentRepo, hrRepo, clientRepo, scenarioRepo)
+	calibrationHandler := handlers.NewCalibrationHandler(ctx, calibrationService)
+
+	wbsTemplateRepo := repositories.NewWBSTemplateRepository(db)
+	wbsTemplateService := services.NewWBSTemplateService(wbsTemplateRepo, projectRepo, taskRepo, milestoneRepo, projectRoleRepo)
+	wbsTemplateHandler := handlers.NewWBSTemplateHandler(ctx, wbsTemplateService)
+
+	bufferRepo := repositories.NewBufferRepository(db)
+	bufferService := services.NewBufferService(bufferRepo, projectRepo, taskRepo, taskDependencyRepo, calendarRepo, milestoneRepo, projectResourceRepo, rollupService)
+	bufferHandler := handlers.NewBufferHandler(ctx, bufferService)
+
+	sprintRepo := repositories.NewSprintRepository(db)
+	sprintService := services.NewSprintService(sprintRepo, projectRepo, taskRepo)
+	sprintHandler := handlers.NewSprintHandler(ctx, sprintService)
+
+	sizeMappingRepo := repositories.NewSizeMappingRepository(db)
+	sizingService := services.NewSizingService(sizeMappingRepo, taskRepo, projectRoleRepo, taskRoleEstimateRepo)
+	sizingHandler := handlers.NewSizingHandler(ctx, sizingService)
+
+	costItemRepo := repositories.NewCostItemRepository(db)
+	costItemService := services.NewCostItemService(costItemRepo, projectRepo, milestoneRepo, taskRepo, laborCostService)
+	costItemHandler := handlers.NewCostItemHandler(ctx, costItemService)
+
+	earnedValueService := services.NewEarnedValueService(projectRepo, milestoneRepo, taskRepo, taskAssignmentRepo, timeEntryRepo, calendarRepo, rateCardService)
+	earnedValueHandler := handlers.NewEarnedValueHandler(ctx, earnedValueService)
+
+	exchangeRateRepo := repositories.NewExchangeRateRepository(db)
+	exchangeRateService := services.NewExchangeRateService(exchangeRateRepo, projectRepo, costItemService)
+	exchangeRateHandler := handlers.NewExchangeRateHandler(ctx, exchangeRateService)
+
+	projectService := services.NewProjectService(projectRepo, laborCostService, costItemService)
+	projectHandler := handlers.NewProjectHandler(ctx, projectService, laborCostService)
 
 	// Update handlers container with new handlers
-	a.Handlers = handlers.NewHandlers(clientHandler, hrHandler, projectHandler, projectResourceHandler, projectRoleHandler, milestoneHandler, taskHandler)
+	a.Handlers = handlers.NewHandlers(clientHandler, hrHandler, projectHandler, projectResourceHandler, projectRoleHandler, milestoneHandler, taskHandler, taskDependencyHandler, taskAssignmentHandler, schedulingHandler, calendarHandler, levelingHandler, rollupHandler, scenarioHandler, simulationHandler, taskRoleEstimateHandler, timeEntryHandler, calibrationHandler, wbsTemplateHandler, bufferHandler, sprintHandler, sizingHandler, rateCardHandler, costItemHandler, earnedValueHandler, exchangeRateHandler)
 }
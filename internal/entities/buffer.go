@@ -0,0 +1,276 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BufferKind tells what a buffer is held for
+type BufferKind string
+
+// Buffer kinds
+const (
+	BufferKindContingency       BufferKind = "contingency"        // For identified risks; consumed as actuals exceed estimates
+	BufferKindManagementReserve BufferKind = "management_reserve" // For unforeseen work; released by management
+)
+
+// BufferBasis tells how a buffer is sized
+type BufferBasis string
+
+// Buffer bases
+const (
+	BufferBasisPercent BufferBasis = "percent" // Percentage of the base estimate
+	BufferBasisHours   BufferBasis = "hours"   // Fixed working hours
+	BufferBasisMoney   BufferBasis = "money"   // Fixed amount in the project currency
+)
+
+// BufferZone is the fever chart zone of a buffer
+type BufferZone string
+
+// Buffer zones
+const (
+	BufferZoneGreen  BufferZone = "green"  // Buffer consumed no faster than the work is completed
+	BufferZoneYellow BufferZone = "yellow" // Consumption ahead of completion; plan a recovery
+	BufferZoneRed    BufferZone = "red"    // Consumption far ahead of completion, or the buffer is overdrawn; act now
+)
+
+// BufferYellowMargin is how many percentage points buffer consumption may run ahead of completion
+// before a buffer turns red
+const BufferYellowMargin = 20.0
+
+var (
+	ErrBufferInvalidProjectID  = errors.New("buffer must belong to a project")
+	ErrBufferInvalidKind       = errors.New("buffer kind must be contingency or management_reserve")
+	ErrBufferInvalidBasis      = errors.New("buffer basis must be percent, hours or money")
+	ErrBufferInvalidValue      = errors.New("buffer value must be non-negative")
+	ErrBufferDuplicate         = errors.New("project or milestone already has a buffer of this kind")
+	ErrBufferMilestoneMismatch = errors.New("milestone belongs to a different project")
+
+	BufferAllowedSortField = map[string]string{
+		"id":           "id",
+		"project_id":   "project_id",
+		"milestone_id": "milestone_id",
+		"kind":         "kind",
+		"basis":        "basis",
+		"value":        "value",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+	}
+)
+
+// IsValidBufferKind checks if the buffer kind is valid
+func IsValidBufferKind(k BufferKind) bool {
+	switch k {
+	case BufferKindContingency, BufferKindManagementReserve:
+		return true
+	}
+	return false
+}
+
+// IsValidBufferBasis checks if the buffer basis is valid
+func IsValidBufferBasis(b BufferBasis) bool {
+	switch b {
+	case BufferBasisPercent, BufferBasisHours, BufferBasisMoney:
+		return true
+	}
+	return false
+}
+
+// Buffer is a contingency or management reserve held on top of the base estimate of a project or
+// one of its milestones
+type Buffer struct {
+	ID          uint        `gorm:"primary_key" json:"id"`
+	ProjectID   uint        `gorm:"not null;index" json:"project_id"`
+	MilestoneID *uint       `gorm:"index" json:"milestone_id"` // Nil for a buffer on the whole project
+	Kind        BufferKind  `gorm:"not null" json:"kind"`
+	Basis       BufferBasis `gorm:"not null" json:"basis"`
+	Value       float64     `gorm:"not null;default:0" json:"value"` // Percent, hours or money depending on the basis
+	Notes       string      `gorm:"type:text" json:"notes"`
+	CreatedAt   time.Time   `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time   `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Project   *Project   `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	Milestone *Milestone `gorm:"foreignKey:MilestoneID;constraint:OnDelete:CASCADE" json:"milestone,omitempty"`
+}
+
+// TableName returns the table name for the buffer entity
+func (Buffer) TableName() string {
+	return "buffers"
+}
+
+// Ratio returns the size of the buffer relative to a base estimate of effort hours and cost.
+// A fixed buffer on a base of 0 has no ratio.
+func (b *Buffer) Ratio(baseEffort, baseCost float64) float64 {
+	switch b.Basis {
+	case BufferBasisPercent:
+		return b.Value / 100
+	case BufferBasisHours:
+		if baseEffort > 0 {
+			return b.Value / baseEffort
+		}
+	case BufferBasisMoney:
+		if baseCost > 0 {
+			return b.Value / baseCost
+		}
+	}
+	return 0
+}
+
+// Amounts returns the effort hours and cost the buffer adds to a base estimate. A fixed buffer
+// keeps its own amount and takes the other in proportion to the base.
+func (b *Buffer) Amounts(baseEffort, baseCost float64) (effort, cost float64) {
+	ratio := b.Ratio(baseEffort, baseCost)
+	effort, cost = ratio*baseEffort, ratio*baseCost
+	switch b.Basis {
+	case BufferBasisHours:
+		effort = b.Value
+	case BufferBasisMoney:
+		cost = b.Value
+	}
+	return effort, cost
+}
+
+// Validate validates the buffer fields
+func (b *Buffer) Validate() error {
+	// Trim whitespace from string fields
+	b.Notes = strings.TrimSpace(b.Notes)
+
+	// Validate required fields
+	if b.ProjectID == 0 {
+		return ErrBufferInvalidProjectID
+	}
+
+	if !IsValidBufferKind(b.Kind) {
+		return ErrBufferInvalidKind
+	}
+
+	if !IsValidBufferBasis(b.Basis) {
+		return ErrBufferInvalidBasis
+	}
+
+	// Validate value
+	if b.Value < 0 {
+		return ErrBufferInvalidValue
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a buffer
+func (b *Buffer) BeforeCreate(tx *gorm.DB) error {
+	return b.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a buffer
+func (b *Buffer) BeforeUpdate(tx *gorm.DB) error {
+	return b.Validate()
+}
+
+// BufferQueryParams defines query parameters for filtering buffers
+type BufferQueryParams struct {
+	ID_In              []uint       `json:"id_in"`
+	ProjectID          uint         `json:"project_id"`
+	ProjectID_In       []uint       `json:"project_id_in"`
+	MilestoneID        *uint        `json:"milestone_id"`
+	MilestoneID_In     []uint       `json:"milestone_id_in"`
+	MilestoneID_IsNull *bool        `json:"milestone_id_is_null"` // True for project buffers only
+	Kind               BufferKind   `json:"kind"`
+	Kind_In            []BufferKind `json:"kind_in"`
+	CreatedAt_Gte      *time.Time   `json:"created_at_gte"`
+	CreatedAt_Lte      *time.Time   `json:"created_at_lte"`
+	UpdatedAt_Gte      *time.Time   `json:"updated_at_gte"`
+	UpdatedAt_Lte      *time.Time   `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// BufferListResponse represents the response for GetBuffers
+type BufferListResponse struct {
+	Data  []*Buffer `json:"data"`
+	Total int64     `json:"total"`
+}
+
+// BufferFigures splits a total into the base estimate and the buffers held on top of it.
+// The base is the internal figure; the quoted figure includes both buffers.
+type BufferFigures struct {
+	Base              float64 `json:"base"`
+	Contingency       float64 `json:"contingency"`
+	ManagementReserve float64 `json:"management_reserve"`
+	Quoted            float64 `json:"quoted"`
+}
+
+// add adds a buffer amount of the given kind
+func (f *BufferFigures) add(kind BufferKind, amount float64) {
+	switch kind {
+	case BufferKindContingency:
+		f.Contingency += amount
+	case BufferKindManagementReserve:
+		f.ManagementReserve += amount
+	}
+	f.Quoted = f.Base + f.Contingency + f.ManagementReserve
+}
+
+// BufferConsumption is a fever chart point: how much of the contingency the overrun of the actuals
+// over the completed estimate has used, against how much of the work is complete
+type BufferConsumption struct {
+	CompletionPercent float64    `json:"completion_percent"`
+	ConsumedHours     float64    `json:"consumed_hours"`   // Actual hours beyond the estimate of the completed work
+	ConsumedPercent   float64    `json:"consumed_percent"` // Of the contingency hours; above 100 when overdrawn
+	Zone              BufferZone `json:"zone"`
+}
+
+// NewBufferConsumption computes the fever chart point of a piece of work
+func NewBufferConsumption(completedEffort, percentComplete, actualHours, contingencyHours float64) BufferConsumption {
+	c := BufferConsumption{CompletionPercent: percentComplete, ConsumedHours: max(0, actualHours-completedEffort)}
+	switch {
+	case contingencyHours > 0:
+		c.ConsumedPercent = c.ConsumedHours / contingencyHours * 100
+	case c.ConsumedHours > 0:
+		c.ConsumedPercent = 100 // Any overrun uses up a missing buffer
+	}
+	switch {
+	case c.ConsumedHours > contingencyHours || c.ConsumedPercent > c.CompletionPercent+BufferYellowMargin:
+		c.Zone = BufferZoneRed
+	case c.ConsumedPercent > c.CompletionPercent:
+		c.Zone = BufferZoneYellow
+	default:
+		c.Zone = BufferZoneGreen
+	}
+	return c
+}
+
+// BufferTotals are the effort, cost and finish of a project or milestone with and without its buffers
+type BufferTotals struct {
+	Effort            BufferFigures     `json:"effort"`             // Working hours
+	Cost              BufferFigures     `json:"cost"`               // In the project currency
+	BaseFinish        *time.Time        `json:"base_finish"`        // Nil when the project has no start date
+	ContingencyFinish *time.Time        `json:"contingency_finish"` // Base finish pushed back by the contingency
+	QuotedFinish      *time.Time        `json:"quoted_finish"`      // Base finish pushed back by both buffers
+	Consumption       BufferConsumption `json:"consumption"`
+}
+
+// AddBuffer adds the amounts of a buffer to the totals
+func (t *BufferTotals) AddBuffer(b *Buffer) {
+	effort, cost := b.Amounts(t.Effort.Base, t.Cost.Base)
+	t.Effort.add(b.Kind, effort)
+	t.Cost.add(b.Kind, cost)
+}
+
+// MilestoneBufferReport is the buffer report of one milestone
+type MilestoneBufferReport struct {
+	MilestoneID uint   `json:"milestone_id"`
+	Name        string `json:"name"`
+	BufferTotals
+}
+
+// ProjectBufferReport compares the internal figures of a project with the quoted ones, which
+// include the contingency and management reserve of the project and its milestones
+type ProjectBufferReport struct {
+	ProjectID uint   `json:"project_id"`
+	Currency  string `json:"currency"`
+	BufferTotals
+	Milestones []*MilestoneBufferReport `json:"milestones"`
+}
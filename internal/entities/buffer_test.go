@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferTableName(t *testing.T) {
+	buffer := Buffer{}
+	assert.Equal(t, "buffers", buffer.TableName())
+}
+
+func TestBufferValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		buffer    Buffer
+		wantError error
+	}{
+		{
+			name:      "Valid percent contingency",
+			buffer:    Buffer{ProjectID: 1, Kind: BufferKindContingency, Basis: BufferBasisPercent, Value: 15},
+			wantError: nil,
+		},
+		{
+			name:      "Valid money reserve",
+			buffer:    Buffer{ProjectID: 1, Kind: BufferKindManagementReserve, Basis: BufferBasisMoney, Value: 5000},
+			wantError: nil,
+		},
+		{
+			name:      "Missing project",
+			buffer:    Buffer{Kind: BufferKindContingency, Basis: BufferBasisHours, Value: 40},
+			wantError: ErrBufferInvalidProjectID,
+		},
+		{
+			name:      "Unknown kind",
+			buffer:    Buffer{ProjectID: 1, Kind: "risk", Basis: BufferBasisHours, Value: 40},
+			wantError: ErrBufferInvalidKind,
+		},
+		{
+			name:      "Unknown basis",
+			buffer:    Buffer{ProjectID: 1, Kind: BufferKindContingency, Basis: "days", Value: 4},
+			wantError: ErrBufferInvalidBasis,
+		},
+		{
+			name:      "Negative value",
+			buffer:    Buffer{ProjectID: 1, Kind: BufferKindContingency, Basis: BufferBasisPercent, Value: -5},
+			wantError: ErrBufferInvalidValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.buffer.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBufferAmounts(t *testing.T) {
+	tests := []struct {
+		name       string
+		buffer     Buffer
+		wantEffort float64
+		wantCost   float64
+	}{
+		{"Percent of both", Buffer{Basis: BufferBasisPercent, Value: 10}, 20, 1000},
+		{"Fixed hours", Buffer{Basis: BufferBasisHours, Value: 50}, 50, 2500},
+		{"Fixed money", Buffer{Basis: BufferBasisMoney, Value: 2000}, 40, 2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effort, cost := tt.buffer.Amounts(200, 10000)
+			assert.InDelta(t, tt.wantEffort, effort, 1e-9)
+			assert.InDelta(t, tt.wantCost, cost, 1e-9)
+		})
+	}
+
+	t.Run("Fixed buffer on an empty base", func(t *testing.T) {
+		buffer := Buffer{Basis: BufferBasisHours, Value: 16}
+		effort, cost := buffer.Amounts(0, 0)
+		assert.Equal(t, 16.0, effort)
+		assert.Equal(t, 0.0, cost)
+	})
+}
+
+func TestNewBufferConsumption(t *testing.T) {
+	tests := []struct {
+		name         string
+		completed    float64
+		percent      float64
+		actual       float64
+		contingency  float64
+		wantConsumed float64
+		wantZone     BufferZone
+	}{
+		{"Under estimate", 40, 50, 30, 20, 0, BufferZoneGreen},
+		{"Consumption behind completion", 40, 50, 48, 20, 40, BufferZoneGreen},
+		{"Consumption ahead of completion", 40, 50, 52, 20, 60, BufferZoneYellow},
+		{"Consumption far ahead of completion", 40, 50, 56, 20, 80, BufferZoneRed},
+		{"Buffer used up at completion", 80, 100, 100, 20, 100, BufferZoneGreen},
+		{"Buffer overdrawn", 80, 100, 110, 20, 150, BufferZoneRed},
+		{"Overrun without a buffer", 40, 50, 41, 0, 100, BufferZoneRed},
+		{"No overrun without a buffer", 40, 50, 40, 0, 0, BufferZoneGreen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewBufferConsumption(tt.completed, tt.percent, tt.actual, tt.contingency)
+			assert.InDelta(t, tt.wantConsumed, c.ConsumedPercent, 1e-9)
+			assert.Equal(t, tt.wantZone, c.Zone)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultWorkdayStartHour is the hour of the day at which working time begins
+const DefaultWorkdayStartHour = 9
+
+// maxCalendarDays bounds calendar walks so a calendar without working time cannot loop forever
+const maxCalendarDays = 366 * 50
+
+var (
+	ErrCalendarNameRequired       = errors.New("calendar name is required")
+	ErrCalendarInvalidHoursPerDay = errors.New("calendar hours per day must be greater than 0 and at most 24")
+	ErrCalendarInvalidWorkingDays = errors.New("calendar working days must contain unique valid weekdays (Sunday=0 to Saturday=6)")
+
+	CalendarAllowedSortField = map[string]string{
+		"id":            "id",
+		"name":          "name",
+		"hours_per_day": "hours_per_day",
+		"created_at":    "created_at",
+		"updated_at":    "updated_at",
+	}
+)
+
+// Calendar is a named working calendar: a weekly pattern of working days
+// refined by exceptions such as holidays, shutdowns and days with custom hours
+type Calendar struct {
+	ID          uint         `gorm:"primary_key" json:"id"`
+	Name        string       `gorm:"not null;uniqueIndex" json:"name"`
+	Description string       `gorm:"type:text" json:"description"`
+	WorkingDays WeekdayArray `gorm:"type:text" json:"working_days"`
+	HoursPerDay float64      `gorm:"not null;default:8" json:"hours_per_day"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time    `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Exceptions []*CalendarException `gorm:"foreignKey:CalendarID;constraint:OnDelete:CASCADE" json:"exceptions,omitempty"`
+}
+
+// TableName returns the table name for the calendar entity
+func (Calendar) TableName() string {
+	return "calendars"
+}
+
+// Validate validates the calendar fields
+func (c *Calendar) Validate() error {
+	// Trim whitespace from string fields
+	c.Name = strings.TrimSpace(c.Name)
+	c.Description = strings.TrimSpace(c.Description)
+
+	// Validate required fields
+	if c.Name == "" {
+		return ErrCalendarNameRequired
+	}
+
+	if c.HoursPerDay <= 0 || c.HoursPerDay > 24 {
+		return ErrCalendarInvalidHoursPerDay
+	}
+
+	seen := make(map[time.Weekday]bool)
+	for _, day := range c.WorkingDays {
+		if day < time.Sunday || day > time.Saturday || seen[day] {
+			return ErrCalendarInvalidWorkingDays
+		}
+		seen[day] = true
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a calendar
+func (c *Calendar) BeforeCreate(tx *gorm.DB) error {
+	// Set defaults if not set
+	if c.WorkingDays == nil {
+		c.WorkingDays = DefaultWorkingDays()
+	}
+	if c.HoursPerDay == 0 {
+		c.HoursPerDay = DefaultHoursPerDay
+	}
+
+	return c.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a calendar
+func (c *Calendar) BeforeUpdate(tx *gorm.DB) error {
+	return c.Validate()
+}
+
+// HoursOn returns the working hours available on the calendar day of t.
+// A custom hours exception wins over holidays and non-working periods,
+// which in turn win over the weekly pattern.
+func (c *Calendar) HoursOn(t time.Time) float64 {
+	closed := false
+	for _, e := range c.Exceptions {
+		if !e.Covers(t) {
+			continue
+		}
+		if e.Type == CalendarExceptionCustomHours {
+			return e.Hours
+		}
+		closed = true
+	}
+	if closed {
+		return 0
+	}
+	for _, day := range c.WorkingDays {
+		if day == t.Weekday() {
+			return c.HoursPerDay
+		}
+	}
+	return 0
+}
+
+// workingWindow returns the working time of the calendar day of t
+func (c *Calendar) workingWindow(t time.Time) (start, end time.Time) {
+	start = time.Date(t.Year(), t.Month(), t.Day(), DefaultWorkdayStartHour, 0, 0, 0, t.Location())
+	return start, start.Add(HoursToDuration(c.HoursOn(t)))
+}
+
+// AddWorkingHours returns the instant reached after working the given hours from `from`.
+// Landing exactly on the end of a working day returns that end rather than the next
+// morning, which is what a finish date means. Negative hours are treated as zero.
+func (c *Calendar) AddWorkingHours(from time.Time, hours float64) time.Time {
+	hours = max(0, hours)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	cursor := from
+	for i := 0; i < maxCalendarDays; i++ {
+		dayStart, dayEnd := c.workingWindow(day)
+		if dayEnd.After(dayStart) {
+			if cursor.Before(dayStart) {
+				cursor = dayStart
+			}
+			if cursor.Before(dayEnd) {
+				remaining := dayEnd.Sub(cursor).Hours()
+				if hours <= remaining+1e-6 {
+					return cursor.Add(HoursToDuration(hours))
+				}
+				hours -= remaining
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+		cursor = day
+	}
+	return cursor
+}
+
+// NextWorkingTime returns the first instant at or after t from which work can be done.
+// The end of a working day moves to the start of the next one.
+func (c *Calendar) NextWorkingTime(t time.Time) time.Time {
+	return c.AddWorkingHours(t, 0)
+}
+
+// WorkingHoursBetween returns the working hours between two instants.
+// The result is negative when `to` is before `from`.
+func (c *Calendar) WorkingHoursBetween(from, to time.Time) float64 {
+	if to.Before(from) {
+		return -c.WorkingHoursBetween(to, from)
+	}
+	total := 0.0
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	for i := 0; i < maxCalendarDays && day.Before(to); i++ {
+		dayStart, dayEnd := c.workingWindow(day)
+		if from.After(dayStart) {
+			dayStart = from
+		}
+		if to.Before(dayEnd) {
+			dayEnd = to
+		}
+		if dayEnd.After(dayStart) {
+			total += dayEnd.Sub(dayStart).Hours()
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return total
+}
+
+// HoursToDuration converts fractional hours to a duration rounded to the second
+func HoursToDuration(hours float64) time.Duration {
+	return time.Duration(math.Round(hours*3600)) * time.Second
+}
+
+// CalendarQueryParams defines query parameters for filtering calendars
+type CalendarQueryParams struct {
+	ID_In         []uint     `json:"id_in"`
+	Name          string     `json:"name"`
+	Name_Like     string     `json:"name_like"`
+	CreatedAt_Gte *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// CalendarListResponse represents the response for GetCalendars
+type CalendarListResponse struct {
+	Data  []*Calendar `json:"data"`
+	Total int64       `json:"total"`
+}
@@ -0,0 +1,160 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CalendarExceptionType tells how a calendar exception changes working time
+type CalendarExceptionType string
+
+const (
+	CalendarExceptionHoliday     CalendarExceptionType = "holiday"      // Public holiday, no work
+	CalendarExceptionNonWorking  CalendarExceptionType = "non_working"  // Non-working period such as a shutdown, no work
+	CalendarExceptionCustomHours CalendarExceptionType = "custom_hours" // Day(s) with custom working hours, even on a weekend
+)
+
+var (
+	ErrCalendarExceptionInvalidCalendarID = errors.New("calendar exception must belong to a calendar")
+	ErrCalendarExceptionInvalidType       = errors.New("calendar exception type must be holiday, non_working, or custom_hours")
+	ErrCalendarExceptionStartDateRequired = errors.New("calendar exception start date is required")
+	ErrCalendarExceptionInvalidDates      = errors.New("calendar exception end date must be on or after start date")
+	ErrCalendarExceptionInvalidHours      = errors.New("custom hours must be between 0 and 24; holidays and non-working periods have no hours")
+
+	CalendarExceptionAllowedSortField = map[string]string{
+		"id":          "id",
+		"calendar_id": "calendar_id",
+		"name":        "name",
+		"type":        "type",
+		"start_date":  "start_date",
+		"end_date":    "end_date",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+	}
+)
+
+// CalendarException overrides the weekly pattern of a calendar for the days
+// from StartDate to EndDate inclusive. Only the calendar date of both bounds matters.
+type CalendarException struct {
+	ID         uint                  `gorm:"primary_key" json:"id"`
+	CalendarID uint                  `gorm:"not null;index" json:"calendar_id"`
+	Name       string                `gorm:"" json:"name"`
+	Type       CalendarExceptionType `gorm:"not null;default:holiday;index" json:"type"`
+	StartDate  time.Time             `gorm:"not null;index" json:"start_date"`
+	EndDate    time.Time             `gorm:"not null;index" json:"end_date"`
+	Hours      float64               `gorm:"not null;default:0" json:"hours"` // Working hours per day for custom_hours
+	CreatedAt  time.Time             `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt  time.Time             `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Calendar *Calendar `gorm:"foreignKey:CalendarID;constraint:OnDelete:CASCADE" json:"calendar,omitempty"`
+}
+
+// TableName returns the table name for the calendar exception entity
+func (CalendarException) TableName() string {
+	return "calendar_exceptions"
+}
+
+// IsValidCalendarExceptionType checks if the calendar exception type is valid
+func IsValidCalendarExceptionType(t CalendarExceptionType) bool {
+	switch t {
+	case CalendarExceptionHoliday, CalendarExceptionNonWorking, CalendarExceptionCustomHours:
+		return true
+	}
+	return false
+}
+
+// Covers returns true if the calendar day of t falls within the exception
+func (e *CalendarException) Covers(t time.Time) bool {
+	day := DateKey(t)
+	return day >= DateKey(e.StartDate) && day <= DateKey(e.EndDate)
+}
+
+// DateKey turns the calendar date of t into a comparable number such as 20260105
+func DateKey(t time.Time) int {
+	return t.Year()*10000 + int(t.Month())*100 + t.Day()
+}
+
+// Validate validates the calendar exception fields
+func (e *CalendarException) Validate() error {
+	// Trim whitespace from string fields
+	e.Name = strings.TrimSpace(e.Name)
+
+	// Validate required fields
+	if e.CalendarID == 0 {
+		return ErrCalendarExceptionInvalidCalendarID
+	}
+
+	if !IsValidCalendarExceptionType(e.Type) {
+		return ErrCalendarExceptionInvalidType
+	}
+
+	// Validate dates
+	if e.StartDate.IsZero() {
+		return ErrCalendarExceptionStartDateRequired
+	}
+	if DateKey(e.EndDate) < DateKey(e.StartDate) {
+		return ErrCalendarExceptionInvalidDates
+	}
+
+	// Validate hours
+	if e.Type == CalendarExceptionCustomHours {
+		if e.Hours < 0 || e.Hours > 24 {
+			return ErrCalendarExceptionInvalidHours
+		}
+	} else if e.Hours != 0 {
+		return ErrCalendarExceptionInvalidHours
+	}
+
+	return nil
+}
+
+// setDefaults fills in the type and a single-day end date
+func (e *CalendarException) setDefaults() {
+	if e.Type == "" {
+		e.Type = CalendarExceptionHoliday
+	}
+	if e.EndDate.IsZero() {
+		e.EndDate = e.StartDate
+	}
+}
+
+// BeforeCreate is a GORM hook that runs before creating a calendar exception
+func (e *CalendarException) BeforeCreate(tx *gorm.DB) error {
+	e.setDefaults()
+	return e.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a calendar exception
+func (e *CalendarException) BeforeUpdate(tx *gorm.DB) error {
+	e.setDefaults()
+	return e.Validate()
+}
+
+// CalendarExceptionQueryParams defines query parameters for filtering calendar exceptions
+type CalendarExceptionQueryParams struct {
+	ID_In         []uint                  `json:"id_in"`
+	CalendarID    uint                    `json:"calendar_id"`
+	CalendarID_In []uint                  `json:"calendar_id_in"`
+	Name_Like     string                  `json:"name_like"`
+	Type          CalendarExceptionType   `json:"type"`
+	Type_In       []CalendarExceptionType `json:"type_in"`
+	StartDate_Gte *time.Time              `json:"start_date_gte"`
+	StartDate_Lte *time.Time              `json:"start_date_lte"`
+	EndDate_Gte   *time.Time              `json:"end_date_gte"`
+	EndDate_Lte   *time.Time              `json:"end_date_lte"`
+	CreatedAt_Gte *time.Time              `json:"created_at_gte"`
+	CreatedAt_Lte *time.Time              `json:"created_at_lte"`
+	UpdatedAt_Gte *time.Time              `json:"updated_at_gte"`
+	UpdatedAt_Lte *time.Time              `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// CalendarExceptionListResponse represents the response for GetCalendarExceptions
+type CalendarExceptionListResponse struct {
+	Data  []*CalendarException `json:"data"`
+	Total int64                `json:"total"`
+}
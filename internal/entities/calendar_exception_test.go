@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarExceptionTableName(t *testing.T) {
+	e := CalendarException{}
+	assert.Equal(t, "calendar_exceptions", e.TableName())
+}
+
+func TestCalendarExceptionValidate(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name      string
+		exception CalendarException
+		wantError error
+	}{
+		{"Valid: Holiday", CalendarException{CalendarID: 1, Type: CalendarExceptionHoliday, StartDate: day(1), EndDate: day(1)}, nil},
+		{"Valid: Non-working period", CalendarException{CalendarID: 1, Type: CalendarExceptionNonWorking, StartDate: day(1), EndDate: day(5)}, nil},
+		{"Valid: Custom hours", CalendarException{CalendarID: 1, Type: CalendarExceptionCustomHours, StartDate: day(3), EndDate: day(3), Hours: 4}, nil},
+		{"Valid: End date later on the same day", CalendarException{CalendarID: 1, Type: CalendarExceptionHoliday, StartDate: day(1).Add(10 * time.Hour), EndDate: day(1)}, nil},
+		{"Invalid: Missing calendar", CalendarException{Type: CalendarExceptionHoliday, StartDate: day(1), EndDate: day(1)}, ErrCalendarExceptionInvalidCalendarID},
+		{"Invalid: Type", CalendarException{CalendarID: 1, Type: "vacation", StartDate: day(1), EndDate: day(1)}, ErrCalendarExceptionInvalidType},
+		{"Invalid: Missing start date", CalendarException{CalendarID: 1, Type: CalendarExceptionHoliday}, ErrCalendarExceptionStartDateRequired},
+		{"Invalid: End before start", CalendarException{CalendarID: 1, Type: CalendarExceptionNonWorking, StartDate: day(5), EndDate: day(1)}, ErrCalendarExceptionInvalidDates},
+		{"Invalid: Hours on a holiday", CalendarException{CalendarID: 1, Type: CalendarExceptionHoliday, StartDate: day(1), EndDate: day(1), Hours: 4}, ErrCalendarExceptionInvalidHours},
+		{"Invalid: Custom hours over 24", CalendarException{CalendarID: 1, Type: CalendarExceptionCustomHours, StartDate: day(3), EndDate: day(3), Hours: 25}, ErrCalendarExceptionInvalidHours},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.exception.Validate()
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCalendarExceptionBeforeCreateDefaults(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := CalendarException{CalendarID: 1, StartDate: start}
+	assert.NoError(t, e.BeforeCreate(nil))
+	assert.Equal(t, CalendarExceptionHoliday, e.Type)
+	assert.Equal(t, start, e.EndDate)
+}
+
+func TestCalendarExceptionCovers(t *testing.T) {
+	e := CalendarException{
+		StartDate: time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC),
+	}
+	assert.False(t, e.Covers(time.Date(2026, 1, 11, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, e.Covers(time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Covers(time.Date(2026, 1, 13, 17, 0, 0, 0, time.UTC)))
+	assert.False(t, e.Covers(time.Date(2026, 1, 14, 0, 0, 0, 0, time.UTC)))
+}
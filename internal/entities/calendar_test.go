@@ -0,0 +1,164 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarTableName(t *testing.T) {
+	c := Calendar{}
+	assert.Equal(t, "calendars", c.TableName())
+}
+
+func TestCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		calendar  Calendar
+		wantError error
+	}{
+		{"Valid: Standard calendar", Calendar{Name: "Standard", WorkingDays: DefaultWorkingDays(), HoursPerDay: 8}, nil},
+		{"Valid: No working days", Calendar{Name: "Closed", WorkingDays: WeekdayArray{}, HoursPerDay: 8}, nil},
+		{"Invalid: Empty name", Calendar{Name: "  ", HoursPerDay: 8}, ErrCalendarNameRequired},
+		{"Invalid: Zero hours", Calendar{Name: "Standard", HoursPerDay: 0}, ErrCalendarInvalidHoursPerDay},
+		{"Invalid: Too many hours", Calendar{Name: "Standard", HoursPerDay: 25}, ErrCalendarInvalidHoursPerDay},
+		{"Invalid: Weekday out of range", Calendar{Name: "Standard", WorkingDays: WeekdayArray{7}, HoursPerDay: 8}, ErrCalendarInvalidWorkingDays},
+		{"Invalid: Duplicate weekday", Calendar{Name: "Standard", WorkingDays: WeekdayArray{time.Monday, time.Monday}, HoursPerDay: 8}, ErrCalendarInvalidWorkingDays},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.calendar.Validate()
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCalendarBeforeCreateDefaults(t *testing.T) {
+	c := Calendar{Name: "Standard"}
+	assert.NoError(t, c.BeforeCreate(nil))
+	assert.Equal(t, DefaultWorkingDays(), c.WorkingDays)
+	assert.Equal(t, DefaultHoursPerDay, c.HoursPerDay)
+}
+
+// newTestCalendar returns a Monday to Friday, 8 hours calendar with a holiday on
+// Tuesday 2026-01-06, a shutdown from 2026-01-12 to 2026-01-13 and a 4 hours Saturday on 2026-01-10
+func newTestCalendar() *Calendar {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	return &Calendar{
+		Name:        "Test",
+		WorkingDays: DefaultWorkingDays(),
+		HoursPerDay: 8,
+		Exceptions: []*CalendarException{
+			{Type: CalendarExceptionHoliday, StartDate: day(6), EndDate: day(6)},
+			{Type: CalendarExceptionNonWorking, StartDate: day(12), EndDate: day(13)},
+			{Type: CalendarExceptionCustomHours, StartDate: day(10), EndDate: day(10), Hours: 4},
+		},
+	}
+}
+
+func TestCalendarHoursOn(t *testing.T) {
+	c := newTestCalendar()
+	tests := []struct {
+		name string
+		day  int
+		want float64
+	}{
+		{"Working Monday", 5, 8},
+		{"Holiday", 6, 0},
+		{"Working Wednesday", 7, 8},
+		{"Custom hours Saturday", 10, 4},
+		{"Sunday", 11, 0},
+		{"Shutdown first day", 12, 0},
+		{"Shutdown last day", 13, 0},
+		{"Back to work", 14, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.HoursOn(time.Date(2026, 1, tt.day, 15, 0, 0, 0, time.UTC)))
+		})
+	}
+}
+
+func TestCalendarHoursOnCustomHoursWins(t *testing.T) {
+	c := newTestCalendar()
+	c.Exceptions = append(c.Exceptions, &CalendarException{
+		Type:      CalendarExceptionCustomHours,
+		StartDate: time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC),
+		Hours:     2,
+	})
+	assert.Equal(t, 2.0, c.HoursOn(time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestCalendarAddWorkingHours(t *testing.T) {
+	c := newTestCalendar()
+	at := func(day, hour int) time.Time { return time.Date(2026, 1, day, hour, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name  string
+		from  time.Time
+		hours float64
+		want  time.Time
+	}{
+		{"Zero hours from midnight moves to the morning", at(5, 0), 0, at(5, 9)},
+		{"Within a day", at(5, 9), 4, at(5, 13)},
+		{"Full day ends in the evening", at(5, 9), 8, at(5, 17)},
+		{"Skips the holiday", at(5, 9), 12, at(7, 13)},
+		{"Zero hours from the evening moves to the next working morning", at(5, 17), 0, at(7, 9)},
+		{"Uses custom hours and skips the shutdown", at(9, 9), 16, at(14, 13)},
+		{"Negative hours are treated as zero", at(5, 10), -3, at(5, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.AddWorkingHours(tt.from, tt.hours))
+		})
+	}
+}
+
+func TestCalendarNextWorkingTime(t *testing.T) {
+	c := newTestCalendar()
+	assert.Equal(t, time.Date(2026, 1, 7, 9, 0, 0, 0, time.UTC), c.NextWorkingTime(time.Date(2026, 1, 5, 17, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2026, 1, 5, 11, 30, 0, 0, time.UTC), c.NextWorkingTime(time.Date(2026, 1, 5, 11, 30, 0, 0, time.UTC)))
+}
+
+func TestCalendarWorkingHoursBetween(t *testing.T) {
+	c := newTestCalendar()
+	at := func(day, hour int) time.Time { return time.Date(2026, 1, day, hour, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want float64
+	}{
+		{"Same instant", at(5, 10), at(5, 10), 0},
+		{"Within a day", at(5, 10), at(5, 14), 4},
+		{"Across the holiday", at(5, 0), at(8, 0), 16},
+		{"Whole two weeks", at(5, 0), at(17, 0), 8 + 8 + 8 + 8 + 4 + 8 + 8 + 8},
+		{"Reversed bounds", at(5, 14), at(5, 10), -4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.WorkingHoursBetween(tt.from, tt.to))
+		})
+	}
+}
+
+func TestCalendarArithmeticRoundTrip(t *testing.T) {
+	c := newTestCalendar()
+	from := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	for _, hours := range []float64{1, 7.5, 8, 12, 20, 36, 60} {
+		assert.InDelta(t, hours, c.WorkingHoursBetween(from, c.AddWorkingHours(from, hours)), 1e-9)
+	}
+}
+
+func TestCalendarWithoutWorkingTimeTerminates(t *testing.T) {
+	c := &Calendar{Name: "Closed", WorkingDays: WeekdayArray{}, HoursPerDay: 8}
+	from := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	assert.True(t, c.AddWorkingHours(from, 8).After(from))
+}
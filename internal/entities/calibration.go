@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"errors"
+	"math"
+)
+
+// MinCalibrationSamples is the number of completed tasks a calibration factor needs before it is
+// used to adjust estimates
+const MinCalibrationSamples = 3
+
+// DefaultCalibrationScenarioName names the scenario that holds a project's calibrated estimates
+const DefaultCalibrationScenarioName = "Calibrated estimates"
+
+// CalibrationBasis tells which historical group a calibrated estimate is based on
+type CalibrationBasis string
+
+// Calibration bases, from the most to the least specific
+const (
+	CalibrationBasisPerson      CalibrationBasis = "person"
+	CalibrationBasisRoleLevel   CalibrationBasis = "role_level"
+	CalibrationBasisProjectType CalibrationBasis = "project_type"
+	CalibrationBasisClient      CalibrationBasis = "client"
+	CalibrationBasisOverall     CalibrationBasis = "overall"
+	CalibrationBasisNone        CalibrationBasis = "none" // Not enough history; the estimate is kept
+)
+
+var (
+	ErrCalibrationInvalidEffort = errors.New("effort to calibrate must be non-negative")
+)
+
+// CalibrationFactor is the accuracy of past estimates in one group of completed tasks,
+// such as the tasks of one person or one client
+type CalibrationFactor struct {
+	ID             uint    `json:"id"`   // Human resource or client ID; 0 for the other groups
+	Name           string  `json:"name"` // Person or client name, level or project type
+	Samples        int     `json:"samples"`
+	EstimatedHours float64 `json:"estimated_hours"`
+	ActualHours    float64 `json:"actual_hours"`
+	Factor         float64 `json:"factor"`         // Actual over estimated hours; above 1 means tasks were underestimated
+	MeanAbsError   float64 `json:"mean_abs_error"` // Mean absolute error of the estimates in percent
+	IsReliable     bool    `json:"is_reliable"`    // Whether the group has at least MinCalibrationSamples tasks
+	absErrorSum    float64 // Running sum of the absolute errors in percent
+}
+
+// Add records a completed task estimated at estimated hours that took actual hours
+func (f *CalibrationFactor) Add(estimated, actual float64) {
+	f.Samples++
+	f.EstimatedHours += estimated
+	f.ActualHours += actual
+	f.absErrorSum += math.Abs(actual-estimated) / estimated * 100
+	f.Factor = f.ActualHours / f.EstimatedHours
+	f.MeanAbsError = f.absErrorSum / float64(f.Samples)
+	f.IsReliable = f.Samples >= MinCalibrationSamples
+}
+
+// EstimateCalibration is the accuracy of past estimates overall and per group
+type EstimateCalibration struct {
+	Overall       *CalibrationFactor   `json:"overall"`
+	ByPerson      []*CalibrationFactor `json:"by_person"`       // Ordered by human resource ID
+	ByRoleLevel   []*CalibrationFactor `json:"by_role_level"`   // Level of the people who logged the hours, ordered by name
+	ByProjectType []*CalibrationFactor `json:"by_project_type"` // Ordered by project type
+	ByClient      []*CalibrationFactor `json:"by_client"`       // Ordered by client ID
+}
+
+// EstimateSuggestionRequest describes a task being estimated
+type EstimateSuggestionRequest struct {
+	ProjectID        uint    `json:"project_id"`
+	Effort           float64 `json:"effort"`             // Estimated working hours
+	HumanResourceIDs []uint  `json:"human_resource_ids"` // People expected to work on the task, if known
+}
+
+// CalibratedEstimate is an estimate adjusted by the accuracy of comparable past estimates
+type CalibratedEstimate struct {
+	TaskID          uint             `json:"task_id"` // 0 for a task that is not saved yet
+	Name            string           `json:"name"`
+	OriginalEffort  float64          `json:"original_effort"`
+	SuggestedEffort float64          `json:"suggested_effort"`
+	Factor          float64          `json:"factor"`
+	Basis           CalibrationBasis `json:"basis"`
+	BasisName       string           `json:"basis_name"` // Names of the people, levels, project type or client the factor comes from
+	Samples         int              `json:"samples"`
+}
+
+// CalibrationResult is a project's open tasks with calibrated estimates, kept in a draft scenario
+// so the original estimates stay untouched
+type CalibrationResult struct {
+	ProjectID        uint                  `json:"project_id"`
+	ScenarioID       uint                  `json:"scenario_id"`
+	OriginalEffort   float64               `json:"original_effort"`
+	CalibratedEffort float64               `json:"calibrated_effort"`
+	Tasks            []*CalibratedEstimate `json:"tasks"` // Ordered by task ID
+}
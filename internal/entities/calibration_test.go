@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalibrationFactorAdd(t *testing.T) {
+	f := &CalibrationFactor{}
+	f.Add(10, 12)
+	f.Add(20, 16)
+	assert.Equal(t, 2, f.Samples)
+	assert.Equal(t, 30.0, f.EstimatedHours)
+	assert.Equal(t, 28.0, f.ActualHours)
+	assert.InDelta(t, 28.0/30, f.Factor, 1e-9)
+	assert.InDelta(t, 20.0, f.MeanAbsError, 1e-9) // 20% over and 20% under
+	assert.False(t, f.IsReliable)
+
+	f.Add(10, 10)
+	assert.True(t, f.IsReliable)
+}
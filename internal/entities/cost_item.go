@@ -0,0 +1,229 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CostFrequency tells whether a cost item is paid once or every month
+type CostFrequency string
+
+const (
+	CostFrequencyOneOff  CostFrequency = "one_off" // Paid once, in the month of the start date
+	CostFrequencyMonthly CostFrequency = "monthly" // Paid every month from the start date to the end date
+)
+
+var (
+	ErrCostItemNameRequired       = errors.New("cost item name is required")
+	ErrCostItemInvalidProjectID   = errors.New("cost item must belong to a project")
+	ErrCostItemInvalidCostType    = errors.New("cost item type must be labor, material, equipment, overhead, infrastructure, service or other")
+	ErrCostItemInvalidAmount      = errors.New("cost item amount must be non-negative")
+	ErrCostItemInvalidQuantity    = errors.New("cost item quantity must be positive")
+	ErrCostItemInvalidFrequency   = errors.New("cost item frequency must be one_off or monthly")
+	ErrCostItemStartDateRequired  = errors.New("cost item start date is required")
+	ErrCostItemInvalidDates       = errors.New("cost item end date must be on or after start date")
+	ErrCostItemMilestoneMismatch  = errors.New("milestone belongs to a different project than the cost item")
+	ErrCostItemTaskMismatch       = errors.New("task belongs to a different project than the cost item")
+	ErrCostItemMilestoneAndTask   = errors.New("cost item must be linked to a milestone or a task, not both")
+	ErrCostItemOneOffWithEndDate  = errors.New("one-off cost item has no end date")
+	ErrCostItemRecurringUnbounded = errors.New("monthly cost item needs an end date when its project has none")
+
+	CostItemAllowedSortField = map[string]string{
+		"id":           "id",
+		"name":         "name",
+		"project_id":   "project_id",
+		"milestone_id": "milestone_id",
+		"task_id":      "task_id",
+		"cost_type":    "cost_type",
+		"amount":       "amount",
+		"quantity":     "quantity",
+		"frequency":    "frequency",
+		"start_date":   "start_date",
+		"end_date":     "end_date",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+	}
+)
+
+// IsValidCostFrequency checks if the cost frequency is valid
+func IsValidCostFrequency(f CostFrequency) bool {
+	switch f {
+	case CostFrequencyOneOff, CostFrequencyMonthly:
+		return true
+	}
+	return false
+}
+
+// CostItem is a cost of a project other than the labor of its resource allocations, such as cloud
+// hosting, software licences or hardware. It can be linked to a milestone or a task.
+type CostItem struct {
+	ID          uint          `gorm:"primary_key" json:"id"`
+	Name        string        `gorm:"not null" json:"name"`
+	Description string        `gorm:"type:text" json:"description"`
+	ProjectID   uint          `gorm:"not null;index" json:"project_id"`
+	MilestoneID *uint         `gorm:"index" json:"milestone_id"`
+	TaskID      *uint         `gorm:"index" json:"task_id"`
+	CostType    CostType      `gorm:"not null;default:'other';index" json:"cost_type"`
+	Amount      Money         `gorm:"not null;default:0" json:"amount"`   // Price per unit, per month for monthly items
+	Quantity    float64       `gorm:"not null;default:1" json:"quantity"` // Units, such as licences or servers
+	Frequency   CostFrequency `gorm:"not null;default:'one_off'" json:"frequency"`
+	StartDate   *time.Time    `gorm:"not null;index" json:"start_date"`
+	EndDate     *time.Time    `gorm:"" json:"end_date"` // Last day of a monthly item; nil means until the project ends
+	CreatedAt   time.Time     `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time     `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Project   *Project   `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	Milestone *Milestone `gorm:"foreignKey:MilestoneID;constraint:OnDelete:SET NULL" json:"milestone,omitempty"`
+	Task      *Task      `gorm:"foreignKey:TaskID;constraint:OnDelete:SET NULL" json:"task,omitempty"`
+}
+
+// TableName returns the table name for the cost item entity
+func (CostItem) TableName() string {
+	return "cost_items"
+}
+
+// IsRecurring returns true if the cost item is paid every month
+func (c *CostItem) IsRecurring() bool {
+	return c.Frequency == CostFrequencyMonthly
+}
+
+// PerPayment returns the amount paid each time: once for a one-off item, every month for a monthly one
+func (c *CostItem) PerPayment() Money {
+	return c.Amount.Mul(c.Quantity)
+}
+
+// Payments returns the first day of each month the item is paid in. A monthly item is paid in full
+// in every calendar month from its start date to its end date, or else to the given project end.
+func (c *CostItem) Payments(projectEnd *time.Time) []time.Time {
+	if c.StartDate == nil {
+		return nil
+	}
+	month := time.Date(c.StartDate.Year(), c.StartDate.Month(), 1, 0, 0, 0, 0, c.StartDate.Location())
+	if !c.IsRecurring() {
+		return []time.Time{month}
+	}
+	end := c.EndDate
+	if end == nil {
+		end = projectEnd
+	}
+	if end == nil {
+		return nil
+	}
+	var payments []time.Time
+	for ; DateKey(month) <= DateKey(*end); month = month.AddDate(0, 1, 0) {
+		payments = append(payments, month)
+	}
+	return payments
+}
+
+// Validate validates the cost item fields
+func (c *CostItem) Validate() error {
+	// Trim whitespace from string fields
+	c.Name = strings.TrimSpace(c.Name)
+	c.Description = strings.TrimSpace(c.Description)
+
+	// Validate required fields
+	if c.Name == "" {
+		return ErrCostItemNameRequired
+	}
+
+	// Validate project ID
+	if c.ProjectID == 0 {
+		return ErrCostItemInvalidProjectID
+	}
+
+	// Validate links
+	if c.MilestoneID != nil && c.TaskID != nil {
+		return ErrCostItemMilestoneAndTask
+	}
+
+	if !IsValidCostType(c.CostType) {
+		return ErrCostItemInvalidCostType
+	}
+
+	// Validate amounts
+	if c.Amount < 0 {
+		return ErrCostItemInvalidAmount
+	}
+	if c.Quantity <= 0 {
+		return ErrCostItemInvalidQuantity
+	}
+
+	if !IsValidCostFrequency(c.Frequency) {
+		return ErrCostItemInvalidFrequency
+	}
+
+	// Validate dates
+	if c.StartDate == nil {
+		return ErrCostItemStartDateRequired
+	}
+	if c.EndDate != nil {
+		if !c.IsRecurring() {
+			return ErrCostItemOneOffWithEndDate
+		}
+		if DateKey(*c.EndDate) < DateKey(*c.StartDate) {
+			return ErrCostItemInvalidDates
+		}
+	}
+
+	return nil
+}
+
+// setDefaults fills in the cost type, frequency and quantity when they are not set
+func (c *CostItem) setDefaults() {
+	if c.CostType == "" {
+		c.CostType = CostTypeOther
+	}
+	if c.Frequency == "" {
+		c.Frequency = CostFrequencyOneOff
+	}
+	if c.Quantity == 0 {
+		c.Quantity = 1
+	}
+}
+
+// BeforeCreate is a GORM hook that runs before creating a cost item
+func (c *CostItem) BeforeCreate(tx *gorm.DB) error {
+	c.setDefaults()
+	return c.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a cost item
+func (c *CostItem) BeforeUpdate(tx *gorm.DB) error {
+	return c.Validate()
+}
+
+// CostItemQueryParams defines query parameters for filtering cost items
+type CostItemQueryParams struct {
+	ID_In              []uint          `json:"id_in"`
+	Name_Like          string          `json:"name_like"`
+	ProjectID          uint            `json:"project_id"`
+	ProjectID_In       []uint          `json:"project_id_in"`
+	MilestoneID        *uint           `json:"milestone_id"`
+	MilestoneID_IsNull *bool           `json:"milestone_id_is_null"`
+	TaskID             *uint           `json:"task_id"`
+	TaskID_IsNull      *bool           `json:"task_id_is_null"`
+	CostType           CostType        `json:"cost_type"`
+	CostType_In        []CostType      `json:"cost_type_in"`
+	Frequency          CostFrequency   `json:"frequency"`
+	Frequency_In       []CostFrequency `json:"frequency_in"`
+	StartDate_Gte      *time.Time      `json:"start_date_gte"`
+	StartDate_Lte      *time.Time      `json:"start_date_lte"`
+	EndDate_Gte        *time.Time      `json:"end_date_gte"`
+	EndDate_Lte        *time.Time      `json:"end_date_lte"`
+	CreatedAt_Gte      *time.Time      `json:"created_at_gte"`
+	CreatedAt_Lte      *time.Time      `json:"created_at_lte"`
+	UpdatedAt_Gte      *time.Time      `json:"updated_at_gte"`
+	UpdatedAt_Lte      *time.Time      `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// CostItemListResponse represents the response for GetCostItems
+type CostItemListResponse struct {
+	Data  []*CostItem `json:"data"`
+	Total int64       `json:"total"`
+}
@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostItemTableName(t *testing.T) {
+	item := CostItem{}
+	assert.Equal(t, "cost_items", item.TableName())
+}
+
+func TestCostItemValidate(t *testing.T) {
+	day := func(d int) *time.Time {
+		date := time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	milestone := uint(1)
+	task := uint(2)
+	sameDay := day(10).Add(10 * time.Hour)
+
+	tests := []struct {
+		name      string
+		item      CostItem
+		wantError error
+	}{
+		{"Valid: One-off", CostItem{Name: "Laptop", ProjectID: 1, CostType: CostTypeEquipment, Amount: NewMoney(1200), Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10)}, nil},
+		{"Valid: Monthly with end date", CostItem{Name: "Hosting", ProjectID: 1, MilestoneID: &milestone, CostType: CostTypeInfrastructure, Amount: NewMoney(200), Quantity: 2, Frequency: CostFrequencyMonthly, StartDate: day(10), EndDate: &sameDay}, nil},
+		{"Valid: Monthly until the project ends", CostItem{Name: "Licences", ProjectID: 1, TaskID: &task, CostType: CostTypeService, Amount: NewMoney(30), Quantity: 5, Frequency: CostFrequencyMonthly, StartDate: day(10)}, nil},
+		{"Invalid: Empty name", CostItem{Name: "  ", ProjectID: 1, CostType: CostTypeOther, Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10)}, ErrCostItemNameRequired},
+		{"Invalid: No project", CostItem{Name: "Laptop", CostType: CostTypeOther, Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10)}, ErrCostItemInvalidProjectID},
+		{"Invalid: Milestone and task", CostItem{Name: "Laptop", ProjectID: 1, MilestoneID: &milestone, TaskID: &task, CostType: CostTypeOther, Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10)}, ErrCostItemMilestoneAndTask},
+		{"Invalid: Cost type", CostItem{Name: "Laptop", ProjectID: 1, CostType: "travel", Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10)}, ErrCostItemInvalidCostType},
+		{"Invalid: Negative amount", CostItem{Name: "Laptop", ProjectID: 1, CostType: CostTypeOther, Amount: NewMoney(-1), Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10)}, ErrCostItemInvalidAmount},
+		{"Invalid: Zero quantity", CostItem{Name: "Laptop", ProjectID: 1, CostType: CostTypeOther, Frequency: CostFrequencyOneOff, StartDate: day(10)}, ErrCostItemInvalidQuantity},
+		{"Invalid: Frequency", CostItem{Name: "Laptop", ProjectID: 1, CostType: CostTypeOther, Quantity: 1, Frequency: "yearly", StartDate: day(10)}, ErrCostItemInvalidFrequency},
+		{"Invalid: Missing start date", CostItem{Name: "Laptop", ProjectID: 1, CostType: CostTypeOther, Quantity: 1, Frequency: CostFrequencyOneOff}, ErrCostItemStartDateRequired},
+		{"Invalid: One-off with end date", CostItem{Name: "Laptop", ProjectID: 1, CostType: CostTypeOther, Quantity: 1, Frequency: CostFrequencyOneOff, StartDate: day(10), EndDate: day(20)}, ErrCostItemOneOffWithEndDate},
+		{"Invalid: Ends the day before", CostItem{Name: "Hosting", ProjectID: 1, CostType: CostTypeOther, Quantity: 1, Frequency: CostFrequencyMonthly, StartDate: day(10), EndDate: day(9)}, ErrCostItemInvalidDates},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.item.Validate()
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCostItemPayments(t *testing.T) {
+	date := func(year int, month time.Month, d int) *time.Time {
+		t := time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	months := func(payments []time.Time) []string {
+		keys := make([]string, 0, len(payments))
+		for _, p := range payments {
+			keys = append(keys, p.Format("2006-01-02"))
+		}
+		return keys
+	}
+
+	oneOff := CostItem{Amount: NewMoney(300), Quantity: 5, Frequency: CostFrequencyOneOff, StartDate: date(2026, 2, 17)}
+	assert.Equal(t, []string{"2026-02-01"}, months(oneOff.Payments(date(2026, 6, 30))))
+	assert.Equal(t, NewMoney(1500), oneOff.PerPayment())
+
+	// A monthly item is paid in full in the months it starts and ends in, across the year end
+	monthly := CostItem{Amount: NewMoney(200), Quantity: 2, Frequency: CostFrequencyMonthly, StartDate: date(2025, 11, 20), EndDate: date(2026, 1, 3)}
+	assert.Equal(t, []string{"2025-11-01", "2025-12-01", "2026-01-01"}, months(monthly.Payments(date(2026, 6, 30))))
+
+	// Without an end date it runs until the project ends
+	monthly.EndDate = nil
+	assert.Len(t, monthly.Payments(date(2026, 2, 28)), 4)
+	assert.Empty(t, monthly.Payments(nil))
+
+	// Without a start date it is never paid
+	assert.Empty(t, (&CostItem{Frequency: CostFrequencyOneOff}).Payments(nil))
+}
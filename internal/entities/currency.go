@@ -0,0 +1,45 @@
+package entities
+
+import "strings"
+
+// currencyMinorUnits maps the active ISO 4217 currency codes to the number of digits of their minor unit
+var currencyMinorUnits = func() map[string]int {
+	codes := map[int]string{
+		0: "BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX UYI VND VUV XAF XOF XPF",
+		2: "AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BOV BRL BSD BTN BWP BYN BZD " +
+			"CAD CDF CHE CHF CHW CNY COP COU CRC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS " +
+			"GIP GMD GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR KPW KYD KZT LAK LBP LKR LRD LSL " +
+			"MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD PAB PEN PGK " +
+			"PHP PKR PLN QAR RON RSD RUB SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS " +
+			"TMT TOP TRY TTD TWD TZS UAH USD USN UYU UZS VED VES WST XCD XCG YER ZAR ZMW ZWG",
+		3: "BHD IQD JOD KWD LYD OMR TND",
+		4: "CLF UYW",
+	}
+	units := make(map[string]int)
+	for digits, list := range codes {
+		for _, code := range strings.Fields(list) {
+			units[code] = digits
+		}
+	}
+	return units
+}()
+
+// NormalizeCurrency trims and upper-cases a currency code
+func NormalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValidCurrency returns true if the code is an active ISO 4217 currency code, such as USD, EUR or VND
+func IsValidCurrency(code string) bool {
+	_, ok := currencyMinorUnits[code]
+	return ok
+}
+
+// CurrencyMinorUnits returns the number of decimal digits amounts in the currency are rounded to,
+// such as 2 for USD and 0 for VND; unknown currencies have 2
+func CurrencyMinorUnits(code string) int {
+	if digits, ok := currencyMinorUnits[code]; ok {
+		return digits
+	}
+	return 2
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrEarnedValueNoBaseline = errors.New("project has no baseline; set a baseline before measuring earned value")
+)
+
+// TaskBaseline is the plan of a task saved when the project baseline is set
+type TaskBaseline struct {
+	Start  *time.Time
+	Finish *time.Time
+	Effort float64
+	Cost   Money
+	SetAt  time.Time
+}
+
+// EarnedValue holds the earned value management metrics of a project or milestone on a status date.
+// Budget at completion is the approved budget, or else the baseline cost.
+type EarnedValue struct {
+	BAC Money   `json:"bac"` // Budget at completion
+	PV  Money   `json:"pv"`  // Planned value: budgeted cost of the work scheduled by the status date
+	EV  Money   `json:"ev"`  // Earned value: budgeted cost of the work performed
+	AC  Money   `json:"ac"`  // Actual cost of the work performed by the status date
+	CV  Money   `json:"cv"`  // Cost variance: EV - AC
+	SV  Money   `json:"sv"`  // Schedule variance: EV - PV
+	CPI float64 `json:"cpi"` // Cost performance index: EV / AC; 0 without actual cost
+	SPI float64 `json:"spi"` // Schedule performance index: EV / PV; 0 without planned value
+	EAC Money   `json:"eac"` // Estimate at completion
+	ETC Money   `json:"etc"` // Estimate to complete: EAC - AC
+	VAC Money   `json:"vac"` // Variance at completion: BAC - EAC
+}
+
+// Calculate derives the variances, indexes and estimates from BAC, PV, EV and AC. The estimate at
+// completion assumes the cost performance so far goes on (BAC / CPI); before any work is earned
+// it is the actual cost plus the budget of the remaining work.
+func (v *EarnedValue) Calculate() {
+	v.CV = v.EV - v.AC
+	v.SV = v.EV - v.PV
+	v.CPI, v.SPI = 0, 0
+	if v.AC > 0 {
+		v.CPI = v.EV.Float64() / v.AC.Float64()
+	}
+	if v.PV > 0 {
+		v.SPI = v.EV.Float64() / v.PV.Float64()
+	}
+	if v.CPI > 0 {
+		v.EAC = v.BAC.Mul(1 / v.CPI)
+	} else {
+		v.EAC = v.AC + v.BAC - v.EV
+	}
+	v.ETC = v.EAC - v.AC
+	v.VAC = v.BAC - v.EAC
+}
+
+// MilestoneEarnedValue is the earned value of the baselined work packages of one milestone
+type MilestoneEarnedValue struct {
+	EarnedValue
+	MilestoneID *uint  `json:"milestone_id"` // Nil for work not planned on a milestone
+	Name        string `json:"name"`
+	Budget      Money  `json:"budget"`
+}
+
+// ProjectEarnedValue is the earned value of a project on a status date. Planned value spreads the
+// baseline cost of each work package over the working hours between its baseline start and finish;
+// earned value is that cost times the percentage complete; actual cost is the time logged up to the
+// status date at the rate cards that apply each day. When a budget is set, the baseline cost of
+// each work package is scaled so that together they make up the budget.
+type ProjectEarnedValue struct {
+	EarnedValue
+	ProjectID     uint                    `json:"project_id"`
+	Currency      string                  `json:"currency"`
+	StatusDate    time.Time               `json:"status_date"`
+	Budget        Money                   `json:"budget"`
+	BaselineCost  Money                   `json:"baseline_cost"`
+	UnpricedHours float64                 `json:"unpriced_hours"` // Logged hours no rate card in the project currency applies to; they add no actual cost
+	Milestones    []*MilestoneEarnedValue `json:"milestones"`     // Ordered by milestone end date, then work not planned on a milestone
+}
+
+// EarnedValuePoint is the planned value, earned value and actual cost of a project on one date.
+// Earned value and actual cost are only known up to the status date.
+type EarnedValuePoint struct {
+	Date time.Time `json:"date"`
+	PV   Money     `json:"pv"`
+	EV   *Money    `json:"ev"`
+	AC   *Money    `json:"ac"`
+}
+
+// EarnedValueSeries is the earned value of a project at the end of each month from the baseline
+// start to the later of the baseline finish and the status date, and on the status date itself.
+// Earned value is spread over the hours logged on each work package up to the status date; work
+// packages without logged hours earn their value on the status date.
+type EarnedValueSeries struct {
+	ProjectID  uint                `json:"project_id"`
+	Currency   string              `json:"currency"`
+	StatusDate time.Time           `json:"status_date"`
+	BAC        Money               `json:"bac"`
+	Points     []*EarnedValuePoint `json:"points"` // Ordered by date
+}
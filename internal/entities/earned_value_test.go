@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEarnedValueCalculate(t *testing.T) {
+	t.Run("Over budget and behind schedule", func(t *testing.T) {
+		v := EarnedValue{BAC: NewMoney(6000), PV: NewMoney(4000), EV: NewMoney(3000), AC: NewMoney(4000)}
+		v.Calculate()
+		assert.Equal(t, NewMoney(-1000), v.CV)
+		assert.Equal(t, NewMoney(-1000), v.SV)
+		assert.InDelta(t, 0.75, v.CPI, 1e-9)
+		assert.InDelta(t, 0.75, v.SPI, 1e-9)
+		assert.Equal(t, NewMoney(8000), v.EAC)
+		assert.Equal(t, NewMoney(4000), v.ETC)
+		assert.Equal(t, NewMoney(-2000), v.VAC)
+	})
+
+	t.Run("Nothing earned yet", func(t *testing.T) {
+		v := EarnedValue{BAC: NewMoney(6000), PV: NewMoney(1000), AC: NewMoney(500)}
+		v.Calculate()
+		assert.Zero(t, v.CPI)
+		assert.Zero(t, v.SPI)
+		assert.Equal(t, NewMoney(6500), v.EAC)
+		assert.Equal(t, NewMoney(6000), v.ETC)
+		assert.Equal(t, NewMoney(-500), v.VAC)
+	})
+
+	t.Run("Not started", func(t *testing.T) {
+		v := EarnedValue{BAC: NewMoney(6000)}
+		v.Calculate()
+		assert.Zero(t, v.CPI)
+		assert.Zero(t, v.SPI)
+		assert.Equal(t, NewMoney(6000), v.EAC)
+		assert.Zero(t, v.VAC)
+	})
+}
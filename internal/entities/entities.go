@@ -269,6 +269,16 @@ const (
 	RateTypeFixed   RateType = "fixed"
 )
 
+// EffortUnit represents the unit effort is displayed and entered in. Effort is always stored in hours.
+type EffortUnit string
+
+const (
+	EffortUnitHours     EffortUnit = "hours"
+	EffortUnitDays      EffortUnit = "days"
+	EffortUnitManWeeks  EffortUnit = "man_weeks"
+	EffortUnitManMonths EffortUnit = "man_months"
+)
+
 // Helper functions for validation
 
 // IsValidProjectType checks if the project type is valid
@@ -329,12 +339,22 @@ func IsValidRateType(rt RateType) bool {
 	return false
 }
 
+// IsValidEffortUnit checks if the effort unit is valid
+func IsValidEffortUnit(u EffortUnit) bool {
+	switch u {
+	case EffortUnitHours, EffortUnitDays, EffortUnitManWeeks, EffortUnitManMonths:
+		return true
+	}
+	return false
+}
+
 // Default unit conversion constants
 // These are used when projects don't specify custom work time settings
 const (
 	DefaultHoursPerDay   = 8.0  // Standard working hours per day
 	DefaultDaysPerWeek   = 5.0  // Standard working days per week
 	DefaultDaysPerMonth  = 20.0 // Standard working days per month (approximately)
+	DefaultWeeksPerMonth = DefaultDaysPerMonth / DefaultDaysPerWeek
 	DefaultHoursPerWeek  = DefaultHoursPerDay * DefaultDaysPerWeek
 	DefaultHoursPerMonth = DefaultHoursPerDay * DefaultDaysPerMonth
 )
@@ -430,6 +450,30 @@ func MonthsToHoursCustom(months, hoursPerDay, daysPerMonth float64) float64 {
 	return months * hoursPerMonth
 }
 
+// HoursToWeeksCustom converts hours to man-weeks using custom hours per day and days per week
+func HoursToWeeksCustom(hours, hoursPerDay, daysPerWeek float64) float64 {
+	if hoursPerDay <= 0 {
+		hoursPerDay = DefaultHoursPerDay
+	}
+	if daysPerWeek <= 0 {
+		daysPerWeek = DefaultDaysPerWeek
+	}
+	hoursPerWeek := hoursPerDay * daysPerWeek
+	return hours / hoursPerWeek
+}
+
+// WeeksToHoursCustom converts man-weeks to hours using custom hours per day and days per week
+func WeeksToHoursCustom(weeks, hoursPerDay, daysPerWeek float64) float64 {
+	if hoursPerDay <= 0 {
+		hoursPerDay = DefaultHoursPerDay
+	}
+	if daysPerWeek <= 0 {
+		daysPerWeek = DefaultDaysPerWeek
+	}
+	hoursPerWeek := hoursPerDay * daysPerWeek
+	return weeks * hoursPerWeek
+}
+
 // DaysToMonthsCustom converts days to man-months using custom days per month
 func DaysToMonthsCustom(days, daysPerMonth float64) float64 {
 	if daysPerMonth <= 0 {
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// EffortEstimate is the expected effort of some work in hours together with its uncertainty.
+// Percentiles assume the effort is normally distributed, which holds for sums of many tasks.
+type EffortEstimate struct {
+	Expected float64 `json:"expected"`
+	Variance float64 `json:"variance"`
+	StdDev   float64 `json:"std_dev"`
+	P50      float64 `json:"p50"` // Effort not exceeded with 50% confidence
+	P80      float64 `json:"p80"`
+	P90      float64 `json:"p90"`
+}
+
+// NewEffortEstimate creates an estimate from its expected value and variance
+func NewEffortEstimate(expected, variance float64) EffortEstimate {
+	e := EffortEstimate{Expected: expected, Variance: math.Max(0, variance)}
+	e.StdDev = math.Sqrt(e.Variance)
+	e.P50 = e.Percentile(0.5)
+	e.P80 = e.Percentile(0.8)
+	e.P90 = e.Percentile(0.9)
+	return e
+}
+
+// Add combines the estimates of independent work: expected values and variances add up,
+// standard deviations do not
+func (e EffortEstimate) Add(other EffortEstimate) EffortEstimate {
+	return NewEffortEstimate(e.Expected+other.Expected, e.Variance+other.Variance)
+}
+
+// Percentile returns the effort not exceeded with the given confidence, between 0 and 1 exclusive
+func (e EffortEstimate) Percentile(confidence float64) float64 {
+	if e.StdDev == 0 || confidence <= 0 || confidence >= 1 {
+		return e.Expected
+	}
+	z := math.Sqrt2 * math.Erfinv(2*confidence-1)
+	return math.Max(0, e.Expected+z*e.StdDev)
+}
+
+// Range returns the effort range holding the actual effort with the given confidence,
+// between 0 and 1 exclusive, centered on the expected value
+func (e EffortEstimate) Range(confidence float64) (low, high float64) {
+	tail := (1 - confidence) / 2
+	return e.Percentile(tail), e.Percentile(1 - tail)
+}
+
+// sampleTriangular draws a value from the triangular distribution over [o, p] peaking at m
+func sampleTriangular(rng *rand.Rand, o, m, p float64) float64 {
+	if p <= o {
+		return m
+	}
+	u := rng.Float64()
+	if u < (m-o)/(p-o) {
+		return o + math.Sqrt(u*(p-o)*(m-o))
+	}
+	return p - math.Sqrt((1-u)*(p-o)*(p-m))
+}
+
+// samplePERT draws a value from the PERT distribution over [o, p] with mode m:
+// a beta distribution whose mean is (o+4m+p)/6
+func samplePERT(rng *rand.Rand, o, m, p float64) float64 {
+	if p <= o {
+		return m
+	}
+	x := sampleGamma(rng, 1+4*(m-o)/(p-o))
+	y := sampleGamma(rng, 1+4*(p-m)/(p-o))
+	return o + (p-o)*x/(x+y)
+}
+
+// sampleGamma draws a value from the gamma distribution with unit scale and a shape of at
+// least 1, using the method of Marsaglia and Tsang
+func sampleGamma(rng *rand.Rand, shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rng.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		if math.Log(rng.Float64()) < x*x/2+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffortEstimatePercentiles(t *testing.T) {
+	estimate := NewEffortEstimate(100, 400)
+	assert.Equal(t, 20.0, estimate.StdDev)
+	assert.Equal(t, 100.0, estimate.P50)
+	assert.InDelta(t, 116.83, estimate.P80, 0.01)
+	assert.InDelta(t, 125.63, estimate.P90, 0.01)
+
+	low, high := estimate.Range(0.95)
+	assert.InDelta(t, 60.80, low, 0.01)
+	assert.InDelta(t, 139.20, high, 0.01)
+
+	certain := NewEffortEstimate(40, 0)
+	assert.Equal(t, 40.0, certain.Percentile(0.99))
+}
+
+func TestEffortEstimateAdd(t *testing.T) {
+	total := NewEffortEstimate(10, 9).Add(NewEffortEstimate(20, 16))
+	assert.Equal(t, 30.0, total.Expected)
+	assert.Equal(t, 25.0, total.Variance)
+	assert.Equal(t, 5.0, total.StdDev) // not 3 + 4
+}
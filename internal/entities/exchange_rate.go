@@ -0,0 +1,132 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrExchangeRateInvalidCurrency       = errors.New("exchange rate currencies must be ISO 4217 codes such as USD, EUR or VND")
+	ErrExchangeRateSameCurrency          = errors.New("exchange rate must convert between two different currencies")
+	ErrExchangeRateInvalidRate           = errors.New("exchange rate must be positive")
+	ErrExchangeRateEffectiveFromRequired = errors.New("exchange rate effective from date is required")
+	ErrExchangeRateDuplicate             = errors.New("another rate between the same currencies takes effect on the same date")
+	ErrExchangeRateNotFound              = errors.New("no exchange rate between the currencies applies on the date")
+
+	ExchangeRateAllowedSortField = map[string]string{
+		"id":             "id",
+		"from_currency":  "from_currency",
+		"to_currency":    "to_currency",
+		"rate":           "rate",
+		"effective_from": "effective_from",
+		"created_at":     "created_at",
+		"updated_at":     "updated_at",
+	}
+)
+
+// ExchangeRate is what one unit of a currency is worth in another from a date until the next rate
+// between the same currencies takes effect. Only the calendar date of the effective date matters.
+type ExchangeRate struct {
+	ID            uint      `gorm:"primary_key" json:"id"`
+	FromCurrency  string    `gorm:"not null;index" json:"from_currency"`
+	ToCurrency    string    `gorm:"not null;index" json:"to_currency"`
+	Rate          float64   `gorm:"not null" json:"rate"` // Units of the to currency one unit of the from currency buys
+	EffectiveFrom time.Time `gorm:"not null;index" json:"effective_from"`
+	Notes         string    `gorm:"type:text" json:"notes"` // Such as where the rate was published
+	CreatedAt     time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime:milli" json:"updated_at"`
+}
+
+// TableName returns the table name for the exchange rate entity
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+// Converts returns true if the rate converts the from currency into the to currency
+func (r *ExchangeRate) Converts(from, to string) bool {
+	return r.FromCurrency == from && r.ToCurrency == to
+}
+
+// InEffectOn returns true if the rate has taken effect by the calendar day of t
+func (r *ExchangeRate) InEffectOn(t time.Time) bool {
+	return DateKey(r.EffectiveFrom) <= DateKey(t)
+}
+
+// Duplicates returns true if both rates convert the same currencies and take effect on the same day
+func (r *ExchangeRate) Duplicates(other *ExchangeRate) bool {
+	return r.Converts(other.FromCurrency, other.ToCurrency) && DateKey(r.EffectiveFrom) == DateKey(other.EffectiveFrom)
+}
+
+// Validate validates the exchange rate fields
+func (r *ExchangeRate) Validate() error {
+	// Trim whitespace from string fields
+	r.FromCurrency = NormalizeCurrency(r.FromCurrency)
+	r.ToCurrency = NormalizeCurrency(r.ToCurrency)
+	r.Notes = strings.TrimSpace(r.Notes)
+
+	// Validate currencies
+	if !IsValidCurrency(r.FromCurrency) || !IsValidCurrency(r.ToCurrency) {
+		return ErrExchangeRateInvalidCurrency
+	}
+	if r.FromCurrency == r.ToCurrency {
+		return ErrExchangeRateSameCurrency
+	}
+
+	// Validate rate
+	if !(r.Rate > 0) || math.IsInf(r.Rate, 0) {
+		return ErrExchangeRateInvalidRate
+	}
+
+	// Validate date
+	if r.EffectiveFrom.IsZero() {
+		return ErrExchangeRateEffectiveFromRequired
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating an exchange rate
+func (r *ExchangeRate) BeforeCreate(tx *gorm.DB) error {
+	return r.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating an exchange rate
+func (r *ExchangeRate) BeforeUpdate(tx *gorm.DB) error {
+	return r.Validate()
+}
+
+// ExchangeRateQueryParams defines query parameters for filtering exchange rates
+type ExchangeRateQueryParams struct {
+	ID_In             []uint     `json:"id_in"`
+	FromCurrency      string     `json:"from_currency"`
+	FromCurrency_In   []string   `json:"from_currency_in"`
+	ToCurrency        string     `json:"to_currency"`
+	ToCurrency_In     []string   `json:"to_currency_in"`
+	EffectiveFrom_Gte *time.Time `json:"effective_from_gte"`
+	EffectiveFrom_Lte *time.Time `json:"effective_from_lte"`
+	CreatedAt_Gte     *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte     *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte     *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte     *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// ExchangeRateListResponse represents the response for GetExchangeRates
+type ExchangeRateListResponse struct {
+	Data  []*ExchangeRate `json:"data"`
+	Total int64           `json:"total"`
+}
+
+// CurrencyConversion is an amount converted from one currency into another at the rate of a date
+type CurrencyConversion struct {
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Date         time.Time `json:"date"`
+	Rate         float64   `json:"rate"` // Units of the to currency one unit of the from currency buys on the date
+	Amount       Money     `json:"amount"`
+	Converted    Money     `json:"converted"` // Rounded to the minor unit of the to currency
+}
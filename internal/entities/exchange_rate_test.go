@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRateTableName(t *testing.T) {
+	rate := ExchangeRate{}
+	assert.Equal(t, "exchange_rates", rate.TableName())
+}
+
+func TestExchangeRateValidate(t *testing.T) {
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		rate      ExchangeRate
+		wantError error
+	}{
+		{"Valid", ExchangeRate{FromCurrency: "USD", ToCurrency: "VND", Rate: 25400, EffectiveFrom: day}, nil},
+		{"Valid: Lower case", ExchangeRate{FromCurrency: " eur", ToCurrency: "usd ", Rate: 1.08, EffectiveFrom: day}, nil},
+		{"Invalid: Unknown currency", ExchangeRate{FromCurrency: "USD", ToCurrency: "XYZ", Rate: 1, EffectiveFrom: day}, ErrExchangeRateInvalidCurrency},
+		{"Invalid: Missing currency", ExchangeRate{ToCurrency: "USD", Rate: 1, EffectiveFrom: day}, ErrExchangeRateInvalidCurrency},
+		{"Invalid: Same currency", ExchangeRate{FromCurrency: "EUR", ToCurrency: "eur", Rate: 1, EffectiveFrom: day}, ErrExchangeRateSameCurrency},
+		{"Invalid: Zero rate", ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", EffectiveFrom: day}, ErrExchangeRateInvalidRate},
+		{"Invalid: Negative rate", ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", Rate: -0.9, EffectiveFrom: day}, ErrExchangeRateInvalidRate},
+		{"Invalid: Missing effective from", ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", Rate: 0.9}, ErrExchangeRateEffectiveFromRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantError, tt.rate.Validate())
+		})
+	}
+
+	rate := ExchangeRate{FromCurrency: " eur", ToCurrency: "usd ", Rate: 1.08, EffectiveFrom: day}
+	assert.NoError(t, rate.Validate())
+	assert.Equal(t, "EUR", rate.FromCurrency)
+	assert.Equal(t, "USD", rate.ToCurrency)
+}
+
+func TestExchangeRateDates(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2026, 3, d, hour, 0, 0, 0, time.UTC) }
+	rate := ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", Rate: 0.92, EffectiveFrom: day(2, 0)}
+
+	assert.True(t, rate.Converts("USD", "EUR"))
+	assert.False(t, rate.Converts("EUR", "USD"))
+	assert.False(t, rate.InEffectOn(day(1, 23)))
+	assert.True(t, rate.InEffectOn(day(2, 0)))
+	assert.True(t, rate.InEffectOn(day(20, 0)))
+
+	assert.True(t, rate.Duplicates(&ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", EffectiveFrom: day(2, 15)}))
+	assert.False(t, rate.Duplicates(&ExchangeRate{FromCurrency: "EUR", ToCurrency: "USD", EffectiveFrom: day(2, 0)}))
+	assert.False(t, rate.Duplicates(&ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", EffectiveFrom: day(3, 0)}))
+}
@@ -0,0 +1,65 @@
+package entities
+
+import "time"
+
+// LaborCost is the allocated working hours and their cost in one part of a labor cost breakdown
+type LaborCost struct {
+	Hours float64 `json:"hours"`
+	Cost  Money   `json:"cost"`
+}
+
+// Add adds hours and their cost
+func (c *LaborCost) Add(hours float64, cost Money) {
+	c.Hours += hours
+	c.Cost += cost
+}
+
+// ResourceLaborCost is the labor cost of one resource allocation
+type ResourceLaborCost struct {
+	LaborCost
+	ProjectResourceID uint       `json:"project_resource_id"`
+	HumanResourceID   uint       `json:"human_resource_id"`
+	Name              string     `json:"name"`
+	RoleName          string     `json:"role_name"`
+	RoleLevel         uint       `json:"role_level"`
+	Allocation        float64    `json:"allocation"`
+	StartDate         *time.Time `json:"start_date"` // The allocation's own dates, or else the project's
+	EndDate           *time.Time `json:"end_date"`
+	CostOverride      bool       `json:"cost_override"`  // The cost was entered by hand and not calculated
+	UnpricedHours     float64    `json:"unpriced_hours"` // Hours no rate card in the project currency applies to; they add no cost
+}
+
+// RoleLaborCost is the labor cost of the resources in one role at one level
+type RoleLaborCost struct {
+	LaborCost
+	RoleName  string `json:"role_name"`
+	RoleLevel uint   `json:"role_level"`
+}
+
+// MilestoneLaborCost is the share of labor cost planned on the tasks of one milestone
+type MilestoneLaborCost struct {
+	LaborCost
+	MilestoneID *uint  `json:"milestone_id"` // Nil for labor not planned on a milestone's tasks
+	Name        string `json:"name"`
+}
+
+// MonthLaborCost is the labor cost of one calendar month
+type MonthLaborCost struct {
+	LaborCost
+	Month string `json:"month"` // Such as 2026-01
+}
+
+// ProjectLaborCost is the labor cost of a project's active resource allocations from their allocation,
+// dates, the project calendar and the rate cards that apply on each working day. Resources are shared
+// among milestones by the hours planned in their task assignments; resources without dates have no
+// hours and are only counted when their cost is overridden.
+type ProjectLaborCost struct {
+	LaborCost
+	ProjectID     uint                  `json:"project_id"`
+	Currency      string                `json:"currency"`
+	UnpricedHours float64               `json:"unpriced_hours"`
+	Resources     []*ResourceLaborCost  `json:"resources"`
+	Roles         []*RoleLaborCost      `json:"roles"`      // Ordered by role name and level
+	Milestones    []*MilestoneLaborCost `json:"milestones"` // Ordered by milestone end date, then labor not planned on a milestone
+	Months        []*MonthLaborCost     `json:"months"`     // Ordered by month
+}
@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+// FullTimeUnits is the booking of a human resource working full time, in percent
+const FullTimeUnits = 100.0
+
+// TaskBooking is the share of a human resource's time a task takes while it runs
+type TaskBooking struct {
+	TaskID          uint    `json:"task_id"`
+	HumanResourceID uint    `json:"human_resource_id"`
+	Units           float64 `json:"units"` // Percentage of the resource's working time (100 = full time)
+}
+
+// ResourceOverload is a day on which a human resource is booked over 100%
+type ResourceOverload struct {
+	HumanResourceID uint      `json:"human_resource_id"`
+	Date            time.Time `json:"date"`
+	LoadPercent     float64   `json:"load_percent"`
+	TaskIDs         []uint    `json:"task_ids"` // Booked tasks running on that day
+}
+
+// LevelingMove is a task whose start changed during resource leveling.
+// Tasks only pushed back by their predecessors have no human resource and no own delay.
+type LevelingMove struct {
+	TaskID          uint      `json:"task_id"`
+	TaskName        string    `json:"task_name"`
+	ProjectID       uint      `json:"project_id"`
+	HumanResourceID uint      `json:"human_resource_id"` // Overloaded resource the task was delayed for
+	OldStart        time.Time `json:"old_start"`
+	NewStart        time.Time `json:"new_start"`
+	OldFinish       time.Time `json:"old_finish"`
+	NewFinish       time.Time `json:"new_finish"`
+	DelayHours      float64   `json:"delay_hours"`  // Leveling delay of the task in working hours
+	WasCritical     bool      `json:"was_critical"` // The task was on its project's critical path before leveling
+}
+
+// LevelingReport is the outcome of a resource leveling run
+type LevelingReport struct {
+	Preview    bool                `json:"preview"` // True when nothing was saved
+	ProjectIDs []uint              `json:"project_ids"`
+	Moves      []*LevelingMove     `json:"moves"`
+	Unresolved []*ResourceOverload `json:"unresolved"` // Overloads no task could be delayed for
+	Iterations int                 `json:"iterations"`
+}
+
+// IsLeveled returns true if no overload was left after leveling
+func (r *LevelingReport) IsLeveled() bool {
+	return len(r.Unresolved) == 0
+}
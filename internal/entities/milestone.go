@@ -15,10 +15,11 @@ const (
 )
 
 var (
-	ErrMilestoneNameRequired      = errors.New("milestone name is required")
-	ErrMilestoneInvalidStatus     = errors.New("milestone status must be 1 (inactive) or 2 (active)")
-	ErrMilestoneInvalidProjectID  = errors.New("milestone must belong to a project")
-	ErrMilestoneInvalidDates      = errors.New("milestone end date must be on or after start date")
+	ErrMilestoneNameRequired     = errors.New("milestone name is required")
+	ErrMilestoneInvalidStatus    = errors.New("milestone status must be 1 (inactive) or 2 (active)")
+	ErrMilestoneInvalidProjectID = errors.New("milestone must belong to a project")
+	ErrMilestoneInvalidDates     = errors.New("milestone end date must be on or after start date")
+	ErrMilestoneInvalidBudget    = errors.New("milestone budget must be non-negative")
 
 	MilestoneAllowedSortField = map[string]string{
 		"id":          "id",
@@ -28,6 +29,7 @@ var (
 		"start_date":  "start_date",
 		"end_date":    "end_date",
 		"status":      "status",
+		"budget":      "budget",
 		"created_at":  "created_at",
 		"updated_at":  "updated_at",
 	}
@@ -42,6 +44,7 @@ type Milestone struct {
 	StartDate   *time.Time `gorm:"" json:"start_date"`
 	EndDate     *time.Time `gorm:"" json:"end_date"`
 	Status      uint       `gorm:"not null;default:2" json:"status"`
+	Budget      Money      `gorm:"not null;default:0" json:"budget"` // Approved budget in the project currency; 0 means none
 	CreatedAt   time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
 	UpdatedAt   time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
 
@@ -82,6 +85,10 @@ func (m *Milestone) Validate() error {
 		}
 	}
 
+	if m.Budget < 0 {
+		return ErrMilestoneInvalidBudget
+	}
+
 	// Validate status
 	if err := m.validateStatus(); err != nil {
 		return err
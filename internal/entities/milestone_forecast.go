@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// MilestoneForecast is the expected completion of a milestone given the work left on its tasks
+type MilestoneForecast struct {
+	MilestoneID     uint       `json:"milestone_id"`
+	ProjectID       uint       `json:"project_id"`
+	Name            string     `json:"name"`
+	DueDate         *time.Time `json:"due_date"`         // Milestone end date
+	RemainingEffort float64    `json:"remaining_effort"` // Hours of work left on the milestone's tasks
+	Capacity        float64    `json:"capacity"`         // Full-time equivalents of the people assigned to the milestone's open tasks
+	ForecastDate    *time.Time `json:"forecast_date"`    // Nil when work is left but no one is allocated
+	SlipDays        int        `json:"slip_days"`        // Calendar days the forecast is past the due date
+	IsSlipping      bool       `json:"is_slipping"`
+	IsComplete      bool       `json:"is_complete"`
+	Changed         bool       `json:"changed"` // The forecast is the first one or differs from the one computed before
+}
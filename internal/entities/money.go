@@ -0,0 +1,248 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// MoneyScale is the number of Money units in one unit of a currency
+const MoneyScale = 10000
+
+// moneyDecimals is the number of decimal digits Money holds
+const moneyDecimals = 4
+
+var (
+	ErrInvalidMoney = errors.New("money amount must be a decimal number with at most 4 decimal places")
+
+	// moneyColumns are the columns of each table that hold Money
+	moneyColumns = map[string][]string{
+		"projects":           {"budget"},
+		"milestones":         {"budget"},
+		"project_resources":  {"cost"},
+		"scenario_resources": {"cost"},
+		"rate_cards":         {"cost_rate", "bill_rate"},
+		"cost_items":         {"amount"},
+		"tasks":              {"baseline_cost"},
+	}
+)
+
+// Money is an amount of money held exactly as a whole number of ten-thousandths of the currency unit,
+// so that adding stored amounts does not drift the way binary floating point does. It is stored as an
+// integer and read and written in JSON as a plain decimal number, such as 1234.5.
+type Money int64
+
+// NewMoney converts an amount to Money, rounding it to the nearest ten-thousandth
+func NewMoney(amount float64) Money {
+	return Money(math.Round(amount * MoneyScale))
+}
+
+// ParseMoney parses a decimal number such as "-1234.56" exactly. Digits past the fourth decimal
+// place are rounded half away from zero.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, "eE") {
+		amount, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsInf(amount, 0) || math.Abs(amount) >= math.MaxInt64/MoneyScale {
+			return 0, ErrInvalidMoney
+		}
+		return NewMoney(amount), nil
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	whole, fraction, _ := strings.Cut(s, ".")
+	if (whole == "" && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return 0, ErrInvalidMoney
+	}
+	roundUp := false
+	if len(fraction) > moneyDecimals {
+		roundUp = fraction[moneyDecimals] >= '5'
+		fraction = fraction[:moneyDecimals]
+	}
+	fraction += strings.Repeat("0", moneyDecimals-len(fraction))
+
+	units, err := strconv.ParseInt("0"+whole, 10, 64)
+	if err != nil || units > math.MaxInt64/MoneyScale-1 {
+		return 0, ErrInvalidMoney
+	}
+	cents, _ := strconv.ParseInt(fraction, 10, 64)
+	value := units*MoneyScale + cents
+	if roundUp {
+		value++
+	}
+	if negative {
+		value = -value
+	}
+	return Money(value), nil
+}
+
+// isDigits returns true if s holds only the digits 0 to 9
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64 returns the amount as a float, for ratios and statistics
+func (m Money) Float64() float64 {
+	return float64(m) / MoneyScale
+}
+
+// Mul returns the amount times a factor, such as a quantity or an exchange rate, rounded to the
+// nearest ten-thousandth
+func (m Money) Mul(factor float64) Money {
+	return Money(math.Round(float64(m) * factor))
+}
+
+// Round rounds the amount half away from zero to the minor unit of the currency, such as cents for
+// USD or whole dong for VND
+func (m Money) Round(currency string) Money {
+	digits := CurrencyMinorUnits(currency)
+	if digits >= moneyDecimals {
+		return m
+	}
+	unit := Money(math.Pow10(moneyDecimals - digits))
+	rounded, rest := m-m%unit, m%unit
+	switch {
+	case rest*2 >= unit:
+		rounded += unit
+	case rest*2 <= -unit:
+		rounded -= unit
+	}
+	return rounded
+}
+
+// String returns the amount as a decimal number without trailing zeros, such as 1234.5
+func (m Money) String() string {
+	sign, value := "", uint64(m)
+	if m < 0 {
+		sign, value = "-", uint64(-m)
+	}
+	s := sign + strconv.FormatUint(value/MoneyScale, 10)
+	if fraction := value % MoneyScale; fraction != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%0*d", moneyDecimals, fraction), "0")
+	}
+	return s
+}
+
+// MarshalJSON writes the amount as a JSON number
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON reads the amount from a JSON number or a string holding one
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	value, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = value
+	return nil
+}
+
+// Value implements driver.Valuer, storing the amount as an integer number of ten-thousandths
+func (m Money) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+// Scan implements sql.Scanner
+func (m *Money) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = 0
+	case int64:
+		*m = Money(v)
+	case float64:
+		*m = Money(math.Round(v))
+	case []byte:
+		return m.scanText(string(v))
+	case string:
+		return m.scanText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", value)
+	}
+	return nil
+}
+
+// scanText scans a stored amount held as text
+func (m *Money) scanText(s string) error {
+	value, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return fmt.Errorf("cannot scan %q into Money: %w", s, err)
+	}
+	*m = Money(math.Round(value))
+	return nil
+}
+
+// ScaleMoneyColumns converts the money of a database from before Money, held in REAL columns as
+// amounts of the currency unit, to ten-thousandths. Each column is rebuilt as an INTEGER column in
+// the same transaction that scales it, so that it is scaled only once even if a later migration
+// fails. Databases kept up to date with the SQL migrations are scaled by those and left alone.
+func ScaleMoneyColumns(db *gorm.DB) error {
+	if db.Migrator().HasTable("schema_migrations") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		migrator := tx.Migrator()
+		for table, columns := range moneyColumns {
+			if !migrator.HasTable(table) {
+				continue
+			}
+			columnTypes, err := migrator.ColumnTypes(table)
+			if err != nil {
+				return err
+			}
+			for _, column := range columnTypes {
+				if !slices.Contains(columns, column.Name()) || !strings.EqualFold(column.DatabaseTypeName(), "real") {
+					continue
+				}
+				if err := scaleMoneyColumn(tx, table, column); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// scaleMoneyColumn replaces a REAL column with an INTEGER column of the same name holding its
+// amounts in ten-thousandths. Money columns are not indexed, so the old column can be dropped.
+func scaleMoneyColumn(tx *gorm.DB, table string, column gorm.ColumnType) error {
+	name := column.Name()
+	scaled := name + "_scaled"
+	definition := "INTEGER DEFAULT 0"
+	if nullable, ok := column.Nullable(); ok && !nullable {
+		definition = "INTEGER NOT NULL DEFAULT 0"
+	}
+	statements := []string{
+		fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `%s` %s", table, scaled, definition),
+		fmt.Sprintf("UPDATE `%s` SET `%s` = CAST(ROUND(`%s` * %d) AS INTEGER)", table, scaled, name, MoneyScale),
+		fmt.Sprintf("ALTER TABLE `%s` DROP COLUMN `%s`", table, name),
+		fmt.Sprintf("ALTER TABLE `%s` RENAME COLUMN `%s` TO `%s`", table, scaled, name),
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Money
+		wantErr error
+	}{
+		{"Whole", "1200", 12000000, nil},
+		{"Cents", "0.1", 1000, nil},
+		{"Negative", "-1234.5678", -12345678, nil},
+		{"Leading point", ".25", 2500, nil},
+		{"Rounds the fifth decimal half up", "0.00005", 1, nil},
+		{"Rounds the fifth decimal down", "0.00004", 0, nil},
+		{"Exponent", "1e3", 10000000, nil},
+		{"Invalid: Empty", "", 0, ErrInvalidMoney},
+		{"Invalid: Text", "12a", 0, ErrInvalidMoney},
+		{"Invalid: Two signs", "--1", 0, ErrInvalidMoney},
+		{"Invalid: Too large", "9999999999999999", 0, ErrInvalidMoney},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.input)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	// Ten payments of 0.1 add up exactly
+	var total Money
+	for i := 0; i < 10; i++ {
+		total += NewMoney(0.1)
+	}
+	assert.Equal(t, NewMoney(1), total)
+
+	assert.Equal(t, NewMoney(1500), NewMoney(300).Mul(5))
+	assert.Equal(t, Money(3333), NewMoney(1).Mul(1.0/3))
+	assert.InDelta(t, 12.5, NewMoney(12.5).Float64(), 1e-12)
+
+	assert.Equal(t, "1234.5", NewMoney(1234.5).String())
+	assert.Equal(t, "-0.0001", Money(-1).String())
+	assert.Equal(t, "0", Money(0).String())
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Money
+		currency string
+		want     Money
+	}{
+		{"Cents", NewMoney(10.125), "USD", NewMoney(10.13)},
+		{"Cents down", NewMoney(10.1249), "EUR", NewMoney(10.12)},
+		{"Negative half away from zero", NewMoney(-10.125), "USD", NewMoney(-10.13)},
+		{"Whole dong", NewMoney(25412.5), "VND", NewMoney(25413)},
+		{"Three decimals", NewMoney(1.23456), "KWD", NewMoney(1.235)},
+		{"Four decimals are kept", NewMoney(1.2345), "CLF", NewMoney(1.2345)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.amount.Round(tt.currency))
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var item struct {
+		Amount Money  `json:"amount"`
+		Budget Money  `json:"budget"`
+		Cost   *Money `json:"cost"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount": 19.99, "budget": "250000.5", "cost": null}`), &item))
+	assert.Equal(t, Money(199900), item.Amount)
+	assert.Equal(t, NewMoney(250000.5), item.Budget)
+	assert.Nil(t, item.Cost)
+
+	data, err := json.Marshal(item)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount": 19.99, "budget": 250000.5, "cost": null}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount": "abc"}`), &item))
+}
+
+func TestScaleMoneyColumns(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// A database from before Money holds amounts of the currency unit in REAL columns
+	assert.NoError(t, db.Exec("CREATE TABLE cost_items (id INTEGER PRIMARY KEY, name TEXT, amount REAL NOT NULL DEFAULT 0)").Error)
+	assert.NoError(t, db.Exec("INSERT INTO cost_items (id, name, amount) VALUES (1, 'Hosting', 19.99), (2, 'Licence', 1200)").Error)
+	// Columns that are already integers are left alone
+	assert.NoError(t, db.Exec("CREATE TABLE projects (id INTEGER PRIMARY KEY, budget INTEGER NOT NULL DEFAULT 0)").Error)
+	assert.NoError(t, db.Exec("INSERT INTO projects (id, budget) VALUES (1, 5000000)").Error)
+
+	assert.NoError(t, ScaleMoneyColumns(db))
+	var amounts []Money
+	assert.NoError(t, db.Raw("SELECT amount FROM cost_items ORDER BY id").Scan(&amounts).Error)
+	assert.Equal(t, []Money{NewMoney(19.99), NewMoney(1200)}, amounts)
+	var budget Money
+	assert.NoError(t, db.Raw("SELECT budget FROM projects").Scan(&budget).Error)
+	assert.Equal(t, NewMoney(500), budget)
+
+	// Scaled columns are integers and are not scaled again
+	columnTypes, err := db.Migrator().ColumnTypes("cost_items")
+	assert.NoError(t, err)
+	for _, column := range columnTypes {
+		if column.Name() == "amount" {
+			assert.Equal(t, "INTEGER", column.DatabaseTypeName())
+		}
+	}
+	assert.NoError(t, ScaleMoneyColumns(db))
+	assert.NoError(t, db.Raw("SELECT amount FROM cost_items ORDER BY id").Scan(&amounts).Error)
+	assert.Equal(t, []Money{NewMoney(19.99), NewMoney(1200)}, amounts)
+
+	// Databases upgraded by the SQL migrations are not scaled again
+	assert.NoError(t, db.Exec("CREATE TABLE schema_migrations (version INTEGER, dirty BOOLEAN)").Error)
+	assert.NoError(t, ScaleMoneyColumns(db))
+	assert.NoError(t, db.Raw("SELECT amount FROM cost_items ORDER BY id").Scan(&amounts).Error)
+	assert.Equal(t, []Money{NewMoney(19.99), NewMoney(1200)}, amounts)
+}
+
+func TestCurrencies(t *testing.T) {
+	for _, code := range []string{"USD", "EUR", "VND", "JPY", "KWD"} {
+		assert.True(t, IsValidCurrency(code), code)
+	}
+	for _, code := range []string{"", "usd", "US", "XYZ", "BTC"} {
+		assert.False(t, IsValidCurrency(code), code)
+	}
+	assert.Equal(t, "EUR", NormalizeCurrency(" eur "))
+	assert.Equal(t, 2, CurrencyMinorUnits("USD"))
+	assert.Equal(t, 0, CurrencyMinorUnits("VND"))
+	assert.Equal(t, 3, CurrencyMinorUnits("KWD"))
+}
@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPortfolioInvalidCurrency = errors.New("portfolio reporting currency must be an ISO 4217 code such as USD, EUR or VND")
+)
+
+// PortfolioCostParams selects the projects of a portfolio cost report and the currency it is reported in
+type PortfolioCostParams struct {
+	ProjectID_In      []uint    `json:"project_id_in"` // Empty for all projects
+	ClientID_In       []uint    `json:"client_id_in"`
+	Status_In         []uint    `json:"status_in"`
+	ReportingCurrency string    `json:"reporting_currency"`
+	Date              time.Time `json:"date"` // Exchange rates in effect on this date are used; zero is today
+}
+
+// PortfolioProjectCost is the budget and cost of one project in its own currency and in the
+// reporting currency
+type PortfolioProjectCost struct {
+	ProjectID       uint          `json:"project_id"`
+	Name            string        `json:"name"`
+	ClientID        uint          `json:"client_id"`
+	Currency        string        `json:"currency"`
+	Rate            float64       `json:"rate"` // Units of the reporting currency one unit of the project currency buys on the date
+	Budget          Money         `json:"budget"`
+	Cost            CostBreakdown `json:"cost"`
+	ReportingBudget Money         `json:"reporting_budget"`
+	ReportingCost   CostBreakdown `json:"reporting_cost"`
+}
+
+// PortfolioCost is the budget and cost of several projects converted into one reporting currency at
+// the exchange rates in effect on one date, so that the same date and rates always give the same
+// totals. Each converted amount is rounded to the minor unit of the reporting currency before it is
+// added up. A project without a currency is taken to be in the reporting currency.
+type PortfolioCost struct {
+	CostBreakdown
+	ReportingCurrency string                  `json:"reporting_currency"`
+	Date              time.Time               `json:"date"`
+	Budget            Money                   `json:"budget"`
+	Projects          []*PortfolioProjectCost `json:"projects"` // Ordered by project ID
+}
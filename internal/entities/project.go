@@ -19,6 +19,16 @@ const (
 	ProjectStatusActive   = 2
 )
 
+// ProjectMethodology is the way a project plans and tracks its work
+type ProjectMethodology string
+
+// Project methodologies
+const (
+	ProjectMethodologyWaterfall ProjectMethodology = "waterfall" // Phases, milestones and a critical path schedule
+	ProjectMethodologyAgile     ProjectMethodology = "agile"     // Sprints, story points and velocity
+	ProjectMethodologyHybrid    ProjectMethodology = "hybrid"    // Sprints within a milestone plan
+)
+
 var (
 	ErrProjectNameRequired           = errors.New("project name is required")
 	ErrProjectInvalidStatus          = errors.New("project status must be 1 (inactive) or 2 (active)")
@@ -29,15 +39,24 @@ var (
 	ErrProjectInvalidWorkingDays     = errors.New("working days must contain valid weekdays (Sunday=0 to Saturday=6)")
 	ErrProjectDuplicateWorkingDays   = errors.New("working days must not contain duplicates")
 	ErrProjectWorkingDaysExceedsWeek = errors.New("working days cannot exceed 7 days")
+	ErrProjectInvalidTimezone        = errors.New("timezone must be a valid IANA time zone name such as Asia/Ho_Chi_Minh")
+	ErrProjectInvalidEffortUnit      = errors.New("effort unit must be hours, days, man_weeks or man_months")
+	ErrProjectInvalidMethodology     = errors.New("methodology must be waterfall, agile or hybrid")
+	ErrProjectInvalidType            = errors.New("project type must be product, service, internal, consulting, research or maintenance")
+	ErrProjectInvalidBudget          = errors.New("project budget must be non-negative")
+	ErrProjectInvalidCurrency        = errors.New("currency must be an ISO 4217 code such as USD, EUR or VND")
 
 	ProjectAllowedSortField = map[string]string{
 		"id":          "id",
 		"name":        "name",
 		"description": "description",
+		"type":        "type",
+		"methodology": "methodology",
 		"client_id":   "client_id",
 		"start_date":  "start_date",
 		"end_date":    "end_date",
 		"status":      "status",
+		"budget":      "budget",
 		"created_at":  "created_at",
 		"updated_at":  "updated_at",
 	}
@@ -45,25 +64,31 @@ var (
 
 // Project represents a project entity
 type Project struct {
-	ID          uint       `gorm:"primary_key" json:"id"`
-	Name        string     `gorm:"not null" json:"name"`
-	Description string     `gorm:"type:text" json:"description"`
-	ClientID    uint       `gorm:"not null;index" json:"client_id"`
-	StartDate   *time.Time `gorm:"" json:"start_date"`
-	EndDate     *time.Time `gorm:"" json:"end_date"`
-	Status      uint       `gorm:"not null;default:2" json:"status"`
-	CreatedAt   time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
-	UpdatedAt   time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+	ID          uint               `gorm:"primary_key" json:"id"`
+	Name        string             `gorm:"not null" json:"name"`
+	Description string             `gorm:"type:text" json:"description"`
+	Type        ProjectType        `gorm:"default:'';index" json:"type"`                 // Kind of project, such as product or service; empty means not set
+	Methodology ProjectMethodology `gorm:"default:'waterfall';index" json:"methodology"` // Empty means waterfall
+	ClientID    uint               `gorm:"not null;index" json:"client_id"`
+	StartDate   *time.Time         `gorm:"" json:"start_date"`
+	EndDate     *time.Time         `gorm:"" json:"end_date"`
+	CalendarID  *uint              `gorm:"index" json:"calendar_id"` // Working calendar; nil uses the weekday configuration below
+	Status      uint               `gorm:"not null;default:2" json:"status"`
+	CreatedAt   time.Time          `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time          `gorm:"autoUpdateTime:milli" json:"updated_at"`
 
 	// Configurations
 	HoursPerDay        int          `gorm:"default:8" json:"hours_per_day"`
 	DaysPerWeek        int          `gorm:"default:5" json:"days_per_week"`
 	WorkingDaysPerWeek WeekdayArray `gorm:"type:text" json:"working_days_per_week"`
-	Timezone           string       `gorm:"default:''" json:"timezone"`
+	Timezone           string       `gorm:"default:''" json:"timezone"` // IANA time zone name; empty means UTC
 	Currency           string       `gorm:"default:''" json:"currency"`
+	Budget             Money        `gorm:"not null;default:0" json:"budget"`   // Approved budget in the project currency; 0 means none
+	EffortUnit         EffortUnit   `gorm:"default:'hours'" json:"effort_unit"` // Unit effort is displayed in; empty means hours
 
 	// Relationships
 	Client           *Client            `gorm:"foreignKey:ClientID" json:"client,omitempty"`
+	Calendar         *Calendar          `gorm:"foreignKey:CalendarID;constraint:-" json:"calendar,omitempty"` // No database constraint; deleting a calendar clears the link
 	ProjectResources []*ProjectResource `gorm:"foreignKey:ProjectID" json:"project_resources,omitempty"`
 	ProjectRoles     []*ProjectRole     `gorm:"foreignKey:ProjectID" json:"project_roles,omitempty"`
 }
@@ -78,6 +103,23 @@ func (p *Project) IsActive() bool {
 	return p.Status == ProjectStatusActive
 }
 
+// GetMethodology returns the project's methodology, or waterfall if not set
+func (p *Project) GetMethodology() ProjectMethodology {
+	if p.Methodology == "" {
+		return ProjectMethodologyWaterfall
+	}
+	return p.Methodology
+}
+
+// UsesSprints returns true if the project plans its work in sprints
+func (p *Project) UsesSprints() bool {
+	switch p.GetMethodology() {
+	case ProjectMethodologyAgile, ProjectMethodologyHybrid:
+		return true
+	}
+	return false
+}
+
 // GetHoursPerDay returns the project's hours per day or the default if not set
 func (p *Project) GetHoursPerDay() int {
 	if p.HoursPerDay == 0 {
@@ -94,6 +136,59 @@ func (p *Project) GetDaysPerWeek() int {
 	return p.DaysPerWeek
 }
 
+// GetDaysPerMonth returns the working days in a man-month: four of the project's working weeks
+func (p *Project) GetDaysPerMonth() float64 {
+	return float64(p.GetDaysPerWeek()) * DefaultWeeksPerMonth
+}
+
+// GetEffortUnit returns the unit the project displays effort in, or hours if not set
+func (p *Project) GetEffortUnit() EffortUnit {
+	if p.EffortUnit == "" {
+		return EffortUnitHours
+	}
+	return p.EffortUnit
+}
+
+// EffortToHours converts effort in the given unit to hours using the project's hours per day
+// and days per week. An empty unit is the project's display unit.
+func (p *Project) EffortToHours(value float64, unit EffortUnit) (float64, error) {
+	hoursPerDay, daysPerWeek := float64(p.GetHoursPerDay()), float64(p.GetDaysPerWeek())
+	if unit == "" {
+		unit = p.GetEffortUnit()
+	}
+	switch unit {
+	case EffortUnitHours:
+		return value, nil
+	case EffortUnitDays:
+		return DaysToHoursCustom(value, hoursPerDay), nil
+	case EffortUnitManWeeks:
+		return WeeksToHoursCustom(value, hoursPerDay, daysPerWeek), nil
+	case EffortUnitManMonths:
+		return MonthsToHoursCustom(value, hoursPerDay, p.GetDaysPerMonth()), nil
+	}
+	return 0, ErrProjectInvalidEffortUnit
+}
+
+// EffortFromHours converts hours of effort to the given unit using the project's hours per day
+// and days per week. An empty unit is the project's display unit.
+func (p *Project) EffortFromHours(hours float64, unit EffortUnit) (float64, error) {
+	hoursPerDay, daysPerWeek := float64(p.GetHoursPerDay()), float64(p.GetDaysPerWeek())
+	if unit == "" {
+		unit = p.GetEffortUnit()
+	}
+	switch unit {
+	case EffortUnitHours:
+		return hours, nil
+	case EffortUnitDays:
+		return HoursToDaysCustom(hours, hoursPerDay), nil
+	case EffortUnitManWeeks:
+		return HoursToWeeksCustom(hours, hoursPerDay, daysPerWeek), nil
+	case EffortUnitManMonths:
+		return HoursToMonthsCustom(hours, hoursPerDay, p.GetDaysPerMonth()), nil
+	}
+	return 0, ErrProjectInvalidEffortUnit
+}
+
 // GetWorkingDaysPerWeek returns the project's working days or the default if not set
 func (p *Project) GetWorkingDaysPerWeek() WeekdayArray {
 	if len(p.WorkingDaysPerWeek) == 0 {
@@ -102,13 +197,49 @@ func (p *Project) GetWorkingDaysPerWeek() WeekdayArray {
 	return p.WorkingDaysPerWeek
 }
 
+// Location returns the project's time zone, or UTC if none is set or it cannot be loaded
+func (p *Project) Location() *time.Location {
+	if p.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// DateOf returns the start of the calendar day that t falls on in the project's time zone.
+// Project, milestone and resource dates are calendar days, so they are stored this way.
+func (p *Project) DateOf(t time.Time) time.Time {
+	loc := p.Location()
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
+
+// Today returns the current calendar day in the project's time zone
+func (p *Project) Today(now time.Time) time.Time {
+	return p.DateOf(now)
+}
+
+// BaseCalendar returns an unnamed calendar built from the project's working days and hours per day.
+// It is used when the project has no named calendar.
+func (p *Project) BaseCalendar() *Calendar {
+	return &Calendar{
+		Name:        p.Name,
+		WorkingDays: p.GetWorkingDaysPerWeek(),
+		HoursPerDay: float64(p.GetHoursPerDay()),
+	}
+}
+
 // Validate validates the project fields
 func (p *Project) Validate() error {
 	// Trim whitespace from string fields
 	p.Name = strings.TrimSpace(p.Name)
 	p.Description = strings.TrimSpace(p.Description)
+	p.Type = ProjectType(strings.TrimSpace(string(p.Type)))
 	p.Timezone = strings.TrimSpace(p.Timezone)
-	p.Currency = strings.TrimSpace(p.Currency)
+	p.Currency = NormalizeCurrency(p.Currency)
 
 	// Validate required fields
 	if p.Name == "" {
@@ -146,6 +277,58 @@ func (p *Project) Validate() error {
 		return err
 	}
 
+	// Validate timezone
+	if err := p.validateTimezone(); err != nil {
+		return err
+	}
+
+	// Validate effort unit (empty is allowed and means hours)
+	if p.EffortUnit != "" && !IsValidEffortUnit(p.EffortUnit) {
+		return ErrProjectInvalidEffortUnit
+	}
+
+	// Validate type (empty is allowed and means not set)
+	if p.Type != "" && !IsValidProjectType(p.Type) {
+		return ErrProjectInvalidType
+	}
+
+	// Validate methodology (empty is allowed and means waterfall)
+	if p.Methodology != "" && !IsValidProjectMethodology(p.Methodology) {
+		return ErrProjectInvalidMethodology
+	}
+
+	// Validate currency (empty is allowed and means none set)
+	if p.Currency != "" && !IsValidCurrency(p.Currency) {
+		return ErrProjectInvalidCurrency
+	}
+
+	if p.Budget < 0 {
+		return ErrProjectInvalidBudget
+	}
+
+	return nil
+}
+
+// IsValidProjectMethodology checks if the project methodology is valid
+func IsValidProjectMethodology(m ProjectMethodology) bool {
+	switch m {
+	case ProjectMethodologyWaterfall, ProjectMethodologyAgile, ProjectMethodologyHybrid:
+		return true
+	}
+	return false
+}
+
+func (p *Project) validateTimezone() error {
+	if p.Timezone == "" {
+		return nil // Empty is allowed (will use UTC)
+	}
+	// "Local" depends on the machine running the app, so it is not accepted
+	if p.Timezone == "Local" {
+		return ErrProjectInvalidTimezone
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return ErrProjectInvalidTimezone
+	}
 	return nil
 }
 
@@ -203,22 +386,27 @@ func (p *Project) BeforeUpdate(tx *gorm.DB) error {
 }
 
 type ProjectQueryParams struct {
-	ID_In            []uint     `json:"id_in"`
-	Name             string     `json:"name"`
-	Name_Like        string     `json:"name_like"`
-	Description_Like string     `json:"description_like"`
-	ClientID         uint       `json:"client_id"`
-	ClientID_In      []uint     `json:"client_id_in"`
-	Status           uint       `json:"status"`
-	Status_In        []uint     `json:"status_in"`
-	StartDate_Gte    *time.Time `json:"start_date_gte"`
-	StartDate_Lte    *time.Time `json:"start_date_lte"`
-	EndDate_Gte      *time.Time `json:"end_date_gte"`
-	EndDate_Lte      *time.Time `json:"end_date_lte"`
-	CreatedAt_Gte    *time.Time `json:"created_at_gte"`
-	CreatedAt_Lte    *time.Time `json:"created_at_lte"`
-	UpdatedAt_Gte    *time.Time `json:"updated_at_gte"`
-	UpdatedAt_Lte    *time.Time `json:"updated_at_lte"`
+	ID_In            []uint               `json:"id_in"`
+	Name             string               `json:"name"`
+	Name_Like        string               `json:"name_like"`
+	Description_Like string               `json:"description_like"`
+	Type             ProjectType          `json:"type"`
+	Type_In          []ProjectType        `json:"type_in"`
+	Methodology      ProjectMethodology   `json:"methodology"`
+	Methodology_In   []ProjectMethodology `json:"methodology_in"`
+	ClientID         uint                 `json:"client_id"`
+	ClientID_In      []uint               `json:"client_id_in"`
+	CalendarID       uint                 `json:"calendar_id"`
+	Status           uint                 `json:"status"`
+	Status_In        []uint               `json:"status_in"`
+	StartDate_Gte    *time.Time           `json:"start_date_gte"`
+	StartDate_Lte    *time.Time           `json:"start_date_lte"`
+	EndDate_Gte      *time.Time           `json:"end_date_gte"`
+	EndDate_Lte      *time.Time           `json:"end_date_lte"`
+	CreatedAt_Gte    *time.Time           `json:"created_at_gte"`
+	CreatedAt_Lte    *time.Time           `json:"created_at_lte"`
+	UpdatedAt_Gte    *time.Time           `json:"updated_at_gte"`
+	UpdatedAt_Lte    *time.Time           `json:"updated_at_lte"`
 	*QueryParams
 }
 
@@ -0,0 +1,76 @@
+package entities
+
+// CostTypeOrder is the order cost types are reported in
+var CostTypeOrder = []CostType{
+	CostTypeLabor,
+	CostTypeMaterial,
+	CostTypeEquipment,
+	CostTypeOverhead,
+	CostTypeInfrastructure,
+	CostTypeService,
+	CostTypeOther,
+}
+
+// CostBreakdown splits a cost into the labor of resource allocations and the cost items
+type CostBreakdown struct {
+	Labor Money `json:"labor"`
+	Items Money `json:"items"`
+	Total Money `json:"total"`
+}
+
+// AddLabor adds labor cost
+func (c *CostBreakdown) AddLabor(cost Money) {
+	c.Labor += cost
+	c.Total += cost
+}
+
+// AddItems adds the cost of cost items
+func (c *CostBreakdown) AddItems(cost Money) {
+	c.Items += cost
+	c.Total += cost
+}
+
+// CostItemCost is what one cost item costs over the project
+type CostItemCost struct {
+	CostItemID  uint          `json:"cost_item_id"`
+	Name        string        `json:"name"`
+	CostType    CostType      `json:"cost_type"`
+	Frequency   CostFrequency `json:"frequency"`
+	MilestoneID *uint         `json:"milestone_id"` // The item's milestone, or its task's; nil if neither
+	Payments    int           `json:"payments"`     // Months the item is paid in
+	Cost        Money         `json:"cost"`
+}
+
+// CostTypeCost is the cost of one cost type; labor is the cost of the resource allocations
+// plus any cost items of type labor
+type CostTypeCost struct {
+	CostType CostType `json:"cost_type"`
+	Cost     Money    `json:"cost"`
+}
+
+// MilestoneCost is the labor and cost items of one milestone
+type MilestoneCost struct {
+	CostBreakdown
+	MilestoneID *uint  `json:"milestone_id"` // Nil for costs not planned on a milestone
+	Name        string `json:"name"`
+}
+
+// MonthCost is the labor and cost items of one calendar month
+type MonthCost struct {
+	CostBreakdown
+	Month string `json:"month"` // Such as 2026-01
+}
+
+// ProjectCost is the total cost of a project: the labor of its resource allocations and its cost
+// items, such as hosting or licences. A one-off item is paid in the month of its start date; a
+// monthly one in every month from its start date to its end date, or else to the project end.
+type ProjectCost struct {
+	CostBreakdown
+	ProjectID  uint              `json:"project_id"`
+	Currency   string            `json:"currency"`
+	LaborCost  *ProjectLaborCost `json:"labor_cost"`
+	CostItems  []*CostItemCost   `json:"cost_items"` // Ordered by start date
+	Types      []*CostTypeCost   `json:"types"`      // In CostTypeOrder, only types with a cost item or labor
+	Milestones []*MilestoneCost  `json:"milestones"` // Ordered by milestone end date, then costs not planned on a milestone
+	Months     []*MonthCost      `json:"months"`     // Ordered by month
+}
@@ -19,6 +19,7 @@ var (
 	ErrProjectResourceInvalidStatus          = errors.New("project resource status must be 1 (inactive) or 2 (active)")
 	ErrProjectResourceInvalidAllocation      = errors.New("allocation percentage must be between 0 and 100")
 	ErrProjectResourceInvalidDates           = errors.New("project resource end date must be after start date")
+	ErrProjectResourceInvalidCost            = errors.New("project resource cost must be non-negative")
 
 	ProjectResourceAllowedSortField = map[string]string{
 		"id":                "id",
@@ -27,6 +28,7 @@ var (
 		"role":              "role",
 		"allocation":        "allocation",
 		"cost":              "cost",
+		"cost_override":     "cost_override",
 		"start_date":        "start_date",
 		"end_date":          "end_date",
 		"status":            "status",
@@ -40,12 +42,13 @@ type ProjectResource struct {
 	ID              uint       `gorm:"primary_key" json:"id"`
 	ProjectID       uint       `gorm:"not null;index;uniqueIndex:idx_project_human_resource" json:"project_id"`
 	HumanResourceID uint       `gorm:"not null;index;uniqueIndex:idx_project_human_resource" json:"human_resource_id"`
-	Role            string     `gorm:"" json:"role"`                  // Role in the project (e.g., "Developer", "Tech Lead", "QA")
-	Allocation      float64    `gorm:"default:100" json:"allocation"` // Allocation percentage (0-100)
-	Cost            float64    `gorm:"default:0" json:"cost"`         // Cost for this resource allocation
-	StartDate       *time.Time `gorm:"" json:"start_date"`            // When the resource starts on the project
-	EndDate         *time.Time `gorm:"" json:"end_date"`              // When the resource ends on the project
-	Notes           string     `gorm:"type:text" json:"notes"`        // Additional notes
+	Role            string     `gorm:"" json:"role"`                                // Role in the project (e.g., "Developer", "Tech Lead", "QA")
+	Allocation      float64    `gorm:"default:100" json:"allocation"`               // Allocation percentage (0-100)
+	Cost            Money      `gorm:"default:0" json:"cost"`                       // Labor cost of this resource allocation, calculated unless overridden
+	CostOverride    bool       `gorm:"not null;default:false" json:"cost_override"` // Cost is entered by hand, e.g. for a fixed-fee contractor
+	StartDate       *time.Time `gorm:"" json:"start_date"`                          // When the resource starts on the project
+	EndDate         *time.Time `gorm:"" json:"end_date"`                            // When the resource ends on the project
+	Notes           string     `gorm:"type:text" json:"notes"`                      // Additional notes
 	Status          uint       `gorm:"not null;default:2" json:"status"`
 	CreatedAt       time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
 	UpdatedAt       time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
@@ -81,6 +84,11 @@ func (pr *ProjectResource) Validate() error {
 		return ErrProjectResourceInvalidAllocation
 	}
 
+	// Validate cost
+	if pr.Cost < 0 {
+		return ErrProjectResourceInvalidCost
+	}
+
 	// Validate dates
 	if pr.StartDate != nil && pr.EndDate != nil {
 		if pr.EndDate.Before(*pr.StartDate) {
@@ -129,8 +137,9 @@ type ProjectResourceQueryParams struct {
 	Role_Like          string     `json:"role_like"`
 	Allocation_Gte     *float64   `json:"allocation_gte"`
 	Allocation_Lte     *float64   `json:"allocation_lte"`
-	Cost_Gte           *float64   `json:"cost_gte"`
-	Cost_Lte           *float64   `json:"cost_lte"`
+	Cost_Gte           *Money     `json:"cost_gte"`
+	Cost_Lte           *Money     `json:"cost_lte"`
+	CostOverride       *bool      `json:"cost_override"`
 	Status             uint       `json:"status"`
 	Status_In          []uint     `json:"status_in"`
 	StartDate_Gte      *time.Time `json:"start_date_gte"`
@@ -149,3 +158,20 @@ type ProjectResourceListResponse struct {
 	Data  []*ProjectResource `json:"data"`
 	Total int64              `json:"total"`
 }
+
+// KeepLegacyCostOverrides marks the allocation costs of a database from before costs were calculated
+// as entered by hand, so that recalculating them does not overwrite them. It adds the cost_override
+// column before AutoMigrate does and marks the costs in the same transaction, so that they are marked
+// only once. Databases kept up to date with the SQL migrations are marked by those and left alone.
+func KeepLegacyCostOverrides(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if migrator.HasTable("schema_migrations") || !migrator.HasTable(&ProjectResource{}) || migrator.HasColumn(&ProjectResource{}, "CostOverride") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().AddColumn(&ProjectResource{}, "CostOverride"); err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE project_resources SET cost_override = ? WHERE cost > 0", true).Error
+	})
+}
@@ -144,6 +144,18 @@ func TestProjectResourceValidate(t *testing.T) {
 			},
 			wantError: ErrProjectResourceInvalidAllocation,
 		},
+		{
+			name: "Invalid cost - negative",
+			pr: ProjectResource{
+				ProjectID:       1,
+				HumanResourceID: 1,
+				Allocation:      100,
+				Cost:            NewMoney(-1),
+				CostOverride:    true,
+				Status:          ProjectResourceStatusActive,
+			},
+			wantError: ErrProjectResourceInvalidCost,
+		},
 		{
 			name: "Valid allocation - zero",
 			pr: ProjectResource{
@@ -701,6 +713,7 @@ func TestProjectResourceAllowedSortFields(t *testing.T) {
 		"role":              "role",
 		"allocation":        "allocation",
 		"cost":              "cost",
+		"cost_override":     "cost_override",
 		"start_date":        "start_date",
 		"end_date":          "end_date",
 		"status":            "status",
@@ -62,6 +62,22 @@ func RoleLevelName(level uint) string {
 	}
 }
 
+// ParseRoleLevel returns the role level with the given name, ignoring case, or RoleLevelUnknown
+func ParseRoleLevel(name string) uint {
+	name = strings.TrimSpace(name)
+	for level := uint(RoleLevelJunior); level <= RoleLevelCLevel; level++ {
+		if strings.EqualFold(name, RoleLevelName(level)) {
+			return level
+		}
+	}
+	return RoleLevelUnknown
+}
+
+// IsValidRoleLevel checks if the role level is one of the known levels
+func IsValidRoleLevel(level uint) bool {
+	return level >= RoleLevelJunior && level <= RoleLevelCLevel
+}
+
 // ProjectRole represents a role within a project with its level and headcount
 type ProjectRole struct {
 	ID        uint      `gorm:"primary_key" json:"id"`
@@ -115,8 +131,7 @@ func (pr *ProjectRole) Validate() error {
 }
 
 func (pr *ProjectRole) validateLevel() error {
-	switch pr.Level {
-	case RoleLevelJunior, RoleLevelMid, RoleLevelSenior, RoleLevelLead, RoleLevelManager, RoleLevelDirector, RoleLevelVP, RoleLevelCLevel:
+	if IsValidRoleLevel(pr.Level) {
 		return nil
 	}
 	return ErrProjectRoleInvalidLevel
@@ -35,6 +35,27 @@ func TestRoleLevelName(t *testing.T) {
 	}
 }
 
+func TestParseRoleLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		level string
+		want  uint
+	}{
+		{"Senior level", "Senior", RoleLevelSenior},
+		{"Lower case", "lead", RoleLevelLead},
+		{"Surrounding spaces", " C-Level ", RoleLevelCLevel},
+		{"Unknown level", "Principal", RoleLevelUnknown},
+		{"Empty", "", RoleLevelUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseRoleLevel(tt.level)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestProjectRoleGetLevelName(t *testing.T) {
 	tests := []struct {
 		name  string
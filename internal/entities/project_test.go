@@ -412,6 +412,201 @@ func TestProjectGetWorkingDaysPerWeek(t *testing.T) {
 	}
 }
 
+func TestProjectValidateTimezone(t *testing.T) {
+	tests := []struct {
+		name      string
+		timezone  string
+		wantError error
+	}{
+		{"Valid: Empty uses UTC", "", nil},
+		{"Valid: UTC", "UTC", nil},
+		{"Valid: Asia/Ho_Chi_Minh", "Asia/Ho_Chi_Minh", nil},
+		{"Valid: America/New_York", "America/New_York", nil},
+		{"Invalid: Unknown zone", "Mars/Olympus_Mons", ErrProjectInvalidTimezone},
+		{"Invalid: Offset", "+07:00", ErrProjectInvalidTimezone},
+		{"Invalid: Local", "Local", ErrProjectInvalidTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := Project{Name: "Test Project", ClientID: 1, Status: ProjectStatusActive, Timezone: tt.timezone}
+			assert.Equal(t, tt.wantError, project.Validate())
+		})
+	}
+}
+
+func TestProjectValidateEffortUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		effortUnit EffortUnit
+		wantError  error
+	}{
+		{"Valid: Empty uses hours", "", nil},
+		{"Valid: Hours", EffortUnitHours, nil},
+		{"Valid: Days", EffortUnitDays, nil},
+		{"Valid: Man-weeks", EffortUnitManWeeks, nil},
+		{"Valid: Man-months", EffortUnitManMonths, nil},
+		{"Invalid: Story points", "points", ErrProjectInvalidEffortUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := Project{Name: "Test Project", ClientID: 1, Status: ProjectStatusActive, EffortUnit: tt.effortUnit}
+			assert.Equal(t, tt.wantError, project.Validate())
+		})
+	}
+}
+
+func TestProjectValidateMethodology(t *testing.T) {
+	tests := []struct {
+		name        string
+		methodology ProjectMethodology
+		wantError   error
+		usesSprints bool
+	}{
+		{"Valid: Empty uses waterfall", "", nil, false},
+		{"Valid: Waterfall", ProjectMethodologyWaterfall, nil, false},
+		{"Valid: Agile", ProjectMethodologyAgile, nil, true},
+		{"Valid: Hybrid", ProjectMethodologyHybrid, nil, true},
+		{"Invalid: Kanban", "kanban", ErrProjectInvalidMethodology, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := Project{Name: "Test Project", ClientID: 1, Status: ProjectStatusActive, Methodology: tt.methodology}
+			assert.Equal(t, tt.wantError, project.Validate())
+			assert.Equal(t, tt.usesSprints, project.UsesSprints())
+		})
+	}
+}
+
+func TestProjectValidateType(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectType ProjectType
+		wantError   error
+	}{
+		{"Valid: Empty", "", nil},
+		{"Valid: Product", ProjectTypeProduct, nil},
+		{"Valid: Maintenance", ProjectTypeMaintenance, nil},
+		{"Invalid: Free text", "Web app", ErrProjectInvalidType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := Project{Name: "Test Project", ClientID: 1, Status: ProjectStatusActive, Type: tt.projectType}
+			assert.Equal(t, tt.wantError, project.Validate())
+		})
+	}
+}
+
+func TestProjectValidateBudget(t *testing.T) {
+	tests := []struct {
+		name      string
+		budget    Money
+		wantError error
+	}{
+		{"Valid: No budget", 0, nil},
+		{"Valid: Budget", NewMoney(250000), nil},
+		{"Invalid: Negative", NewMoney(-1), ErrProjectInvalidBudget},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := Project{Name: "Test Project", ClientID: 1, Status: ProjectStatusActive, Budget: tt.budget}
+			assert.Equal(t, tt.wantError, project.Validate())
+			milestone := Milestone{Name: "Test Milestone", ProjectID: 1, Status: MilestoneStatusActive, Budget: tt.budget}
+			if tt.wantError != nil {
+				assert.Equal(t, ErrMilestoneInvalidBudget, milestone.Validate())
+			} else {
+				assert.NoError(t, milestone.Validate())
+			}
+		})
+	}
+}
+
+func TestProjectValidateCurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		currency  string
+		want      string
+		wantError error
+	}{
+		{"Valid: No currency", "", "", nil},
+		{"Valid: Upper-cased", " eur ", "EUR", nil},
+		{"Valid: No minor unit", "VND", "VND", nil},
+		{"Invalid: Unknown code", "XYZ", "XYZ", ErrProjectInvalidCurrency},
+		{"Invalid: Symbol", "$", "$", ErrProjectInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := Project{Name: "Test Project", ClientID: 1, Status: ProjectStatusActive, Currency: tt.currency}
+			assert.Equal(t, tt.wantError, project.Validate())
+			assert.Equal(t, tt.want, project.Currency)
+		})
+	}
+}
+
+func TestProjectEffortConversion(t *testing.T) {
+	// A 6-hour, 4-day week: a man-week is 24 hours and a man-month 96 hours
+	project := Project{HoursPerDay: 6, DaysPerWeek: 4, EffortUnit: EffortUnitDays}
+	tests := []struct {
+		unit  EffortUnit
+		value float64
+		hours float64
+	}{
+		{EffortUnitHours, 30, 30},
+		{EffortUnitDays, 5, 30},
+		{EffortUnitManWeeks, 2, 48},
+		{EffortUnitManMonths, 1.5, 144},
+		{"", 3, 18}, // The project's display unit
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.unit), func(t *testing.T) {
+			hours, err := project.EffortToHours(tt.value, tt.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.hours, hours)
+
+			value, err := project.EffortFromHours(tt.hours, tt.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+
+	// Unset settings fall back to an 8-hour, 5-day week
+	hours, err := (&Project{}).EffortToHours(1, EffortUnitManMonths)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultHoursPerMonth, hours)
+
+	_, err = project.EffortToHours(1, "points")
+	assert.ErrorIs(t, err, ErrProjectInvalidEffortUnit)
+	_, err = project.EffortFromHours(1, "points")
+	assert.ErrorIs(t, err, ErrProjectInvalidEffortUnit)
+}
+
+func TestProjectLocation(t *testing.T) {
+	assert.Equal(t, time.UTC, (&Project{}).Location())
+	assert.Equal(t, time.UTC, (&Project{Timezone: "Mars/Olympus_Mons"}).Location())
+	assert.Equal(t, "Asia/Ho_Chi_Minh", (&Project{Timezone: "Asia/Ho_Chi_Minh"}).Location().String())
+}
+
+func TestProjectDateOf(t *testing.T) {
+	project := Project{Timezone: "Asia/Ho_Chi_Minh"}
+	loc := project.Location()
+
+	// Local midnight of 2026-01-06 in UTC+7 is still 2026-01-05 in UTC
+	picked := time.Date(2026, 1, 5, 17, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 1, 6, 0, 0, 0, 0, loc), project.DateOf(picked))
+
+	// Late evening in UTC is already the next day in UTC+7
+	assert.Equal(t, time.Date(2026, 1, 7, 0, 0, 0, 0, loc), project.Today(time.Date(2026, 1, 6, 20, 0, 0, 0, time.UTC)))
+
+	// A project without a time zone keeps the UTC day
+	assert.Equal(t, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), (&Project{}).DateOf(picked))
+}
+
 func setupProjectTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	if err != nil {
@@ -612,6 +807,7 @@ func TestProjectBeforeCreateDefaultConfiguration(t *testing.T) {
 	assert.Equal(t, DefaultProjectHoursPerDay, retrieved.HoursPerDay)
 	assert.Equal(t, DefaultProjectDaysPerWeek, retrieved.DaysPerWeek)
 	assert.Equal(t, DefaultWorkingDays(), retrieved.WorkingDaysPerWeek)
+	assert.Equal(t, EffortUnitHours, retrieved.EffortUnit)
 }
 
 func TestProjectBeforeUpdate(t *testing.T) {
@@ -831,10 +1027,13 @@ func TestProjectAllowedSortFields(t *testing.T) {
 		"id":          "id",
 		"name":        "name",
 		"description": "description",
+		"type":        "type",
+		"methodology": "methodology",
 		"client_id":   "client_id",
 		"start_date":  "start_date",
 		"end_date":    "end_date",
 		"status":      "status",
+		"budget":      "budget",
 		"created_at":  "created_at",
 		"updated_at":  "updated_at",
 	}
@@ -0,0 +1,223 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RateSource tells which rate card a resolved rate comes from, from the most to the least specific
+type RateSource string
+
+const (
+	RateSourcePersonProject RateSource = "person_project" // The person's rate in the project
+	RateSourcePerson        RateSource = "person"         // The person's standard rate
+	RateSourceRoleProject   RateSource = "role_project"   // The rate of the person's role and level in the project
+	RateSourceRole          RateSource = "role"           // The standard rate of the role and level
+)
+
+var (
+	ErrRateCardInvalidScope          = errors.New("rate card must apply to either a person or a role name and level")
+	ErrRateCardInvalidRoleLevel      = errors.New("rate card role level must be between 1 (junior) and 8 (C-level)")
+	ErrRateCardInvalidRateType       = errors.New("rate card rate type must be hourly, daily, monthly or fixed")
+	ErrRateCardInvalidRates          = errors.New("rate card cost and bill rates must be non-negative")
+	ErrRateCardInvalidCurrency       = errors.New("rate card currency must be an ISO 4217 code such as USD, EUR or VND")
+	ErrRateCardEffectiveFromRequired = errors.New("rate card effective from date is required")
+	ErrRateCardInvalidDates          = errors.New("rate card effective to date must be on or after effective from date")
+	ErrRateCardOverlap               = errors.New("rate card overlaps another rate card of the same person or role")
+	ErrRateCardNotFound              = errors.New("no rate card applies on the date")
+
+	RateCardAllowedSortField = map[string]string{
+		"id":                "id",
+		"project_id":        "project_id",
+		"human_resource_id": "human_resource_id",
+		"role_name":         "role_name",
+		"role_level":        "role_level",
+		"rate_type":         "rate_type",
+		"cost_rate":         "cost_rate",
+		"bill_rate":         "bill_rate",
+		"currency":          "currency",
+		"effective_from":    "effective_from",
+		"effective_to":      "effective_to",
+		"created_at":        "created_at",
+		"updated_at":        "updated_at",
+	}
+)
+
+// RateCard holds what a person, or anyone in a role at a level, costs and is billed at from one date
+// to another. A rate card without a project is the standard rate; one with a project applies there only.
+// Only the calendar date of the effective dates matters.
+type RateCard struct {
+	ID              uint       `gorm:"primary_key" json:"id"`
+	ProjectID       *uint      `gorm:"index" json:"project_id"`        // Nil for a standard rate
+	HumanResourceID *uint      `gorm:"index" json:"human_resource_id"` // Person the rate applies to; nil for a role rate
+	RoleName        string     `gorm:"index" json:"role_name"`         // Role the rate applies to, matching the project role name; empty for a person rate
+	RoleLevel       uint       `gorm:"not null;default:0" json:"role_level"`
+	RateType        RateType   `gorm:"not null;default:'hourly'" json:"rate_type"`
+	CostRate        Money      `gorm:"not null;default:0" json:"cost_rate"` // What the resource costs per rate type unit
+	BillRate        Money      `gorm:"not null;default:0" json:"bill_rate"` // What the client is billed per rate type unit
+	Currency        string     `gorm:"default:''" json:"currency"`          // Defaults to the project currency
+	EffectiveFrom   time.Time  `gorm:"not null;index" json:"effective_from"`
+	EffectiveTo     *time.Time `gorm:"index" json:"effective_to"` // Last day the rate applies; nil means until further notice
+	Notes           string     `gorm:"type:text" json:"notes"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Project       *Project       `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	HumanResource *HumanResource `gorm:"foreignKey:HumanResourceID;constraint:OnDelete:CASCADE" json:"human_resource,omitempty"`
+}
+
+// TableName returns the table name for the rate card entity
+func (RateCard) TableName() string {
+	return "rate_cards"
+}
+
+// IsPersonRate returns true if the rate card applies to a person rather than a role
+func (r *RateCard) IsPersonRate() bool {
+	return r.HumanResourceID != nil
+}
+
+// AppliesOn returns true if the calendar day of t falls within the effective dates of the rate card
+func (r *RateCard) AppliesOn(t time.Time) bool {
+	day := DateKey(t)
+	return day >= DateKey(r.EffectiveFrom) && (r.EffectiveTo == nil || day <= DateKey(*r.EffectiveTo))
+}
+
+// SameScope returns true if both rate cards apply to the same person or role and level in the same project
+func (r *RateCard) SameScope(other *RateCard) bool {
+	if (r.ProjectID == nil) != (other.ProjectID == nil) || (r.ProjectID != nil && *r.ProjectID != *other.ProjectID) {
+		return false
+	}
+	if r.IsPersonRate() || other.IsPersonRate() {
+		return r.IsPersonRate() && other.IsPersonRate() && *r.HumanResourceID == *other.HumanResourceID
+	}
+	return strings.EqualFold(r.RoleName, other.RoleName) && r.RoleLevel == other.RoleLevel
+}
+
+// Overlaps returns true if the effective dates of both rate cards share a day
+func (r *RateCard) Overlaps(other *RateCard) bool {
+	startsBeforeOtherEnds := other.EffectiveTo == nil || DateKey(r.EffectiveFrom) <= DateKey(*other.EffectiveTo)
+	endsAfterOtherStarts := r.EffectiveTo == nil || DateKey(*r.EffectiveTo) >= DateKey(other.EffectiveFrom)
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
+
+// HourlyRates returns the cost and bill rates per working hour, unrounded. Daily rates are spread over the hours
+// of a working day and monthly rates over those of a man-month. A fixed rate is paid once, not by the hour.
+func (r *RateCard) HourlyRates(hoursPerDay, daysPerMonth float64) (cost, bill float64) {
+	switch r.RateType {
+	case RateTypeHourly:
+		return r.CostRate.Float64(), r.BillRate.Float64()
+	case RateTypeDaily:
+		hours := DaysToHoursCustom(1, hoursPerDay)
+		return r.CostRate.Float64() / hours, r.BillRate.Float64() / hours
+	case RateTypeMonthly:
+		hours := MonthsToHoursCustom(1, hoursPerDay, daysPerMonth)
+		return r.CostRate.Float64() / hours, r.BillRate.Float64() / hours
+	}
+	return 0, 0
+}
+
+// Validate validates the rate card fields
+func (r *RateCard) Validate() error {
+	// Trim whitespace from string fields
+	r.RoleName = strings.TrimSpace(r.RoleName)
+	r.Currency = NormalizeCurrency(r.Currency)
+	r.Notes = strings.TrimSpace(r.Notes)
+
+	// Validate scope: a person or a role, not both
+	if r.HumanResourceID != nil {
+		if *r.HumanResourceID == 0 || r.RoleName != "" || r.RoleLevel != RoleLevelUnknown {
+			return ErrRateCardInvalidScope
+		}
+	} else {
+		if r.RoleName == "" {
+			return ErrRateCardInvalidScope
+		}
+		if !IsValidRoleLevel(r.RoleLevel) {
+			return ErrRateCardInvalidRoleLevel
+		}
+	}
+
+	if !IsValidRateType(r.RateType) {
+		return ErrRateCardInvalidRateType
+	}
+
+	// Validate rates
+	if r.CostRate < 0 || r.BillRate < 0 {
+		return ErrRateCardInvalidRates
+	}
+
+	// Validate currency (empty is allowed and means the project currency)
+	if r.Currency != "" && !IsValidCurrency(r.Currency) {
+		return ErrRateCardInvalidCurrency
+	}
+
+	// Validate dates
+	if r.EffectiveFrom.IsZero() {
+		return ErrRateCardEffectiveFromRequired
+	}
+	if r.EffectiveTo != nil && DateKey(*r.EffectiveTo) < DateKey(r.EffectiveFrom) {
+		return ErrRateCardInvalidDates
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a rate card
+func (r *RateCard) BeforeCreate(tx *gorm.DB) error {
+	// Set default rate type if not set
+	if r.RateType == "" {
+		r.RateType = RateTypeHourly
+	}
+
+	return r.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a rate card
+func (r *RateCard) BeforeUpdate(tx *gorm.DB) error {
+	return r.Validate()
+}
+
+// RateCardQueryParams defines query parameters for filtering rate cards
+type RateCardQueryParams struct {
+	ID_In                  []uint     `json:"id_in"`
+	ProjectID              *uint      `json:"project_id"`
+	ProjectID_In           []uint     `json:"project_id_in"`
+	ProjectID_IsNull       *bool      `json:"project_id_is_null"` // True for standard rates only
+	HumanResourceID        *uint      `json:"human_resource_id"`
+	HumanResourceID_In     []uint     `json:"human_resource_id_in"`
+	HumanResourceID_IsNull *bool      `json:"human_resource_id_is_null"` // True for role rates only
+	RoleName               string     `json:"role_name"`
+	RoleName_Like          string     `json:"role_name_like"`
+	RoleLevel              uint       `json:"role_level"`
+	RateType               RateType   `json:"rate_type"`
+	RateType_In            []RateType `json:"rate_type_in"`
+	Currency               string     `json:"currency"`
+	EffectiveFrom_Gte      *time.Time `json:"effective_from_gte"`
+	EffectiveFrom_Lte      *time.Time `json:"effective_from_lte"`
+	CreatedAt_Gte          *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte          *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte          *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte          *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// RateCardListResponse represents the response for GetRateCards
+type RateCardListResponse struct {
+	Data  []*RateCard `json:"data"`
+	Total int64       `json:"total"`
+}
+
+// ResolvedRate is the rate card that applies to a person or a role on a date, and why
+type ResolvedRate struct {
+	RateCard        *RateCard  `json:"rate_card"`
+	Source          RateSource `json:"source"`
+	HumanResourceID uint       `json:"human_resource_id"` // 0 when a role rate was looked up
+	ProjectID       uint       `json:"project_id"`        // 0 when only standard rates were looked at
+	RoleName        string     `json:"role_name"`         // The person's role in the project, if any
+	RoleLevel       uint       `json:"role_level"`
+	Date            time.Time  `json:"date"`
+}
@@ -0,0 +1,116 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateCardTableName(t *testing.T) {
+	rateCard := RateCard{}
+	assert.Equal(t, "rate_cards", rateCard.TableName())
+}
+
+func TestRateCardValidate(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	person := uint(1)
+	zero := uint(0)
+	end := day(31)
+	start := day(1)
+	before := day(1).Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		rateCard  RateCard
+		wantError error
+	}{
+		{"Valid: Person rate", RateCard{HumanResourceID: &person, RateType: RateTypeHourly, CostRate: NewMoney(50), BillRate: NewMoney(90), EffectiveFrom: day(1)}, nil},
+		{"Valid: Role rate with end date", RateCard{RoleName: "Developer", RoleLevel: RoleLevelSenior, RateType: RateTypeDaily, CostRate: NewMoney(400), EffectiveFrom: day(1), EffectiveTo: &end}, nil},
+		{"Valid: One day", RateCard{RoleName: "QA", RoleLevel: RoleLevelMid, RateType: RateTypeFixed, EffectiveFrom: day(1).Add(10 * time.Hour), EffectiveTo: &start}, nil},
+		{"Invalid: No scope", RateCard{RateType: RateTypeHourly, EffectiveFrom: day(1)}, ErrRateCardInvalidScope},
+		{"Invalid: Person and role", RateCard{HumanResourceID: &person, RoleName: "Developer", RateType: RateTypeHourly, EffectiveFrom: day(1)}, ErrRateCardInvalidScope},
+		{"Invalid: Person ID 0", RateCard{HumanResourceID: &zero, RateType: RateTypeHourly, EffectiveFrom: day(1)}, ErrRateCardInvalidScope},
+		{"Invalid: Role level", RateCard{RoleName: "Developer", RateType: RateTypeHourly, EffectiveFrom: day(1)}, ErrRateCardInvalidRoleLevel},
+		{"Invalid: Rate type", RateCard{HumanResourceID: &person, RateType: "weekly", EffectiveFrom: day(1)}, ErrRateCardInvalidRateType},
+		{"Invalid: Negative bill rate", RateCard{HumanResourceID: &person, RateType: RateTypeHourly, BillRate: NewMoney(-1), EffectiveFrom: day(1)}, ErrRateCardInvalidRates},
+		{"Invalid: Currency", RateCard{HumanResourceID: &person, RateType: RateTypeHourly, Currency: "usdollar", EffectiveFrom: day(1)}, ErrRateCardInvalidCurrency},
+		{"Invalid: Ends the day before", RateCard{HumanResourceID: &person, RateType: RateTypeHourly, EffectiveFrom: day(1), EffectiveTo: &before}, ErrRateCardInvalidDates},
+		{"Invalid: Missing effective from", RateCard{HumanResourceID: &person, RateType: RateTypeHourly}, ErrRateCardEffectiveFromRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rateCard.Validate()
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+
+	t.Run("Currency is upper-cased", func(t *testing.T) {
+		rateCard := RateCard{HumanResourceID: &person, RateType: RateTypeHourly, Currency: " eur ", EffectiveFrom: day(1)}
+		assert.NoError(t, rateCard.Validate())
+		assert.Equal(t, "EUR", rateCard.Currency)
+	})
+}
+
+func TestRateCardAppliesOn(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+	closed := RateCard{EffectiveFrom: from, EffectiveTo: &to}
+	open := RateCard{EffectiveFrom: from}
+
+	assert.False(t, closed.AppliesOn(from.AddDate(0, 0, -1)))
+	assert.True(t, closed.AppliesOn(from))
+	assert.True(t, closed.AppliesOn(to.Add(23*time.Hour)))
+	assert.False(t, closed.AppliesOn(to.AddDate(0, 0, 1)))
+	assert.True(t, open.AppliesOn(to.AddDate(10, 0, 0)))
+}
+
+func TestRateCardHourlyRates(t *testing.T) {
+	tests := []struct {
+		name     string
+		rateType RateType
+		cost     Money
+		bill     Money
+		wantCost float64
+		wantBill float64
+	}{
+		{"Hourly", RateTypeHourly, NewMoney(50), NewMoney(80), 50, 80},
+		{"Daily over the hours of a day", RateTypeDaily, NewMoney(400), NewMoney(600), 50, 75},
+		{"Monthly over the hours of a man-month", RateTypeMonthly, NewMoney(8000), NewMoney(16000), 50, 100},
+		{"Fixed has no hourly rate", RateTypeFixed, NewMoney(5000), NewMoney(7000), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rateCard := RateCard{RateType: tt.rateType, CostRate: tt.cost, BillRate: tt.bill}
+			cost, bill := rateCard.HourlyRates(8, 20)
+			assert.InDelta(t, tt.wantCost, cost, 1e-9)
+			assert.InDelta(t, tt.wantBill, bill, 1e-9)
+		})
+	}
+}
+
+func TestRateCardSameScopeAndOverlaps(t *testing.T) {
+	day := func(m time.Month, d int) time.Time { return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	person, other, project := uint(1), uint(2), uint(7)
+
+	h1 := RateCard{HumanResourceID: &person, EffectiveFrom: day(1, 1), EffectiveTo: ptr(day(6, 30))}
+	h2 := RateCard{HumanResourceID: &person, EffectiveFrom: day(7, 1)}
+	h3 := RateCard{HumanResourceID: &person, EffectiveFrom: day(6, 30)}
+	assert.True(t, h1.SameScope(&h2))
+	assert.False(t, h1.Overlaps(&h2))
+	assert.True(t, h1.Overlaps(&h3))
+	assert.True(t, h2.Overlaps(&h3))
+
+	inProject := RateCard{HumanResourceID: &person, ProjectID: &project, EffectiveFrom: day(1, 1)}
+	otherPerson := RateCard{HumanResourceID: &other, EffectiveFrom: day(1, 1)}
+	assert.False(t, h1.SameScope(&inProject))
+	assert.False(t, h1.SameScope(&otherPerson))
+
+	role := RateCard{RoleName: "Developer", RoleLevel: RoleLevelSenior}
+	assert.True(t, role.SameScope(&RateCard{RoleName: "developer", RoleLevel: RoleLevelSenior}))
+	assert.False(t, role.SameScope(&RateCard{RoleName: "Developer", RoleLevel: RoleLevelMid}))
+	assert.False(t, role.SameScope(&h1))
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// RoleMonthDemand compares the effort a project role is needed for in one calendar month
+// with the working time its headcount provides
+type RoleMonthDemand struct {
+	Month             time.Time `json:"month"`              // First day of the month in the project's time zone
+	DemandHours       float64   `json:"demand_hours"`       // Estimated hours of the tasks scheduled in the month
+	CapacityHours     float64   `json:"capacity_hours"`     // Working hours of the role's headcount in the month
+	RequiredHeadcount float64   `json:"required_headcount"` // Full-time people needed to cover the demand
+	Gap               float64   `json:"gap"`                // Headcount minus required headcount; negative is a shortfall
+	IsShortfall       bool      `json:"is_shortfall"`
+}
+
+// RoleDemand is the demand for one role and level of a project, month by month
+type RoleDemand struct {
+	ProjectRoleID uint               `json:"project_role_id"`
+	Name          string             `json:"name"`
+	Level         uint               `json:"level"`
+	LevelName     string             `json:"level_name"`
+	Headcount     int                `json:"headcount"`
+	TotalHours    float64            `json:"total_hours"`
+	PeakRequired  float64            `json:"peak_required"` // Highest monthly required headcount
+	HasShortfall  bool               `json:"has_shortfall"`
+	Months        []*RoleMonthDemand `json:"months"`
+}
+
+// RoleDemandReport is the demand for every role of a project, derived from the tasks' role estimates
+// laid over the project schedule
+type RoleDemandReport struct {
+	ProjectID uint          `json:"project_id"`
+	Roles     []*RoleDemand `json:"roles"`
+}
@@ -0,0 +1,77 @@
+package entities
+
+// TaskRollup holds the values of a task aggregated over its subtasks.
+// For a task without subtasks they are the task's own values.
+type TaskRollup struct {
+	TaskID          uint           `json:"task_id"`
+	Name            string         `json:"name"`
+	ParentID        *uint          `json:"parent_id"`
+	Level           int            `json:"level"`
+	IsSummary       bool           `json:"is_summary"`
+	EstimatedEffort float64        `json:"estimated_effort"` // Hours of the subtasks that are not cancelled
+	CompletedEffort float64        `json:"completed_effort"` // Hours of that effort already done
+	PercentComplete float64        `json:"percent_complete"` // Weighted by effort
+	Status          uint           `json:"status"`
+	Estimate        EffortEstimate `json:"estimate"`         // Three-point estimates of the subtasks combined
+	ActualHours     float64        `json:"actual_hours"`     // Hours logged on the task and its subtasks
+	EffortVariance  float64        `json:"effort_variance"`  // Actual hours minus estimated effort; positive is an overrun
+	VariancePercent float64        `json:"variance_percent"` // Effort variance relative to the estimated effort
+}
+
+// ProjectRollup aggregates the work breakdown structure of a project
+type ProjectRollup struct {
+	ProjectID       uint               `json:"project_id"`
+	EstimatedEffort float64            `json:"estimated_effort"`
+	CompletedEffort float64            `json:"completed_effort"`
+	PercentComplete float64            `json:"percent_complete"`
+	Status          uint               `json:"status"`
+	Estimate        EffortEstimate     `json:"estimate"`
+	ActualHours     float64            `json:"actual_hours"`
+	EffortVariance  float64            `json:"effort_variance"`
+	VariancePercent float64            `json:"variance_percent"`
+	Tasks           []*TaskRollup      `json:"tasks"`
+	Milestones      []*MilestoneRollup `json:"milestones"`
+}
+
+// MilestoneRollup combines the estimates of the tasks of a milestone
+type MilestoneRollup struct {
+	MilestoneID     uint           `json:"milestone_id"`
+	Name            string         `json:"name"`
+	EstimatedEffort float64        `json:"estimated_effort"` // Hours of the tasks that are not cancelled
+	CompletedEffort float64        `json:"completed_effort"`
+	PercentComplete float64        `json:"percent_complete"` // Weighted by effort
+	ActualHours     float64        `json:"actual_hours"`     // Hours logged on the milestone's tasks
+	Estimate        EffortEstimate `json:"estimate"`
+}
+
+// SetActualHours records the hours logged and derives the variance from the estimated effort
+func (tr *TaskRollup) SetActualHours(hours float64) {
+	tr.ActualHours = hours
+	tr.EffortVariance, tr.VariancePercent = effortVariance(tr.EstimatedEffort, hours)
+}
+
+// SetActualHours records the hours logged and derives the variance from the estimated effort
+func (pr *ProjectRollup) SetActualHours(hours float64) {
+	pr.ActualHours = hours
+	pr.EffortVariance, pr.VariancePercent = effortVariance(pr.EstimatedEffort, hours)
+}
+
+// effortVariance returns actual minus estimated hours, and that difference in percent of the
+// estimate, 0 without an estimate
+func effortVariance(estimated, actual float64) (variance, percent float64) {
+	variance = actual - estimated
+	if estimated > 0 {
+		percent = variance / estimated * 100
+	}
+	return variance, percent
+}
+
+// GetTask returns the rollup of a task, or nil if the task is not part of the project
+func (pr *ProjectRollup) GetTask(taskID uint) *TaskRollup {
+	for _, tr := range pr.Tasks {
+		if tr.TaskID == taskID {
+			return tr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ScenarioStatusUnknown  = 0
+	ScenarioStatusDraft    = 1
+	ScenarioStatusPromoted = 2
+)
+
+var (
+	ErrScenarioNameRequired     = errors.New("scenario name is required")
+	ErrScenarioInvalidProjectID = errors.New("scenario must belong to a project")
+	ErrScenarioInvalidStatus    = errors.New("scenario status must be 1 (draft) or 2 (promoted)")
+	ErrScenarioPromoted         = errors.New("scenario has already been promoted to the live plan")
+	ErrScenarioInvalidID        = errors.New("scenario row must belong to a scenario")
+	ErrScenarioInvalidHeadcount = errors.New("scenario headcount must be non-negative")
+	ErrScenarioInvalidCost      = errors.New("scenario cost must be non-negative")
+	ErrScenarioHeadcountNotLive = errors.New("scenario headcount above 1 must be staffed with project resources before promotion")
+
+	ScenarioAllowedSortField = map[string]string{
+		"id":          "id",
+		"project_id":  "project_id",
+		"name":        "name",
+		"status":      "status",
+		"promoted_at": "promoted_at",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+	}
+)
+
+// Scenario is a named what-if copy of a project's plan. It forks the editable values of the
+// project's tasks, active resource allocations, task assignments and active milestones; rows
+// added to the live plan after the fork follow the live plan.
+type Scenario struct {
+	ID          uint       `gorm:"primary_key" json:"id"`
+	ProjectID   uint       `gorm:"not null;index;uniqueIndex:idx_scenario_project_name" json:"project_id"`
+	Name        string     `gorm:"not null;uniqueIndex:idx_scenario_project_name" json:"name"`
+	Description string     `gorm:"type:text" json:"description"`
+	Status      uint       `gorm:"not null;default:1" json:"status"`
+	PromotedAt  *time.Time `gorm:"" json:"promoted_at"` // When the scenario became the live plan
+	CreatedAt   time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Project     *Project              `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	Tasks       []*ScenarioTask       `gorm:"foreignKey:ScenarioID;constraint:OnDelete:CASCADE" json:"tasks,omitempty"`
+	Resources   []*ScenarioResource   `gorm:"foreignKey:ScenarioID;constraint:OnDelete:CASCADE" json:"resources,omitempty"`
+	Assignments []*ScenarioAssignment `gorm:"foreignKey:ScenarioID;constraint:OnDelete:CASCADE" json:"assignments,omitempty"`
+	Milestones  []*ScenarioMilestone  `gorm:"foreignKey:ScenarioID;constraint:OnDelete:CASCADE" json:"milestones,omitempty"`
+}
+
+// TableName returns the table name for the scenario entity
+func (Scenario) TableName() string {
+	return "scenarios"
+}
+
+// IsDraft returns true if the scenario can still be edited
+func (s *Scenario) IsDraft() bool {
+	return s.Status == ScenarioStatusDraft
+}
+
+// Validate validates the scenario fields
+func (s *Scenario) Validate() error {
+	// Trim whitespace from string fields
+	s.Name = strings.TrimSpace(s.Name)
+	s.Description = strings.TrimSpace(s.Description)
+
+	// Validate required fields
+	if s.Name == "" {
+		return ErrScenarioNameRequired
+	}
+
+	if s.ProjectID == 0 {
+		return ErrScenarioInvalidProjectID
+	}
+
+	// Validate status
+	switch s.Status {
+	case ScenarioStatusDraft, ScenarioStatusPromoted:
+		return nil
+	}
+	return ErrScenarioInvalidStatus
+}
+
+// BeforeCreate is a GORM hook that runs before creating a scenario
+func (s *Scenario) BeforeCreate(tx *gorm.DB) error {
+	// Set default status if not set
+	if s.Status == ScenarioStatusUnknown {
+		s.Status = ScenarioStatusDraft
+	}
+
+	return s.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a scenario
+func (s *Scenario) BeforeUpdate(tx *gorm.DB) error {
+	return s.Validate()
+}
+
+// ScenarioTask holds the scenario's values of a task
+type ScenarioTask struct {
+	ID              uint       `gorm:"primary_key" json:"id"`
+	ScenarioID      uint       `gorm:"not null;index;uniqueIndex:idx_scenario_task" json:"scenario_id"`
+	TaskID          uint       `gorm:"not null;index;uniqueIndex:idx_scenario_task" json:"task_id"`
+	Name            string     `gorm:"not null" json:"name"` // Name of the task when it was forked
+	MilestoneID     *uint      `gorm:"" json:"milestone_id"`
+	EstimatedEffort float64    `gorm:"not null;default:0" json:"estimated_effort"`
+	Duration        float64    `gorm:"not null;default:0" json:"duration"` // Working days; 0 means the duration follows from EstimatedEffort
+	ConstraintType  uint       `gorm:"not null;default:1" json:"constraint_type"`
+	ConstraintDate  *time.Time `gorm:"" json:"constraint_date"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Task *Task `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE" json:"task,omitempty"`
+}
+
+// TableName returns the table name for the scenario task entity
+func (ScenarioTask) TableName() string {
+	return "scenario_tasks"
+}
+
+// NewScenarioTask forks the editable values of a task
+func NewScenarioTask(scenarioID uint, task *Task) *ScenarioTask {
+	return &ScenarioTask{
+		ScenarioID:      scenarioID,
+		TaskID:          task.ID,
+		Name:            task.Name,
+		MilestoneID:     task.MilestoneID,
+		EstimatedEffort: task.EstimatedEffort,
+		Duration:        task.Duration,
+		ConstraintType:  task.ConstraintType,
+		ConstraintDate:  task.ConstraintDate,
+	}
+}
+
+// ApplyTo overwrites the editable values of a task with the scenario's
+func (st *ScenarioTask) ApplyTo(task *Task) {
+	task.MilestoneID = st.MilestoneID
+	task.EstimatedEffort = st.EstimatedEffort
+	task.Duration = st.Duration
+	task.ConstraintType = st.ConstraintType
+	task.ConstraintDate = st.ConstraintDate
+}
+
+// Validate validates the scenario task fields. The constraint date of an undated constraint is cleared.
+func (st *ScenarioTask) Validate() error {
+	if st.ScenarioID == 0 {
+		return ErrScenarioInvalidID
+	}
+
+	if st.TaskID == 0 {
+		return ErrTaskAssignmentInvalidTaskID
+	}
+
+	if st.EstimatedEffort < 0 {
+		return ErrTaskInvalidEffort
+	}
+
+	if st.Duration < 0 {
+		return ErrTaskInvalidDuration
+	}
+
+	if st.ConstraintType == TaskConstraintUnknown {
+		st.ConstraintType = TaskConstraintASAP
+	}
+	constraint := Task{ConstraintType: st.ConstraintType, ConstraintDate: st.ConstraintDate}
+	if err := constraint.validateConstraint(); err != nil {
+		return err
+	}
+	st.ConstraintDate = constraint.ConstraintDate
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a scenario task
+func (st *ScenarioTask) BeforeCreate(tx *gorm.DB) error {
+	return st.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a scenario task
+func (st *ScenarioTask) BeforeUpdate(tx *gorm.DB) error {
+	return st.Validate()
+}
+
+// ScenarioResource holds the scenario's values of an active resource allocation.
+// Headcount counts people working like the allocated one; 0 takes the allocation out.
+type ScenarioResource struct {
+	ID                uint      `gorm:"primary_key" json:"id"`
+	ScenarioID        uint      `gorm:"not null;index;uniqueIndex:idx_scenario_resource" json:"scenario_id"`
+	ProjectResourceID uint      `gorm:"not null;index;uniqueIndex:idx_scenario_resource" json:"project_resource_id"`
+	HumanResourceID   uint      `gorm:"not null;index" json:"human_resource_id"`
+	Allocation        float64   `gorm:"not null;default:100" json:"allocation"` // Allocation percentage (0-100)
+	Cost              Money     `gorm:"not null;default:0" json:"cost"`         // Cost of the allocation, per head
+	Headcount         int       `gorm:"not null;default:1" json:"headcount"`
+	CreatedAt         time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	ProjectResource *ProjectResource `gorm:"foreignKey:ProjectResourceID;constraint:OnDelete:CASCADE" json:"project_resource,omitempty"`
+	HumanResource   *HumanResource   `gorm:"foreignKey:HumanResourceID" json:"human_resource,omitempty"`
+}
+
+// TableName returns the table name for the scenario resource entity
+func (ScenarioResource) TableName() string {
+	return "scenario_resources"
+}
+
+// NewScenarioResource forks the editable values of a resource allocation
+func NewScenarioResource(scenarioID uint, pr *ProjectResource) *ScenarioResource {
+	return &ScenarioResource{
+		ScenarioID:        scenarioID,
+		ProjectResourceID: pr.ID,
+		HumanResourceID:   pr.HumanResourceID,
+		Allocation:        pr.Allocation,
+		Cost:              pr.Cost,
+		Headcount:         1,
+	}
+}
+
+// FullTimeEquivalent returns the full-time people the allocation stands for in the scenario
+func (sr *ScenarioResource) FullTimeEquivalent() float64 {
+	return sr.Allocation / 100 * float64(sr.Headcount)
+}
+
+// TotalCost returns the cost of the allocation for its whole headcount
+func (sr *ScenarioResource) TotalCost() Money {
+	return sr.Cost * Money(sr.Headcount)
+}
+
+// Validate validates the scenario resource fields
+func (sr *ScenarioResource) Validate() error {
+	if sr.ScenarioID == 0 {
+		return ErrScenarioInvalidID
+	}
+
+	if sr.ProjectResourceID == 0 {
+		return ErrTaskAssignmentInvalidProjectResourceID
+	}
+
+	if sr.Allocation < 0 || sr.Allocation > 100 {
+		return ErrProjectResourceInvalidAllocation
+	}
+
+	if sr.Cost < 0 {
+		return ErrScenarioInvalidCost
+	}
+
+	if sr.Headcount < 0 {
+		return ErrScenarioInvalidHeadcount
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a scenario resource
+func (sr *ScenarioResource) BeforeCreate(tx *gorm.DB) error {
+	return sr.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a scenario resource
+func (sr *ScenarioResource) BeforeUpdate(tx *gorm.DB) error {
+	return sr.Validate()
+}
+
+// ScenarioAssignment holds the scenario's values of a task assignment
+type ScenarioAssignment struct {
+	ID               uint      `gorm:"primary_key" json:"id"`
+	ScenarioID       uint      `gorm:"not null;index;uniqueIndex:idx_scenario_assignment" json:"scenario_id"`
+	TaskAssignmentID uint      `gorm:"not null;index;uniqueIndex:idx_scenario_assignment" json:"task_assignment_id"`
+	TaskID           uint      `gorm:"not null;index" json:"task_id"`
+	HumanResourceID  uint      `gorm:"not null;index" json:"human_resource_id"`
+	PlannedHours     float64   `gorm:"not null;default:0" json:"planned_hours"`
+	Units            float64   `gorm:"not null;default:100" json:"units"`
+	CreatedAt        time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	TaskAssignment *TaskAssignment `gorm:"foreignKey:TaskAssignmentID;constraint:OnDelete:CASCADE" json:"task_assignment,omitempty"`
+}
+
+// TableName returns the table name for the scenario assignment entity
+func (ScenarioAssignment) TableName() string {
+	return "scenario_assignments"
+}
+
+// NewScenarioAssignment forks the editable values of a task assignment
+func NewScenarioAssignment(scenarioID uint, a *TaskAssignment) *ScenarioAssignment {
+	return &ScenarioAssignment{
+		ScenarioID:       scenarioID,
+		TaskAssignmentID: a.ID,
+		TaskID:           a.TaskID,
+		HumanResourceID:  a.HumanResourceID,
+		PlannedHours:     a.PlannedHours,
+		Units:            a.Units,
+	}
+}
+
+// Validate validates the scenario assignment fields
+func (sa *ScenarioAssignment) Validate() error {
+	if sa.ScenarioID == 0 {
+		return ErrScenarioInvalidID
+	}
+
+	if sa.PlannedHours < 0 {
+		return ErrTaskAssignmentInvalidPlannedHours
+	}
+
+	if sa.Units <= 0 || sa.Units > 100 {
+		return ErrTaskAssignmentInvalidUnits
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a scenario assignment
+func (sa *ScenarioAssignment) BeforeCreate(tx *gorm.DB) error {
+	return sa.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a scenario assignment
+func (sa *ScenarioAssignment) BeforeUpdate(tx *gorm.DB) error {
+	return sa.Validate()
+}
+
+// ScenarioMilestone holds the scenario's dates of an active milestone
+type ScenarioMilestone struct {
+	ID          uint       `gorm:"primary_key" json:"id"`
+	ScenarioID  uint       `gorm:"not null;index;uniqueIndex:idx_scenario_milestone" json:"scenario_id"`
+	MilestoneID uint       `gorm:"not null;index;uniqueIndex:idx_scenario_milestone" json:"milestone_id"`
+	Name        string     `gorm:"not null" json:"name"` // Name of the milestone when it was forked
+	StartDate   *time.Time `gorm:"" json:"start_date"`
+	EndDate     *time.Time `gorm:"" json:"end_date"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Milestone *Milestone `gorm:"foreignKey:MilestoneID;constraint:OnDelete:CASCADE" json:"milestone,omitempty"`
+}
+
+// TableName returns the table name for the scenario milestone entity
+func (ScenarioMilestone) TableName() string {
+	return "scenario_milestones"
+}
+
+// NewScenarioMilestone forks the dates of a milestone
+func NewScenarioMilestone(scenarioID uint, m *Milestone) *ScenarioMilestone {
+	return &ScenarioMilestone{
+		ScenarioID:  scenarioID,
+		MilestoneID: m.ID,
+		Name:        m.Name,
+		StartDate:   m.StartDate,
+		EndDate:     m.EndDate,
+	}
+}
+
+// ApplyTo overwrites the dates of a milestone with the scenario's
+func (sm *ScenarioMilestone) ApplyTo(m *Milestone) {
+	m.StartDate = sm.StartDate
+	m.EndDate = sm.EndDate
+}
+
+// Validate validates the scenario milestone fields
+func (sm *ScenarioMilestone) Validate() error {
+	if sm.ScenarioID == 0 {
+		return ErrScenarioInvalidID
+	}
+
+	if sm.StartDate != nil && sm.EndDate != nil && sm.EndDate.Before(*sm.StartDate) {
+		return ErrMilestoneInvalidDates
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a scenario milestone
+func (sm *ScenarioMilestone) BeforeCreate(tx *gorm.DB) error {
+	return sm.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a scenario milestone
+func (sm *ScenarioMilestone) BeforeUpdate(tx *gorm.DB) error {
+	return sm.Validate()
+}
+
+// ScenarioQueryParams defines query parameters for filtering scenarios
+type ScenarioQueryParams struct {
+	ID_In         []uint     `json:"id_in"`
+	ProjectID     uint       `json:"project_id"`
+	ProjectID_In  []uint     `json:"project_id_in"`
+	Name_Like     string     `json:"name_like"`
+	Status        uint       `json:"status"`
+	Status_In     []uint     `json:"status_in"`
+	CreatedAt_Gte *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// ScenarioListResponse represents the response for GetScenarios
+type ScenarioListResponse struct {
+	Data  []*Scenario `json:"data"`
+	Total int64       `json:"total"`
+}
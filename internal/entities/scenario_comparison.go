@@ -0,0 +1,51 @@
+package entities
+
+import "time"
+
+// ScenarioOutcome sums up what a plan delivers and what it costs
+type ScenarioOutcome struct {
+	FinishDate      time.Time  `json:"finish_date"`      // Early finish of the critical path
+	ForecastDate    *time.Time `json:"forecast_date"`    // When the allocated people finish the remaining work; nil when no one is allocated
+	RemainingEffort float64    `json:"remaining_effort"` // Hours of work left on the tasks that are not cancelled
+	PlannedHours    float64    `json:"planned_hours"`    // Hours planned on task assignments
+	Capacity        float64    `json:"capacity"`         // Full-time equivalents allocated to the project
+	Cost            Money      `json:"cost"`             // Cost of the active resource allocations
+}
+
+// ScenarioTaskComparison compares the schedule of a task in the live plan and in a scenario
+type ScenarioTaskComparison struct {
+	TaskID           uint      `json:"task_id"`
+	Name             string    `json:"name"`
+	LiveStart        time.Time `json:"live_start"`
+	LiveFinish       time.Time `json:"live_finish"`
+	ScenarioStart    time.Time `json:"scenario_start"`
+	ScenarioFinish   time.Time `json:"scenario_finish"`
+	FinishDeltaDays  int       `json:"finish_delta_days"` // Calendar days the scenario finishes after the live plan; negative when earlier
+	LiveCritical     bool      `json:"live_critical"`
+	ScenarioCritical bool      `json:"scenario_critical"`
+}
+
+// ScenarioMilestoneComparison compares the due date and forecast of a milestone in the live plan and in a scenario
+type ScenarioMilestoneComparison struct {
+	MilestoneID          uint       `json:"milestone_id"`
+	Name                 string     `json:"name"`
+	LiveDueDate          *time.Time `json:"live_due_date"`
+	ScenarioDueDate      *time.Time `json:"scenario_due_date"`
+	LiveForecastDate     *time.Time `json:"live_forecast_date"`
+	ScenarioForecastDate *time.Time `json:"scenario_forecast_date"`
+	LiveSlipDays         int        `json:"live_slip_days"`
+	ScenarioSlipDays     int        `json:"scenario_slip_days"`
+}
+
+// ScenarioComparison compares a scenario against the live plan of its project
+type ScenarioComparison struct {
+	ScenarioID      uint                           `json:"scenario_id"`
+	ProjectID       uint                           `json:"project_id"`
+	Name            string                         `json:"name"`
+	Live            *ScenarioOutcome               `json:"live"`
+	Scenario        *ScenarioOutcome               `json:"scenario"`
+	FinishDeltaDays int                            `json:"finish_delta_days"` // Calendar days the scenario finishes after the live plan; negative when earlier
+	CostDelta       Money                          `json:"cost_delta"`        // Scenario cost minus live cost
+	Tasks           []*ScenarioTaskComparison      `json:"tasks"`
+	Milestones      []*ScenarioMilestoneComparison `json:"milestones"`
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenarioTableNames(t *testing.T) {
+	assert.Equal(t, "scenarios", Scenario{}.TableName())
+	assert.Equal(t, "scenario_tasks", ScenarioTask{}.TableName())
+	assert.Equal(t, "scenario_resources", ScenarioResource{}.TableName())
+	assert.Equal(t, "scenario_assignments", ScenarioAssignment{}.TableName())
+	assert.Equal(t, "scenario_milestones", ScenarioMilestone{}.TableName())
+}
+
+func TestScenarioValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		scenario  Scenario
+		wantError error
+	}{
+		{
+			name:      "Valid draft",
+			scenario:  Scenario{ProjectID: 1, Name: "Hire early", Status: ScenarioStatusDraft},
+			wantError: nil,
+		},
+		{
+			name:      "Missing name",
+			scenario:  Scenario{ProjectID: 1, Name: "  ", Status: ScenarioStatusDraft},
+			wantError: ErrScenarioNameRequired,
+		},
+		{
+			name:      "Missing project",
+			scenario:  Scenario{Name: "Hire early", Status: ScenarioStatusDraft},
+			wantError: ErrScenarioInvalidProjectID,
+		},
+		{
+			name:      "Unknown status",
+			scenario:  Scenario{ProjectID: 1, Name: "Hire early", Status: 7},
+			wantError: ErrScenarioInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scenario.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScenarioTaskForkAndApply(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	task := Task{ID: 4, Name: "Design", EstimatedEffort: 16, ConstraintType: TaskConstraintStartNoEarlierThan, ConstraintDate: &day}
+	forked := NewScenarioTask(9, &task)
+	assert.NoError(t, forked.Validate())
+
+	forked.EstimatedEffort = 24
+	forked.ConstraintType = TaskConstraintASAP
+	assert.NoError(t, forked.Validate())
+	assert.Nil(t, forked.ConstraintDate)
+
+	forked.ApplyTo(&task)
+	assert.Equal(t, 24.0, task.EstimatedEffort)
+	assert.Equal(t, uint(TaskConstraintASAP), task.ConstraintType)
+	assert.Nil(t, task.ConstraintDate)
+
+	forked.Duration = -1
+	assert.Equal(t, ErrTaskInvalidDuration, forked.Validate())
+}
+
+func TestScenarioResourceHeadcount(t *testing.T) {
+	resource := NewScenarioResource(9, &ProjectResource{ID: 3, HumanResourceID: 2, Allocation: 50, Cost: NewMoney(1200)})
+	assert.Equal(t, 1, resource.Headcount)
+	assert.Equal(t, 0.5, resource.FullTimeEquivalent())
+
+	resource.Headcount = 3
+	assert.Equal(t, 1.5, resource.FullTimeEquivalent())
+	assert.Equal(t, NewMoney(3600), resource.TotalCost())
+
+	resource.Headcount = -1
+	assert.Equal(t, ErrScenarioInvalidHeadcount, resource.Validate())
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrScheduleStartDateRequired = errors.New("project start date is required to compute a schedule")
+	ErrScheduleCycle             = errors.New("task dependencies contain a cycle")
+)
+
+// TaskSchedule holds the Critical Path Method results for one task.
+// Floats are expressed in working hours.
+type TaskSchedule struct {
+	TaskID        uint      `json:"task_id"`
+	Name          string    `json:"name"`
+	ParentID      *uint     `json:"parent_id"`
+	MilestoneID   *uint     `json:"milestone_id"`
+	IsSummary     bool      `json:"is_summary"`
+	DurationHours float64   `json:"duration_hours"`
+	LevelingDelay float64   `json:"leveling_delay"` // Working hours added by resource leveling
+	EarlyStart    time.Time `json:"early_start"`
+	EarlyFinish   time.Time `json:"early_finish"`
+	LateStart     time.Time `json:"late_start"`
+	LateFinish    time.Time `json:"late_finish"`
+	TotalFloat    float64   `json:"total_float"`
+	FreeFloat     float64   `json:"free_float"`
+	IsCritical    bool      `json:"is_critical"`
+}
+
+// ProjectSchedule is the computed schedule of a project
+type ProjectSchedule struct {
+	ProjectID     uint            `json:"project_id"`
+	StartDate     time.Time       `json:"start_date"`
+	FinishDate    time.Time       `json:"finish_date"`
+	DurationHours float64         `json:"duration_hours"` // Working hours from start to finish
+	Tasks         []*TaskSchedule `json:"tasks"`
+	CriticalPath  []uint          `json:"critical_path"` // Critical task IDs ordered by early start
+
+	// Dependencies linking a task of the project to a task of another project. The schedule
+	// does not account for them, so a late external predecessor does not move it.
+	IgnoredDependencies []*TaskDependency `json:"ignored_dependencies"`
+}
+
+// GetTask returns the schedule entry of a task, or nil if the task is not part of the schedule
+func (ps *ProjectSchedule) GetTask(taskID uint) *TaskSchedule {
+	for _, ts := range ps.Tasks {
+		if ts.TaskID == taskID {
+			return ts
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// Simulation iteration limits
+const (
+	DefaultSimulationIterations = 5000
+	MaxSimulationIterations     = 100000
+)
+
+var (
+	ErrSimulationInvalidIterations = errors.New("simulation iterations must be between 0 and 100000")
+)
+
+// SimulationOptions configures a Monte Carlo simulation run
+type SimulationOptions struct {
+	Iterations int   `json:"iterations"` // Number of sampled runs; 0 uses DefaultSimulationIterations
+	Seed       int64 `json:"seed"`       // Seed of the random source; 0 picks a random seed
+}
+
+// Validate validates the simulation options
+func (o *SimulationOptions) Validate() error {
+	if o.Iterations < 0 || o.Iterations > MaxSimulationIterations {
+		return ErrSimulationInvalidIterations
+	}
+	return nil
+}
+
+// DatePercentiles are the dates not passed in the given share of the simulated runs
+type DatePercentiles struct {
+	P50 time.Time `json:"p50"`
+	P80 time.Time `json:"p80"`
+	P90 time.Time `json:"p90"`
+}
+
+// CostPercentiles are the costs not exceeded in the given share of the simulated runs
+type CostPercentiles struct {
+	P50 float64 `json:"p50"`
+	P80 float64 `json:"p80"`
+	P90 float64 `json:"p90"`
+}
+
+// MilestoneSimulation is the simulated completion of a milestone
+type MilestoneSimulation struct {
+	MilestoneID       uint            `json:"milestone_id"`
+	Name              string          `json:"name"`
+	DueDate           *time.Time      `json:"due_date"`            // Milestone end date
+	FinishDate        DatePercentiles `json:"finish_date"`         // Early finish of the milestone's last task
+	Cost              CostPercentiles `json:"cost"`                // Cost of the milestone's tasks
+	OnTimeProbability float64         `json:"on_time_probability"` // Share of the runs finishing by the due date, 1 without one
+}
+
+// ProjectSimulation is the outcome of a Monte Carlo simulation of a project's schedule and cost
+type ProjectSimulation struct {
+	ProjectID  uint                   `json:"project_id"`
+	Iterations int                    `json:"iterations"`
+	Seed       int64                  `json:"seed"` // Seed to pass again to reproduce the run
+	FinishDate DatePercentiles        `json:"finish_date"`
+	Cost       CostPercentiles        `json:"cost"`
+	Milestones []*MilestoneSimulation `json:"milestones"`
+}
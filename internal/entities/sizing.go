@@ -0,0 +1,182 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SizeUnit is a T-shirt size or a function point, the units a task can be sized in
+type SizeUnit string
+
+// Size units, from the smallest T-shirt size to the largest
+const (
+	SizeUnitXS            SizeUnit = "xs"
+	SizeUnitS             SizeUnit = "s"
+	SizeUnitM             SizeUnit = "m"
+	SizeUnitL             SizeUnit = "l"
+	SizeUnitXL            SizeUnit = "xl"
+	SizeUnitXXL           SizeUnit = "xxl"
+	SizeUnitFunctionPoint SizeUnit = "function_point"
+)
+
+var (
+	ErrSizeMappingInvalidProjectID     = errors.New("size mapping must belong to a project")
+	ErrSizeMappingInvalidProjectRoleID = errors.New("size mapping must have a valid project role ID")
+	ErrSizeMappingInvalidUnit          = errors.New("size mapping unit must be xs, s, m, l, xl, xxl or function_point")
+	ErrSizeMappingInvalidHours         = errors.New("size mapping hours must be non-negative")
+	ErrSizeMappingRoleMismatch         = errors.New("project role belongs to a different project than the size mapping")
+	ErrTaskSizingTasksRequired         = errors.New("at least one task is required for sizing")
+	ErrTaskSizingProjectMismatch       = errors.New("tasks sized together must belong to the same project")
+
+	SizeMappingAllowedSortField = map[string]string{
+		"id":              "id",
+		"project_id":      "project_id",
+		"project_role_id": "project_role_id",
+		"unit":            "unit",
+		"hours":           "hours",
+		"created_at":      "created_at",
+		"updated_at":      "updated_at",
+	}
+)
+
+// TShirtSizes returns the T-shirt sizes from the smallest to the largest
+func TShirtSizes() []SizeUnit {
+	return []SizeUnit{SizeUnitXS, SizeUnitS, SizeUnitM, SizeUnitL, SizeUnitXL, SizeUnitXXL}
+}
+
+// IsTShirtSize checks if the size unit is one of the T-shirt sizes
+func IsTShirtSize(u SizeUnit) bool {
+	switch u {
+	case SizeUnitXS, SizeUnitS, SizeUnitM, SizeUnitL, SizeUnitXL, SizeUnitXXL:
+		return true
+	}
+	return false
+}
+
+// IsValidSizeUnit checks if the size unit is a T-shirt size or a function point
+func IsValidSizeUnit(u SizeUnit) bool {
+	return IsTShirtSize(u) || u == SizeUnitFunctionPoint
+}
+
+// SizeMapping is a row of a project's sizing table: the hours one role spends on a task of a
+// T-shirt size, or per function point
+type SizeMapping struct {
+	ID            uint      `gorm:"primary_key" json:"id"`
+	ProjectID     uint      `gorm:"not null;index" json:"project_id"`
+	ProjectRoleID uint      `gorm:"not null;index;uniqueIndex:idx_size_mapping_role_unit" json:"project_role_id"`
+	Unit          SizeUnit  `gorm:"not null;uniqueIndex:idx_size_mapping_role_unit" json:"unit"`
+	Hours         float64   `gorm:"not null;default:0" json:"hours"` // Working hours per task of the size, or per function point
+	CreatedAt     time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Project     *Project     `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	ProjectRole *ProjectRole `gorm:"foreignKey:ProjectRoleID;constraint:OnDelete:CASCADE" json:"project_role,omitempty"`
+}
+
+// TableName returns the table name for the size mapping entity
+func (SizeMapping) TableName() string {
+	return "size_mappings"
+}
+
+// Validate validates the size mapping fields
+func (m *SizeMapping) Validate() error {
+	// Validate required fields
+	if m.ProjectID == 0 {
+		return ErrSizeMappingInvalidProjectID
+	}
+
+	if m.ProjectRoleID == 0 {
+		return ErrSizeMappingInvalidProjectRoleID
+	}
+
+	if !IsValidSizeUnit(m.Unit) {
+		return ErrSizeMappingInvalidUnit
+	}
+
+	// Validate hours
+	if m.Hours < 0 {
+		return ErrSizeMappingInvalidHours
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a size mapping
+func (m *SizeMapping) BeforeCreate(tx *gorm.DB) error {
+	return m.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a size mapping
+func (m *SizeMapping) BeforeUpdate(tx *gorm.DB) error {
+	return m.Validate()
+}
+
+// SizeMappingQueryParams defines query parameters for filtering size mappings
+type SizeMappingQueryParams struct {
+	ID_In            []uint     `json:"id_in"`
+	ProjectID        uint       `json:"project_id"`
+	ProjectID_In     []uint     `json:"project_id_in"`
+	ProjectRoleID    uint       `json:"project_role_id"`
+	ProjectRoleID_In []uint     `json:"project_role_id_in"`
+	Unit             SizeUnit   `json:"unit"`
+	Unit_In          []SizeUnit `json:"unit_in"`
+	CreatedAt_Gte    *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte    *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte    *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte    *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// SizeMappingListResponse represents the response for GetSizeMappings
+type SizeMappingListResponse struct {
+	Data  []*SizeMapping `json:"data"`
+	Total int64          `json:"total"`
+}
+
+// TaskSizing sizes a batch of tasks at once, in a T-shirt size or in function points.
+// An empty size with no function points takes the tasks' size away.
+type TaskSizing struct {
+	TaskIDs        []uint   `json:"task_ids"`
+	Size           SizeUnit `json:"size"`            // T-shirt size
+	FunctionPoints float64  `json:"function_points"` // Used when no T-shirt size is given
+}
+
+// Validate validates the sizing request
+func (s *TaskSizing) Validate() error {
+	if len(s.TaskIDs) == 0 {
+		return ErrTaskSizingTasksRequired
+	}
+	task := Task{Size: s.Size, FunctionPoints: s.FunctionPoints}
+	return task.validateSize()
+}
+
+// SizingResult tells how many tasks took their effort from their size
+type SizingResult struct {
+	ProjectID   uint    `json:"project_id"`
+	Derived     int     `json:"derived"`      // Tasks whose effort and role estimates now follow their size
+	Overridden  int     `json:"overridden"`   // Sized tasks that keep their manual or three-point estimate
+	Unmapped    int     `json:"unmapped"`     // Sized tasks whose size has no hours in the sizing table; their effort is kept
+	TotalEffort float64 `json:"total_effort"` // Effort of the derived tasks
+}
+
+// SizeCount is the number of tasks of one size and the effort their size maps to
+type SizeCount struct {
+	Unit   SizeUnit `json:"unit"`
+	Tasks  int      `json:"tasks"`
+	Points float64  `json:"points"` // Function points; 0 for T-shirt sizes
+	Effort float64  `json:"effort"` // Hours the size maps to, overrides not applied
+}
+
+// SizingSummary is a rough estimate of a project's backlog from the sizes of its work packages
+type SizingSummary struct {
+	ProjectID       uint         `json:"project_id"`
+	Sizes           []*SizeCount `json:"sizes"` // T-shirt sizes from XS to XXL, then function points
+	SizedTasks      int          `json:"sized_tasks"`
+	UnsizedTasks    int          `json:"unsized_tasks"`
+	OverriddenTasks int          `json:"overridden_tasks"`
+	SizedEffort     float64      `json:"sized_effort"`     // Hours the sizes map to
+	EstimatedEffort float64      `json:"estimated_effort"` // Hours of the sized tasks with overrides applied
+}
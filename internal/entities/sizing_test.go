@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizeMappingTableName(t *testing.T) {
+	mapping := SizeMapping{}
+	assert.Equal(t, "size_mappings", mapping.TableName())
+}
+
+func TestSizeMappingValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		mapping   SizeMapping
+		wantError error
+	}{
+		{
+			name:      "Valid T-shirt size",
+			mapping:   SizeMapping{ProjectID: 1, ProjectRoleID: 1, Unit: SizeUnitM, Hours: 16},
+			wantError: nil,
+		},
+		{
+			name:      "Valid function point",
+			mapping:   SizeMapping{ProjectID: 1, ProjectRoleID: 1, Unit: SizeUnitFunctionPoint, Hours: 2.5},
+			wantError: nil,
+		},
+		{
+			name:      "Missing project",
+			mapping:   SizeMapping{ProjectRoleID: 1, Unit: SizeUnitM, Hours: 16},
+			wantError: ErrSizeMappingInvalidProjectID,
+		},
+		{
+			name:      "Missing role",
+			mapping:   SizeMapping{ProjectID: 1, Unit: SizeUnitM, Hours: 16},
+			wantError: ErrSizeMappingInvalidProjectRoleID,
+		},
+		{
+			name:      "Invalid unit",
+			mapping:   SizeMapping{ProjectID: 1, ProjectRoleID: 1, Unit: "xxxl", Hours: 16},
+			wantError: ErrSizeMappingInvalidUnit,
+		},
+		{
+			name:      "Negative hours",
+			mapping:   SizeMapping{ProjectID: 1, ProjectRoleID: 1, Unit: SizeUnitS, Hours: -1},
+			wantError: ErrSizeMappingInvalidHours,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mapping.Validate()
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestTaskSizingValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizing    TaskSizing
+		wantError error
+	}{
+		{
+			name:      "T-shirt size",
+			sizing:    TaskSizing{TaskIDs: []uint{1, 2}, Size: SizeUnitXL},
+			wantError: nil,
+		},
+		{
+			name:      "Function points",
+			sizing:    TaskSizing{TaskIDs: []uint{1}, FunctionPoints: 12},
+			wantError: nil,
+		},
+		{
+			name:      "Clear size",
+			sizing:    TaskSizing{TaskIDs: []uint{1}},
+			wantError: nil,
+		},
+		{
+			name:      "No tasks",
+			sizing:    TaskSizing{Size: SizeUnitS},
+			wantError: ErrTaskSizingTasksRequired,
+		},
+		{
+			name:      "Function point is not a T-shirt size",
+			sizing:    TaskSizing{TaskIDs: []uint{1}, Size: SizeUnitFunctionPoint},
+			wantError: ErrTaskInvalidSize,
+		},
+		{
+			name:      "Both size and function points",
+			sizing:    TaskSizing{TaskIDs: []uint{1}, Size: SizeUnitS, FunctionPoints: 3},
+			wantError: ErrTaskSizeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sizing.Validate()
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	SprintStatusUnknown   = 0
+	SprintStatusPlanned   = 1
+	SprintStatusActive    = 2
+	SprintStatusCompleted = 3
+)
+
+// DefaultVelocityWindow is the number of most recent completed sprints velocity is averaged over
+const DefaultVelocityWindow = 3
+
+var (
+	ErrSprintNameRequired     = errors.New("sprint name is required")
+	ErrSprintInvalidProjectID = errors.New("sprint must belong to a project")
+	ErrSprintDatesRequired    = errors.New("sprint start and end dates are required")
+	ErrSprintInvalidDates     = errors.New("sprint end date must be on or after start date")
+	ErrSprintInvalidCapacity  = errors.New("sprint capacity must be non-negative")
+	ErrSprintInvalidStatus    = errors.New("sprint status must be 1 (planned), 2 (active) or 3 (completed)")
+	ErrSprintProjectNotAgile  = errors.New("sprints require an agile or hybrid project")
+	ErrSprintTaskMismatch     = errors.New("tasks must belong to the sprint's project")
+
+	SprintAllowedSortField = map[string]string{
+		"id":         "id",
+		"name":       "name",
+		"project_id": "project_id",
+		"start_date": "start_date",
+		"end_date":   "end_date",
+		"capacity":   "capacity",
+		"status":     "status",
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+	}
+)
+
+// Sprint is a time-boxed iteration of an agile or hybrid project
+type Sprint struct {
+	ID        uint       `gorm:"primary_key" json:"id"`
+	Name      string     `gorm:"not null" json:"name"`
+	Goal      string     `gorm:"type:text" json:"goal"`
+	ProjectID uint       `gorm:"not null;index" json:"project_id"`
+	StartDate *time.Time `gorm:"not null;index" json:"start_date"`
+	EndDate   *time.Time `gorm:"not null" json:"end_date"`           // Last day of the sprint
+	Capacity  float64    `gorm:"not null;default:0" json:"capacity"` // Story points the team commits to; 0 means not set
+	Status    uint       `gorm:"not null;default:1;index" json:"status"`
+	CreatedAt time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Project *Project `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName returns the table name for the sprint entity
+func (Sprint) TableName() string {
+	return "sprints"
+}
+
+// IsCompleted returns true if the sprint is completed
+func (s *Sprint) IsCompleted() bool {
+	return s.Status == SprintStatusCompleted
+}
+
+// LengthDays returns the number of calendar days of the sprint, both ends included
+func (s *Sprint) LengthDays() int {
+	if s.StartDate == nil || s.EndDate == nil {
+		return 0
+	}
+	return int(s.EndDate.Sub(*s.StartDate).Round(24*time.Hour).Hours()/24) + 1
+}
+
+// Validate validates the sprint fields
+func (s *Sprint) Validate() error {
+	// Trim whitespace from string fields
+	s.Name = strings.TrimSpace(s.Name)
+	s.Goal = strings.TrimSpace(s.Goal)
+
+	// Validate required fields
+	if s.Name == "" {
+		return ErrSprintNameRequired
+	}
+
+	// Validate project ID
+	if s.ProjectID == 0 {
+		return ErrSprintInvalidProjectID
+	}
+
+	// Validate dates
+	if s.StartDate == nil || s.EndDate == nil {
+		return ErrSprintDatesRequired
+	}
+	if s.EndDate.Before(*s.StartDate) {
+		return ErrSprintInvalidDates
+	}
+
+	// Validate capacity
+	if s.Capacity < 0 {
+		return ErrSprintInvalidCapacity
+	}
+
+	// Validate status
+	if err := s.validateStatus(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Sprint) validateStatus() error {
+	switch s.Status {
+	case SprintStatusPlanned, SprintStatusActive, SprintStatusCompleted:
+		return nil
+	}
+	return ErrSprintInvalidStatus
+}
+
+// BeforeCreate is a GORM hook that runs before creating a sprint
+func (s *Sprint) BeforeCreate(tx *gorm.DB) error {
+	// Set default status if not valid
+	if err := s.validateStatus(); err != nil {
+		s.Status = SprintStatusPlanned
+	}
+
+	return s.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a sprint
+func (s *Sprint) BeforeUpdate(tx *gorm.DB) error {
+	return s.Validate()
+}
+
+// SprintQueryParams defines query parameters for filtering sprints
+type SprintQueryParams struct {
+	ID_In         []uint     `json:"id_in"`
+	Name          string     `json:"name"`
+	Name_Like     string     `json:"name_like"`
+	ProjectID     uint       `json:"project_id"`
+	ProjectID_In  []uint     `json:"project_id_in"`
+	Status        uint       `json:"status"`
+	Status_In     []uint     `json:"status_in"`
+	StartDate_Gte *time.Time `json:"start_date_gte"`
+	StartDate_Lte *time.Time `json:"start_date_lte"`
+	EndDate_Gte   *time.Time `json:"end_date_gte"`
+	EndDate_Lte   *time.Time `json:"end_date_lte"`
+	CreatedAt_Gte *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// SprintListResponse represents the response for GetSprints
+type SprintListResponse struct {
+	Data  []*Sprint `json:"data"`
+	Total int64     `json:"total"`
+}
+
+// SprintVelocity is the story points planned in and delivered by one sprint
+type SprintVelocity struct {
+	SprintID        uint       `json:"sprint_id"`
+	Name            string     `json:"name"`
+	StartDate       *time.Time `json:"start_date"`
+	EndDate         *time.Time `json:"end_date"`
+	Status          uint       `json:"status"`
+	Capacity        float64    `json:"capacity"`
+	CommittedPoints float64    `json:"committed_points"` // Points of the sprint's tasks that are not cancelled
+	CompletedPoints float64    `json:"completed_points"` // Points of the sprint's tasks that are done
+}
+
+// VelocityForecast is the velocity of a project's completed sprints and the number of sprints
+// its remaining backlog needs at that velocity. The forecast is empty until a sprint is completed.
+type VelocityForecast struct {
+	ProjectID        uint              `json:"project_id"`
+	Sprints          []*SprintVelocity `json:"sprints"`     // Ordered by start date
+	SampleSize       int               `json:"sample_size"` // Completed sprints the velocity is taken from
+	AverageVelocity  float64           `json:"average_velocity"`
+	MinVelocity      float64           `json:"min_velocity"`
+	MaxVelocity      float64           `json:"max_velocity"`
+	RemainingPoints  float64           `json:"remaining_points"`   // Points of the open work packages
+	UnpointedTasks   int               `json:"unpointed_tasks"`    // Open work packages without story points, not in the forecast
+	SprintLengthDays int               `json:"sprint_length_days"` // Calendar days of the most recent completed sprint
+	ForecastSprints  int               `json:"forecast_sprints"`   // At the average velocity
+	BestCaseSprints  int               `json:"best_case_sprints"`  // At the highest velocity
+	WorstCaseSprints int               `json:"worst_case_sprints"` // At the lowest velocity
+	ForecastFinish   *time.Time        `json:"forecast_finish"`    // Counted from the end of the most recent completed sprint
+	BestCaseFinish   *time.Time        `json:"best_case_finish"`
+	WorstCaseFinish  *time.Time        `json:"worst_case_finish"`
+}
@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSprintTableName(t *testing.T) {
+	sprint := Sprint{}
+	assert.Equal(t, "sprints", sprint.TableName())
+}
+
+func TestSprintValidate(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 13)
+
+	tests := []struct {
+		name      string
+		sprint    Sprint
+		wantError error
+	}{
+		{
+			name:      "Valid sprint",
+			sprint:    Sprint{Name: "Sprint 1", ProjectID: 1, StartDate: &start, EndDate: &end, Capacity: 30, Status: SprintStatusPlanned},
+			wantError: nil,
+		},
+		{
+			name:      "Valid one-day sprint",
+			sprint:    Sprint{Name: "Hardening", ProjectID: 1, StartDate: &start, EndDate: &start, Status: SprintStatusActive},
+			wantError: nil,
+		},
+		{
+			name:      "Missing name",
+			sprint:    Sprint{Name: "  ", ProjectID: 1, StartDate: &start, EndDate: &end, Status: SprintStatusPlanned},
+			wantError: ErrSprintNameRequired,
+		},
+		{
+			name:      "Missing project",
+			sprint:    Sprint{Name: "Sprint 1", StartDate: &start, EndDate: &end, Status: SprintStatusPlanned},
+			wantError: ErrSprintInvalidProjectID,
+		},
+		{
+			name:      "Missing end date",
+			sprint:    Sprint{Name: "Sprint 1", ProjectID: 1, StartDate: &start, Status: SprintStatusPlanned},
+			wantError: ErrSprintDatesRequired,
+		},
+		{
+			name:      "End before start",
+			sprint:    Sprint{Name: "Sprint 1", ProjectID: 1, StartDate: &end, EndDate: &start, Status: SprintStatusPlanned},
+			wantError: ErrSprintInvalidDates,
+		},
+		{
+			name:      "Negative capacity",
+			sprint:    Sprint{Name: "Sprint 1", ProjectID: 1, StartDate: &start, EndDate: &end, Capacity: -1, Status: SprintStatusPlanned},
+			wantError: ErrSprintInvalidCapacity,
+		},
+		{
+			name:      "Invalid status",
+			sprint:    Sprint{Name: "Sprint 1", ProjectID: 1, StartDate: &start, EndDate: &end, Status: 9},
+			wantError: ErrSprintInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sprint.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSprintLengthDays(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 13)
+	assert.Equal(t, 14, (&Sprint{StartDate: &start, EndDate: &end}).LengthDays())
+	assert.Equal(t, 1, (&Sprint{StartDate: &start, EndDate: &start}).LengthDays())
+	assert.Equal(t, 0, (&Sprint{StartDate: &start}).LengthDays())
+
+	// A daylight saving change inside the sprint does not change its length
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if assert.NoError(t, err) {
+		dstStart := time.Date(2026, 3, 23, 0, 0, 0, 0, loc)
+		dstEnd := dstStart.AddDate(0, 0, 13)
+		assert.Equal(t, 14, (&Sprint{StartDate: &dstStart, EndDate: &dstEnd}).LengthDays())
+	}
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"errors"
+	"math/rand/v2"
 	"strings"
 	"time"
 
@@ -26,48 +27,117 @@ const (
 	TaskPriorityCritical = 4
 )
 
+// Task effort distribution constants (numeric values for database storage)
+const (
+	TaskEffortDistributionUnknown    = 0
+	TaskEffortDistributionPERT       = 1 // Beta distribution weighting the most likely estimate four times
+	TaskEffortDistributionTriangular = 2
+)
+
+// Task constraint constants (numeric values for database storage)
+const (
+	TaskConstraintUnknown            = 0
+	TaskConstraintASAP               = 1 // As soon as possible
+	TaskConstraintALAP               = 2 // As late as possible without delaying successors
+	TaskConstraintMustStartOn        = 3
+	TaskConstraintStartNoEarlierThan = 4
+	TaskConstraintFinishNoLaterThan  = 5
+)
+
 var (
-	ErrTaskNameRequired       = errors.New("task name is required")
-	ErrTaskInvalidStatus      = errors.New("task status must be 1 (to do), 2 (in progress), 3 (done), or 4 (cancelled)")
-	ErrTaskInvalidPriority    = errors.New("task priority must be 1 (low), 2 (medium), 3 (high), or 4 (critical)")
-	ErrTaskInvalidProjectID   = errors.New("task must belong to a project")
-	ErrTaskInvalidLevel       = errors.New("task level must be at least 1")
-	ErrTaskInvalidEffort      = errors.New("task estimated effort must be non-negative")
-	ErrTaskCircularDependency = errors.New("task cannot be its own parent")
+	ErrTaskNameRequired           = errors.New("task name is required")
+	ErrTaskInvalidStatus          = errors.New("task status must be 1 (to do), 2 (in progress), 3 (done), or 4 (cancelled)")
+	ErrTaskInvalidPriority        = errors.New("task priority must be 1 (low), 2 (medium), 3 (high), or 4 (critical)")
+	ErrTaskInvalidProjectID       = errors.New("task must belong to a project")
+	ErrTaskInvalidLevel           = errors.New("task level must be at least 1")
+	ErrTaskInvalidEffort          = errors.New("task estimated effort must be non-negative")
+	ErrTaskCircularDependency     = errors.New("task cannot be its own parent")
+	ErrTaskInvalidLevelingDelay   = errors.New("task leveling delay must be non-negative")
+	ErrTaskInvalidPercentComplete = errors.New("task percent complete must be between 0 and 100")
+	ErrTaskParentProjectMismatch  = errors.New("task parent must belong to the same project")
+	ErrTaskParentCycle            = errors.New("task cannot be moved under one of its own subtasks")
+	ErrTaskParentDependencyCycle  = errors.New("task cannot be moved under this parent because it would create a cycle with task dependencies")
+	ErrTaskInvalidDuration        = errors.New("task duration must be non-negative")
+	ErrTaskInvalidPlannedDates    = errors.New("task planned finish must be on or after planned start")
+	ErrTaskInvalidConstraint      = errors.New("task constraint must be 1 (as soon as possible), 2 (as late as possible), 3 (must start on), 4 (start no earlier than), or 5 (finish no later than)")
+	ErrTaskConstraintDateRequired = errors.New("task constraint date is required for must start on, start no earlier than, and finish no later than constraints")
+	ErrTaskInvalidEstimate        = errors.New("task three-point estimate must satisfy 0 <= optimistic <= most likely <= pessimistic")
+	ErrTaskInvalidDistribution    = errors.New("task effort distribution must be 1 (PERT) or 2 (triangular)")
+	ErrTaskInvalidStoryPoints     = errors.New("task story points must be non-negative")
+	ErrTaskInvalidSize            = errors.New("task size must be xs, s, m, l, xl or xxl")
+	ErrTaskInvalidFunctionPoints  = errors.New("task function points must be non-negative")
+	ErrTaskSizeConflict           = errors.New("task can be sized in a T-shirt size or in function points, not both")
 
 	TaskAllowedSortField = map[string]string{
-		"id":               "id",
-		"name":             "name",
-		"level":            "level",
-		"project_id":       "project_id",
-		"milestone_id":     "milestone_id",
-		"parent_id":        "parent_id",
-		"priority":         "priority",
-		"status":           "status",
-		"estimated_effort": "estimated_effort",
-		"created_at":       "created_at",
-		"updated_at":       "updated_at",
+		"id":                  "id",
+		"name":                "name",
+		"level":               "level",
+		"project_id":          "project_id",
+		"milestone_id":        "milestone_id",
+		"sprint_id":           "sprint_id",
+		"parent_id":           "parent_id",
+		"priority":            "priority",
+		"status":              "status",
+		"estimated_effort":    "estimated_effort",
+		"optimistic_effort":   "optimistic_effort",
+		"most_likely_effort":  "most_likely_effort",
+		"pessimistic_effort":  "pessimistic_effort",
+		"effort_distribution": "effort_distribution",
+		"story_points":        "story_points",
+		"size":                "size",
+		"function_points":     "function_points",
+		"percent_complete":    "percent_complete",
+		"leveling_delay":      "leveling_delay",
+		"planned_start":       "planned_start",
+		"planned_finish":      "planned_finish",
+		"duration":            "duration",
+		"constraint_type":     "constraint_type",
+		"constraint_date":     "constraint_date",
+		"created_at":          "created_at",
+		"updated_at":          "updated_at",
 	}
 )
 
 // Task represents a task entity within a project
 type Task struct {
-	ID              uint       `gorm:"primary_key" json:"id"`
-	Name            string     `gorm:"not null" json:"name"`
-	Description     string     `gorm:"type:text" json:"description"`
-	Level           int        `gorm:"not null;default:1" json:"level"`
-	ProjectID       uint       `gorm:"not null;index" json:"project_id"`
-	MilestoneID     *uint      `gorm:"index" json:"milestone_id"`
-	ParentID        *uint      `gorm:"index" json:"parent_id"`
-	Priority        uint       `gorm:"not null;default:2" json:"priority"`
-	EstimatedEffort float64    `gorm:"not null;default:0" json:"estimated_effort"`
-	Status          uint       `gorm:"not null;default:1" json:"status"`
-	CreatedAt       time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
-	UpdatedAt       time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+	ID                 uint       `gorm:"primary_key" json:"id"`
+	Name               string     `gorm:"not null" json:"name"`
+	Description        string     `gorm:"type:text" json:"description"`
+	Level              int        `gorm:"not null;default:1" json:"level"`
+	ProjectID          uint       `gorm:"not null;index" json:"project_id"`
+	MilestoneID        *uint      `gorm:"index" json:"milestone_id"`
+	SprintID           *uint      `gorm:"index" json:"sprint_id"` // Sprint the task is planned in; nil keeps it in the backlog
+	ParentID           *uint      `gorm:"index" json:"parent_id"`
+	Priority           uint       `gorm:"not null;default:2" json:"priority"`
+	EstimatedEffort    float64    `gorm:"not null;default:0" json:"estimated_effort"`    // Working hours; follows the expected effort when a three-point estimate is given
+	OptimisticEffort   float64    `gorm:"not null;default:0" json:"optimistic_effort"`   // Hours in the best case
+	MostLikelyEffort   float64    `gorm:"not null;default:0" json:"most_likely_effort"`  // Hours in the most likely case
+	PessimisticEffort  float64    `gorm:"not null;default:0" json:"pessimistic_effort"`  // Hours in the worst case; 0 means no three-point estimate
+	EffortDistribution uint       `gorm:"not null;default:1" json:"effort_distribution"` // How the three-point estimate is weighted
+	StoryPoints        float64    `gorm:"not null;default:0" json:"story_points"`        // Relative size for sprint planning; 0 means not pointed
+	Size               SizeUnit   `gorm:"default:'';index" json:"size"`                  // T-shirt size; empty means not sized in T-shirt sizes
+	FunctionPoints     float64    `gorm:"not null;default:0" json:"function_points"`     // 0 means not sized in function points
+	EffortOverride     bool       `gorm:"not null;default:false" json:"effort_override"` // Estimated effort was entered by hand and does not follow the size
+	Status             uint       `gorm:"not null;default:1" json:"status"`
+	PercentComplete    float64    `gorm:"not null;default:0" json:"percent_complete"` // 0 to 100
+	LevelingDelay      float64    `gorm:"not null;default:0" json:"leveling_delay"`   // Working hours the task is pushed back by resource leveling
+	PlannedStart       *time.Time `gorm:"index" json:"planned_start"`
+	PlannedFinish      *time.Time `gorm:"index" json:"planned_finish"`
+	Duration           float64    `gorm:"not null;default:0" json:"duration"` // Working days; 0 means the duration follows from EstimatedEffort
+	ConstraintType     uint       `gorm:"not null;default:1" json:"constraint_type"`
+	ConstraintDate     *time.Time `gorm:"index" json:"constraint_date"`
+	BaselineStart      *time.Time `gorm:"" json:"baseline_start"`                    // Planned start when the baseline was set
+	BaselineFinish     *time.Time `gorm:"" json:"baseline_finish"`                   // Planned finish when the baseline was set
+	BaselineEffort     float64    `gorm:"not null;default:0" json:"baseline_effort"` // Estimated hours when the baseline was set
+	BaselineCost       Money      `gorm:"not null;default:0" json:"baseline_cost"`   // Planned cost of the assignments when the baseline was set
+	BaselinedAt        *time.Time `gorm:"" json:"baselined_at"`                      // When the baseline was set; nil if the task is not in the baseline
+	CreatedAt          time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt          time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
 
 	// Relationships
 	Project   *Project   `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
 	Milestone *Milestone `gorm:"foreignKey:MilestoneID" json:"milestone,omitempty"`
+	Sprint    *Sprint    `gorm:"foreignKey:SprintID;constraint:-" json:"sprint,omitempty"` // No database constraint; deleting a sprint moves its tasks to the backlog
 	Parent    *Task      `gorm:"foreignKey:ParentID" json:"parent,omitempty"`
 	Children  []*Task    `gorm:"foreignKey:ParentID" json:"children,omitempty"`
 }
@@ -97,7 +167,61 @@ func (t *Task) IsCancelled() bool {
 	return t.Status == TaskWorkStatusCancelled
 }
 
-// Validate validates the task fields
+// Progress returns the percentage of the task that is complete; a done task is complete
+func (t *Task) Progress() float64 {
+	if t.IsDone() {
+		return 100
+	}
+	return t.PercentComplete
+}
+
+// IsBaselined returns true if the task is part of the project's baseline
+func (t *Task) IsBaselined() bool {
+	return t.BaselinedAt != nil
+}
+
+// HasDatedConstraint returns true if the task's constraint is tied to its constraint date
+func (t *Task) HasDatedConstraint() bool {
+	switch t.ConstraintType {
+	case TaskConstraintMustStartOn, TaskConstraintStartNoEarlierThan, TaskConstraintFinishNoLaterThan:
+		return true
+	}
+	return false
+}
+
+// HasThreePointEstimate returns true if the task has optimistic, most likely and pessimistic estimates
+func (t *Task) HasThreePointEstimate() bool {
+	return t.PessimisticEffort > 0
+}
+
+// EffortEstimate returns the expected effort of the task and its variance. Without a
+// three-point estimate the estimated effort is taken as certain.
+func (t *Task) EffortEstimate() EffortEstimate {
+	if !t.HasThreePointEstimate() {
+		return NewEffortEstimate(t.EstimatedEffort, 0)
+	}
+	o, m, p := t.OptimisticEffort, t.MostLikelyEffort, t.PessimisticEffort
+	if t.EffortDistribution == TaskEffortDistributionTriangular {
+		return NewEffortEstimate((o+m+p)/3, (o*o+m*m+p*p-o*m-o*p-m*p)/18)
+	}
+	sd := (p - o) / 6
+	return NewEffortEstimate((o+4*m+p)/6, sd*sd)
+}
+
+// SampleEffort draws an effort for the task from the distribution of its three-point
+// estimate. Without one the estimated effort is returned.
+func (t *Task) SampleEffort(rng *rand.Rand) float64 {
+	if !t.HasThreePointEstimate() {
+		return t.EstimatedEffort
+	}
+	o, m, p := t.OptimisticEffort, t.MostLikelyEffort, t.PessimisticEffort
+	if t.EffortDistribution == TaskEffortDistributionTriangular {
+		return sampleTriangular(rng, o, m, p)
+	}
+	return samplePERT(rng, o, m, p)
+}
+
+// Validate validates the task fields. The constraint date of an undated constraint is cleared.
 func (t *Task) Validate() error {
 	// Trim whitespace from string fields
 	t.Name = strings.TrimSpace(t.Name)
@@ -123,6 +247,46 @@ func (t *Task) Validate() error {
 		return ErrTaskInvalidEffort
 	}
 
+	// Validate three-point estimate
+	if err := t.validateEstimate(); err != nil {
+		return err
+	}
+
+	// Validate percent complete
+	if t.PercentComplete < 0 || t.PercentComplete > 100 {
+		return ErrTaskInvalidPercentComplete
+	}
+
+	// Validate leveling delay
+	if t.LevelingDelay < 0 {
+		return ErrTaskInvalidLevelingDelay
+	}
+
+	// Validate story points
+	if t.StoryPoints < 0 {
+		return ErrTaskInvalidStoryPoints
+	}
+
+	// Validate size
+	if err := t.validateSize(); err != nil {
+		return err
+	}
+
+	// Validate duration
+	if t.Duration < 0 {
+		return ErrTaskInvalidDuration
+	}
+
+	// Validate planned dates
+	if t.PlannedStart != nil && t.PlannedFinish != nil && t.PlannedFinish.Before(*t.PlannedStart) {
+		return ErrTaskInvalidPlannedDates
+	}
+
+	// Validate constraint
+	if err := t.validateConstraint(); err != nil {
+		return err
+	}
+
 	// Validate parent is not self
 	if t.ParentID != nil && *t.ParentID == t.ID && t.ID != 0 {
 		return ErrTaskCircularDependency
@@ -149,6 +313,51 @@ func (t *Task) validateStatus() error {
 	return ErrTaskInvalidStatus
 }
 
+func (t *Task) validateEstimate() error {
+	switch t.EffortDistribution {
+	case TaskEffortDistributionUnknown, TaskEffortDistributionPERT, TaskEffortDistributionTriangular:
+	default:
+		return ErrTaskInvalidDistribution
+	}
+	if !t.HasThreePointEstimate() {
+		if t.OptimisticEffort != 0 || t.MostLikelyEffort != 0 {
+			return ErrTaskInvalidEstimate
+		}
+		return nil
+	}
+	if t.OptimisticEffort < 0 || t.OptimisticEffort > t.MostLikelyEffort || t.MostLikelyEffort > t.PessimisticEffort {
+		return ErrTaskInvalidEstimate
+	}
+	return nil
+}
+
+func (t *Task) validateSize() error {
+	if t.Size != "" && !IsTShirtSize(t.Size) {
+		return ErrTaskInvalidSize
+	}
+	if t.FunctionPoints < 0 {
+		return ErrTaskInvalidFunctionPoints
+	}
+	if t.Size != "" && t.FunctionPoints > 0 {
+		return ErrTaskSizeConflict
+	}
+	return nil
+}
+
+func (t *Task) validateConstraint() error {
+	switch t.ConstraintType {
+	case TaskConstraintASAP, TaskConstraintALAP:
+		t.ConstraintDate = nil
+		return nil
+	case TaskConstraintMustStartOn, TaskConstraintStartNoEarlierThan, TaskConstraintFinishNoLaterThan:
+		if t.ConstraintDate == nil {
+			return ErrTaskConstraintDateRequired
+		}
+		return nil
+	}
+	return ErrTaskInvalidConstraint
+}
+
 func (t *Task) validatePriority() error {
 	switch t.Priority {
 	case TaskPriorityLow, TaskPriorityMedium, TaskPriorityHigh, TaskPriorityCritical:
@@ -174,41 +383,104 @@ func (t *Task) BeforeCreate(tx *gorm.DB) error {
 		t.Level = 1
 	}
 
-	return t.Validate()
+	// Set default constraint if not set
+	if t.ConstraintType == TaskConstraintUnknown {
+		t.ConstraintType = TaskConstraintASAP
+	}
+
+	return t.applyEstimate()
 }
 
 // BeforeUpdate is a GORM hook that runs before updating a task
 func (t *Task) BeforeUpdate(tx *gorm.DB) error {
-	return t.Validate()
+	// Tasks saved without a constraint keep the default one
+	if t.ConstraintType == TaskConstraintUnknown {
+		t.ConstraintType = TaskConstraintASAP
+	}
+
+	return t.applyEstimate()
+}
+
+// applyEstimate validates the task and sets its estimated effort to the expected value of
+// its three-point estimate, if any
+func (t *Task) applyEstimate() error {
+	// Tasks saved without a distribution use PERT
+	if t.EffortDistribution == TaskEffortDistributionUnknown {
+		t.EffortDistribution = TaskEffortDistributionPERT
+	}
+
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	if t.HasThreePointEstimate() {
+		t.EstimatedEffort = t.EffortEstimate().Expected
+	}
+	return nil
+}
+
+// IsSized returns true if the task has a T-shirt size or function points
+func (t *Task) IsSized() bool {
+	return t.Size != "" || t.FunctionPoints > 0
+}
+
+// SizeUnit returns the unit the task is sized in, or an empty unit if it is not sized
+func (t *Task) SizeUnit() SizeUnit {
+	if t.FunctionPoints > 0 {
+		return SizeUnitFunctionPoint
+	}
+	return t.Size
 }
 
 // TaskQueryParams defines query parameters for filtering tasks
 type TaskQueryParams struct {
-	ID_In               []uint     `json:"id_in"`
-	Name                string     `json:"name"`
-	Name_Like           string     `json:"name_like"`
-	Description_Like    string     `json:"description_like"`
-	Level               int        `json:"level"`
-	Level_Gte           *int       `json:"level_gte"`
-	Level_Lte           *int       `json:"level_lte"`
-	ProjectID           uint       `json:"project_id"`
-	ProjectID_In        []uint     `json:"project_id_in"`
-	MilestoneID         *uint      `json:"milestone_id"`
-	MilestoneID_In      []uint     `json:"milestone_id_in"`
-	MilestoneID_IsNull  *bool      `json:"milestone_id_is_null"`
-	ParentID            *uint      `json:"parent_id"`
-	ParentID_In         []uint     `json:"parent_id_in"`
-	ParentID_IsNull     *bool      `json:"parent_id_is_null"`
-	Priority            uint       `json:"priority"`
-	Priority_In         []uint     `json:"priority_in"`
-	Status              uint       `json:"status"`
-	Status_In           []uint     `json:"status_in"`
-	EstimatedEffort_Gte *float64   `json:"estimated_effort_gte"`
-	EstimatedEffort_Lte *float64   `json:"estimated_effort_lte"`
-	CreatedAt_Gte       *time.Time `json:"created_at_gte"`
-	CreatedAt_Lte       *time.Time `json:"created_at_lte"`
-	UpdatedAt_Gte       *time.Time `json:"updated_at_gte"`
-	UpdatedAt_Lte       *time.Time `json:"updated_at_lte"`
+	ID_In                 []uint     `json:"id_in"`
+	Name                  string     `json:"name"`
+	Name_Like             string     `json:"name_like"`
+	Description_Like      string     `json:"description_like"`
+	Level                 int        `json:"level"`
+	Level_Gte             *int       `json:"level_gte"`
+	Level_Lte             *int       `json:"level_lte"`
+	ProjectID             uint       `json:"project_id"`
+	ProjectID_In          []uint     `json:"project_id_in"`
+	MilestoneID           *uint      `json:"milestone_id"`
+	MilestoneID_In        []uint     `json:"milestone_id_in"`
+	MilestoneID_IsNull    *bool      `json:"milestone_id_is_null"`
+	SprintID              *uint      `json:"sprint_id"`
+	SprintID_In           []uint     `json:"sprint_id_in"`
+	SprintID_IsNull       *bool      `json:"sprint_id_is_null"` // True for backlog tasks only
+	ParentID              *uint      `json:"parent_id"`
+	ParentID_In           []uint     `json:"parent_id_in"`
+	ParentID_IsNull       *bool      `json:"parent_id_is_null"`
+	Priority              uint       `json:"priority"`
+	Priority_In           []uint     `json:"priority_in"`
+	Status                uint       `json:"status"`
+	Status_In             []uint     `json:"status_in"`
+	EstimatedEffort_Gte   *float64   `json:"estimated_effort_gte"`
+	EstimatedEffort_Lte   *float64   `json:"estimated_effort_lte"`
+	EffortDistribution    uint       `json:"effort_distribution"`
+	EffortDistribution_In []uint     `json:"effort_distribution_in"`
+	HasThreePointEstimate *bool      `json:"has_three_point_estimate"`
+	StoryPoints_Gte       *float64   `json:"story_points_gte"`
+	StoryPoints_Lte       *float64   `json:"story_points_lte"`
+	Size                  SizeUnit   `json:"size"`
+	Size_In               []SizeUnit `json:"size_in"`
+	EffortOverride        *bool      `json:"effort_override"`
+	PercentComplete_Gte   *float64   `json:"percent_complete_gte"`
+	PercentComplete_Lte   *float64   `json:"percent_complete_lte"`
+	PlannedStart_Gte      *time.Time `json:"planned_start_gte"`
+	PlannedStart_Lte      *time.Time `json:"planned_start_lte"`
+	PlannedFinish_Gte     *time.Time `json:"planned_finish_gte"`
+	PlannedFinish_Lte     *time.Time `json:"planned_finish_lte"`
+	Duration_Gte          *float64   `json:"duration_gte"`
+	Duration_Lte          *float64   `json:"duration_lte"`
+	ConstraintType        uint       `json:"constraint_type"`
+	ConstraintType_In     []uint     `json:"constraint_type_in"`
+	ConstraintDate_Gte    *time.Time `json:"constraint_date_gte"`
+	ConstraintDate_Lte    *time.Time `json:"constraint_date_lte"`
+	CreatedAt_Gte         *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte         *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte         *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte         *time.Time `json:"updated_at_lte"`
 	*QueryParams
 }
 
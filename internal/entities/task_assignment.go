@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTaskAssignmentInvalidTaskID            = errors.New("task assignment must belong to a task")
+	ErrTaskAssignmentInvalidHumanResourceID   = errors.New("task assignment must have a valid human resource ID")
+	ErrTaskAssignmentInvalidProjectResourceID = errors.New("task assignment must have a valid project resource ID")
+	ErrTaskAssignmentInvalidPlannedHours      = errors.New("task assignment planned hours must be non-negative")
+	ErrTaskAssignmentInvalidUnits             = errors.New("task assignment units must be greater than 0 and at most 100")
+	ErrTaskAssignmentResourceNotAllocated     = errors.New("human resource is not actively allocated to the task's project")
+	ErrTaskAssignmentResourceMismatch         = errors.New("project resource belongs to a different human resource")
+
+	TaskAssignmentAllowedSortField = map[string]string{
+		"id":                  "id",
+		"task_id":             "task_id",
+		"project_resource_id": "project_resource_id",
+		"human_resource_id":   "human_resource_id",
+		"planned_hours":       "planned_hours",
+		"units":               "units",
+		"created_at":          "created_at",
+		"updated_at":          "updated_at",
+	}
+)
+
+// TaskAssignment assigns a human resource allocated to a project to one of the project's tasks
+type TaskAssignment struct {
+	ID                uint      `gorm:"primary_key" json:"id"`
+	TaskID            uint      `gorm:"not null;index;uniqueIndex:idx_task_assignment_task_human_resource" json:"task_id"`
+	ProjectResourceID uint      `gorm:"not null;index" json:"project_resource_id"`
+	HumanResourceID   uint      `gorm:"not null;index;uniqueIndex:idx_task_assignment_task_human_resource" json:"human_resource_id"`
+	PlannedHours      float64   `gorm:"not null;default:0" json:"planned_hours"` // Working hours the resource is planned to spend on the task
+	Units             float64   `gorm:"not null;default:100" json:"units"`       // Percentage of the resource's time taken while the task runs
+	Notes             string    `gorm:"type:text" json:"notes"`
+	CreatedAt         time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Task            *Task            `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE" json:"task,omitempty"`
+	ProjectResource *ProjectResource `gorm:"foreignKey:ProjectResourceID;constraint:OnDelete:CASCADE" json:"project_resource,omitempty"`
+	HumanResource   *HumanResource   `gorm:"foreignKey:HumanResourceID" json:"human_resource,omitempty"`
+}
+
+// TableName returns the table name for the task assignment entity
+func (TaskAssignment) TableName() string {
+	return "task_assignments"
+}
+
+// Validate validates the task assignment fields
+func (a *TaskAssignment) Validate() error {
+	// Trim whitespace from string fields
+	a.Notes = strings.TrimSpace(a.Notes)
+
+	// Validate required fields
+	if a.TaskID == 0 {
+		return ErrTaskAssignmentInvalidTaskID
+	}
+
+	if a.HumanResourceID == 0 {
+		return ErrTaskAssignmentInvalidHumanResourceID
+	}
+
+	if a.ProjectResourceID == 0 {
+		return ErrTaskAssignmentInvalidProjectResourceID
+	}
+
+	// Validate planned hours and units
+	if a.PlannedHours < 0 {
+		return ErrTaskAssignmentInvalidPlannedHours
+	}
+
+	if a.Units <= 0 || a.Units > 100 {
+		return ErrTaskAssignmentInvalidUnits
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a task assignment
+func (a *TaskAssignment) BeforeCreate(tx *gorm.DB) error {
+	// Set default units if not set
+	if a.Units == 0 {
+		a.Units = 100
+	}
+
+	return a.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a task assignment
+func (a *TaskAssignment) BeforeUpdate(tx *gorm.DB) error {
+	return a.Validate()
+}
+
+// TaskAssignmentQueryParams defines query parameters for filtering task assignments
+type TaskAssignmentQueryParams struct {
+	ID_In                []uint     `json:"id_in"`
+	TaskID               uint       `json:"task_id"`
+	TaskID_In            []uint     `json:"task_id_in"`
+	ProjectID            uint       `json:"project_id"` // Project of the assigned task
+	ProjectID_In         []uint     `json:"project_id_in"`
+	ProjectResourceID    uint       `json:"project_resource_id"`
+	ProjectResourceID_In []uint     `json:"project_resource_id_in"`
+	HumanResourceID      uint       `json:"human_resource_id"`
+	HumanResourceID_In   []uint     `json:"human_resource_id_in"`
+	PlannedHours_Gte     *float64   `json:"planned_hours_gte"`
+	PlannedHours_Lte     *float64   `json:"planned_hours_lte"`
+	Units_Gte            *float64   `json:"units_gte"`
+	Units_Lte            *float64   `json:"units_lte"`
+	CreatedAt_Gte        *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte        *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte        *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte        *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// TaskAssignmentListResponse represents the response for GetTaskAssignments
+type TaskAssignmentListResponse struct {
+	Data  []*TaskAssignment `json:"data"`
+	Total int64             `json:"total"`
+}
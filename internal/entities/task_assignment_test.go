@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskAssignmentTableName(t *testing.T) {
+	assignment := TaskAssignment{}
+	assert.Equal(t, "task_assignments", assignment.TableName())
+}
+
+func TestTaskAssignmentValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		assignment TaskAssignment
+		wantError  error
+	}{
+		{
+			name:       "Valid assignment",
+			assignment: TaskAssignment{TaskID: 1, ProjectResourceID: 1, HumanResourceID: 1, PlannedHours: 8, Units: 50},
+			wantError:  nil,
+		},
+		{
+			name:       "Missing task",
+			assignment: TaskAssignment{ProjectResourceID: 1, HumanResourceID: 1, Units: 100},
+			wantError:  ErrTaskAssignmentInvalidTaskID,
+		},
+		{
+			name:       "Missing human resource",
+			assignment: TaskAssignment{TaskID: 1, ProjectResourceID: 1, Units: 100},
+			wantError:  ErrTaskAssignmentInvalidHumanResourceID,
+		},
+		{
+			name:       "Missing project resource",
+			assignment: TaskAssignment{TaskID: 1, HumanResourceID: 1, Units: 100},
+			wantError:  ErrTaskAssignmentInvalidProjectResourceID,
+		},
+		{
+			name:       "Negative planned hours",
+			assignment: TaskAssignment{TaskID: 1, ProjectResourceID: 1, HumanResourceID: 1, PlannedHours: -1, Units: 100},
+			wantError:  ErrTaskAssignmentInvalidPlannedHours,
+		},
+		{
+			name:       "Zero units",
+			assignment: TaskAssignment{TaskID: 1, ProjectResourceID: 1, HumanResourceID: 1},
+			wantError:  ErrTaskAssignmentInvalidUnits,
+		},
+		{
+			name:       "Units above 100",
+			assignment: TaskAssignment{TaskID: 1, ProjectResourceID: 1, HumanResourceID: 1, Units: 150},
+			wantError:  ErrTaskAssignmentInvalidUnits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.assignment.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
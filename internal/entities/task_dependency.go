@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTaskDependencyInvalidPredecessorID = errors.New("task dependency must have a valid predecessor task ID")
+	ErrTaskDependencyInvalidSuccessorID   = errors.New("task dependency must have a valid successor task ID")
+	ErrTaskDependencySelfReference        = errors.New("task cannot depend on itself")
+	ErrTaskDependencyInvalidType          = errors.New("task dependency type must be finish_to_start, start_to_start, finish_to_finish, or start_to_finish")
+	ErrTaskDependencyCrossProject         = errors.New("task dependency links tasks from different projects; enable allow_cross_project to permit it")
+	ErrTaskDependencyCycle                = errors.New("task dependency would create a cycle")
+
+	TaskDependencyAllowedSortField = map[string]string{
+		"id":             "id",
+		"predecessor_id": "predecessor_id",
+		"successor_id":   "successor_id",
+		"type":           "type",
+		"lag_hours":      "lag_hours",
+		"created_at":     "created_at",
+		"updated_at":     "updated_at",
+	}
+)
+
+// TaskDependency represents a scheduling link between two tasks.
+// The successor is constrained by the predecessor according to Type,
+// shifted by LagHours working hours (a negative value is a lead).
+type TaskDependency struct {
+	ID                uint           `gorm:"primary_key" json:"id"`
+	PredecessorID     uint           `gorm:"not null;index;uniqueIndex:idx_task_dependency_pair,priority:1" json:"predecessor_id"`
+	SuccessorID       uint           `gorm:"not null;index;uniqueIndex:idx_task_dependency_pair,priority:2" json:"successor_id"`
+	Type              DependencyType `gorm:"not null;default:finish_to_start" json:"type"`
+	LagHours          float64        `gorm:"not null;default:0" json:"lag_hours"`               // Lag (positive) or lead (negative) in working hours
+	AllowCrossProject bool           `gorm:"not null;default:false" json:"allow_cross_project"` // Opt-in for linking tasks of different projects
+	CreatedAt         time.Time      `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt         time.Time      `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Predecessor *Task `gorm:"foreignKey:PredecessorID;constraint:OnDelete:CASCADE" json:"predecessor,omitempty"`
+	Successor   *Task `gorm:"foreignKey:SuccessorID;constraint:OnDelete:CASCADE" json:"successor,omitempty"`
+}
+
+// TableName returns the table name for the task dependency entity
+func (TaskDependency) TableName() string {
+	return "task_dependencies"
+}
+
+// Validate validates the task dependency fields
+func (d *TaskDependency) Validate() error {
+	// Validate required fields
+	if d.PredecessorID == 0 {
+		return ErrTaskDependencyInvalidPredecessorID
+	}
+
+	if d.SuccessorID == 0 {
+		return ErrTaskDependencyInvalidSuccessorID
+	}
+
+	// Validate the dependency is not a self reference
+	if d.PredecessorID == d.SuccessorID {
+		return ErrTaskDependencySelfReference
+	}
+
+	// Validate type
+	if !IsValidDependencyType(d.Type) {
+		return ErrTaskDependencyInvalidType
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a task dependency
+func (d *TaskDependency) BeforeCreate(tx *gorm.DB) error {
+	// Set default type if not set
+	if d.Type == "" {
+		d.Type = DependencyFinishToStart
+	}
+
+	return d.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a task dependency
+func (d *TaskDependency) BeforeUpdate(tx *gorm.DB) error {
+	return d.Validate()
+}
+
+// TaskDependencyQueryParams defines query parameters for filtering task dependencies
+type TaskDependencyQueryParams struct {
+	ID_In            []uint           `json:"id_in"`
+	PredecessorID    uint             `json:"predecessor_id"`
+	PredecessorID_In []uint           `json:"predecessor_id_in"`
+	SuccessorID      uint             `json:"successor_id"`
+	SuccessorID_In   []uint           `json:"successor_id_in"`
+	TaskID           uint             `json:"task_id"`    // Matches either side of the dependency
+	ProjectID        uint             `json:"project_id"` // Matches dependencies touching a task of the project
+	Type             DependencyType   `json:"type"`
+	Type_In          []DependencyType `json:"type_in"`
+	CreatedAt_Gte    *time.Time       `json:"created_at_gte"`
+	CreatedAt_Lte    *time.Time       `json:"created_at_lte"`
+	UpdatedAt_Gte    *time.Time       `json:"updated_at_gte"`
+	UpdatedAt_Lte    *time.Time       `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// TaskDependencyListResponse represents the response for GetTaskDependencies
+type TaskDependencyListResponse struct {
+	Data  []*TaskDependency `json:"data"`
+	Total int64             `json:"total"`
+}
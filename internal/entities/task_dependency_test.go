@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTaskDependencyTableName(t *testing.T) {
+	d := TaskDependency{}
+	assert.Equal(t, "task_dependencies", d.TableName())
+}
+
+func TestTaskDependencyValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		d         TaskDependency
+		wantError error
+	}{
+		{
+			name:      "Valid finish to start",
+			d:         TaskDependency{PredecessorID: 1, SuccessorID: 2, Type: DependencyFinishToStart},
+			wantError: nil,
+		},
+		{
+			name:      "Valid start to finish with lead",
+			d:         TaskDependency{PredecessorID: 1, SuccessorID: 2, Type: DependencyStartToFinish, LagHours: -4},
+			wantError: nil,
+		},
+		{
+			name:      "Missing predecessor",
+			d:         TaskDependency{SuccessorID: 2, Type: DependencyFinishToStart},
+			wantError: ErrTaskDependencyInvalidPredecessorID,
+		},
+		{
+			name:      "Missing successor",
+			d:         TaskDependency{PredecessorID: 1, Type: DependencyFinishToStart},
+			wantError: ErrTaskDependencyInvalidSuccessorID,
+		},
+		{
+			name:      "Self reference",
+			d:         TaskDependency{PredecessorID: 1, SuccessorID: 1, Type: DependencyFinishToStart},
+			wantError: ErrTaskDependencySelfReference,
+		},
+		{
+			name:      "Invalid type",
+			d:         TaskDependency{PredecessorID: 1, SuccessorID: 2, Type: "blocking"},
+			wantError: ErrTaskDependencyInvalidType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.d.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func setupTaskDependencyTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	// Auto-migrate all required tables
+	err = db.AutoMigrate(&Client{}, &Project{}, &Milestone{}, &Task{}, &TaskDependency{})
+	if err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+func TestTaskDependencyBeforeCreate(t *testing.T) {
+	db := setupTaskDependencyTestDB(t)
+
+	client := &Client{Name: "Test Client", Email: "test@client.com", Status: ClientStatusActive}
+	assert.NoError(t, db.Create(client).Error)
+	project := &Project{Name: "Test Project", ClientID: client.ID, Status: ProjectStatusActive}
+	assert.NoError(t, db.Create(project).Error)
+	first := &Task{Name: "Design", ProjectID: project.ID}
+	assert.NoError(t, db.Create(first).Error)
+	second := &Task{Name: "Build", ProjectID: project.ID}
+	assert.NoError(t, db.Create(second).Error)
+
+	t.Run("Default type is finish to start", func(t *testing.T) {
+		d := TaskDependency{PredecessorID: first.ID, SuccessorID: second.ID}
+		result := db.Create(&d)
+		assert.NoError(t, result.Error)
+		assert.NotZero(t, d.ID)
+		assert.Equal(t, DependencyFinishToStart, d.Type)
+	})
+
+	t.Run("Duplicate pair is rejected", func(t *testing.T) {
+		d := TaskDependency{PredecessorID: first.ID, SuccessorID: second.ID, Type: DependencyStartToStart}
+		result := db.Create(&d)
+		assert.Error(t, result.Error)
+	})
+
+	t.Run("Self reference is rejected", func(t *testing.T) {
+		d := TaskDependency{PredecessorID: first.ID, SuccessorID: first.ID}
+		result := db.Create(&d)
+		assert.Equal(t, ErrTaskDependencySelfReference, result.Error)
+	})
+}
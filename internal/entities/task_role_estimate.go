@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTaskRoleEstimateInvalidTaskID        = errors.New("task role estimate must belong to a task")
+	ErrTaskRoleEstimateInvalidProjectRoleID = errors.New("task role estimate must have a valid project role ID")
+	ErrTaskRoleEstimateInvalidEffort        = errors.New("task role estimate effort must be non-negative")
+	ErrTaskRoleEstimateRoleMismatch         = errors.New("project role belongs to a different project than the task")
+
+	TaskRoleEstimateAllowedSortField = map[string]string{
+		"id":              "id",
+		"task_id":         "task_id",
+		"project_role_id": "project_role_id",
+		"effort":          "effort",
+		"created_at":      "created_at",
+		"updated_at":      "updated_at",
+	}
+)
+
+// TaskRoleEstimate is the effort a task needs from one role of its project,
+// such as 40 hours of a senior backend developer
+type TaskRoleEstimate struct {
+	ID            uint      `gorm:"primary_key" json:"id"`
+	TaskID        uint      `gorm:"not null;index;uniqueIndex:idx_task_role_estimate_task_role" json:"task_id"`
+	ProjectRoleID uint      `gorm:"not null;index;uniqueIndex:idx_task_role_estimate_task_role" json:"project_role_id"`
+	Effort        float64   `gorm:"not null;default:0" json:"effort"` // Working hours
+	Notes         string    `gorm:"type:text" json:"notes"`
+	CreatedAt     time.Time `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Task        *Task        `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE" json:"task,omitempty"`
+	ProjectRole *ProjectRole `gorm:"foreignKey:ProjectRoleID;constraint:OnDelete:CASCADE" json:"project_role,omitempty"`
+}
+
+// TableName returns the table name for the task role estimate entity
+func (TaskRoleEstimate) TableName() string {
+	return "task_role_estimates"
+}
+
+// Validate validates the task role estimate fields
+func (e *TaskRoleEstimate) Validate() error {
+	// Trim whitespace from string fields
+	e.Notes = strings.TrimSpace(e.Notes)
+
+	// Validate required fields
+	if e.TaskID == 0 {
+		return ErrTaskRoleEstimateInvalidTaskID
+	}
+
+	if e.ProjectRoleID == 0 {
+		return ErrTaskRoleEstimateInvalidProjectRoleID
+	}
+
+	// Validate effort
+	if e.Effort < 0 {
+		return ErrTaskRoleEstimateInvalidEffort
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a task role estimate
+func (e *TaskRoleEstimate) BeforeCreate(tx *gorm.DB) error {
+	return e.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a task role estimate
+func (e *TaskRoleEstimate) BeforeUpdate(tx *gorm.DB) error {
+	return e.Validate()
+}
+
+// TaskRoleEstimateQueryParams defines query parameters for filtering task role estimates
+type TaskRoleEstimateQueryParams struct {
+	ID_In            []uint     `json:"id_in"`
+	TaskID           uint       `json:"task_id"`
+	TaskID_In        []uint     `json:"task_id_in"`
+	ProjectID        uint       `json:"project_id"` // Project of the estimated task
+	ProjectID_In     []uint     `json:"project_id_in"`
+	ProjectRoleID    uint       `json:"project_role_id"`
+	ProjectRoleID_In []uint     `json:"project_role_id_in"`
+	Effort_Gte       *float64   `json:"effort_gte"`
+	Effort_Lte       *float64   `json:"effort_lte"`
+	CreatedAt_Gte    *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte    *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte    *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte    *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// TaskRoleEstimateListResponse represents the response for GetTaskRoleEstimates
+type TaskRoleEstimateListResponse struct {
+	Data  []*TaskRoleEstimate `json:"data"`
+	Total int64               `json:"total"`
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskRoleEstimateTableName(t *testing.T) {
+	estimate := TaskRoleEstimate{}
+	assert.Equal(t, "task_role_estimates", estimate.TableName())
+}
+
+func TestTaskRoleEstimateValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		estimate  TaskRoleEstimate
+		wantError error
+	}{
+		{
+			name:      "Valid estimate",
+			estimate:  TaskRoleEstimate{TaskID: 1, ProjectRoleID: 1, Effort: 40},
+			wantError: nil,
+		},
+		{
+			name:      "Missing task",
+			estimate:  TaskRoleEstimate{ProjectRoleID: 1, Effort: 40},
+			wantError: ErrTaskRoleEstimateInvalidTaskID,
+		},
+		{
+			name:      "Missing project role",
+			estimate:  TaskRoleEstimate{TaskID: 1, Effort: 40},
+			wantError: ErrTaskRoleEstimateInvalidProjectRoleID,
+		},
+		{
+			name:      "Negative effort",
+			estimate:  TaskRoleEstimate{TaskID: 1, ProjectRoleID: 1, Effort: -1},
+			wantError: ErrTaskRoleEstimateInvalidEffort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.estimate.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package entities
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskTableName(t *testing.T) {
+	task := Task{}
+	assert.Equal(t, "tasks", task.TableName())
+}
+
+func TestTaskValidateScheduling(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	nextDay := day.AddDate(0, 0, 1)
+	valid := func() Task {
+		return Task{Name: "Design", ProjectID: 1, Level: 1, Status: TaskWorkStatusToDo, Priority: TaskPriorityMedium, ConstraintType: TaskConstraintASAP}
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(task *Task)
+		wantError error
+	}{
+		{
+			name:      "Valid as soon as possible",
+			modify:    func(task *Task) {},
+			wantError: nil,
+		},
+		{
+			name: "Valid planned dates on the same day",
+			modify: func(task *Task) {
+				task.PlannedStart = &day
+				task.PlannedFinish = &day
+			},
+			wantError: nil,
+		},
+		{
+			name: "Planned finish before planned start",
+			modify: func(task *Task) {
+				task.PlannedStart = &nextDay
+				task.PlannedFinish = &day
+			},
+			wantError: ErrTaskInvalidPlannedDates,
+		},
+		{
+			name:      "Negative duration",
+			modify:    func(task *Task) { task.Duration = -1 },
+			wantError: ErrTaskInvalidDuration,
+		},
+		{
+			name:      "Negative story points",
+			modify:    func(task *Task) { task.StoryPoints = -3 },
+			wantError: ErrTaskInvalidStoryPoints,
+		},
+		{
+			name:      "Valid T-shirt size",
+			modify:    func(task *Task) { task.Size = SizeUnitXL },
+			wantError: nil,
+		},
+		{
+			name:      "Function points are not a T-shirt size",
+			modify:    func(task *Task) { task.Size = SizeUnitFunctionPoint },
+			wantError: ErrTaskInvalidSize,
+		},
+		{
+			name:      "Negative function points",
+			modify:    func(task *Task) { task.FunctionPoints = -1 },
+			wantError: ErrTaskInvalidFunctionPoints,
+		},
+		{
+			name: "T-shirt size and function points",
+			modify: func(task *Task) {
+				task.Size = SizeUnitM
+				task.FunctionPoints = 12
+			},
+			wantError: ErrTaskSizeConflict,
+		},
+		{
+			name:      "Percent complete above 100",
+			modify:    func(task *Task) { task.PercentComplete = 101 },
+			wantError: ErrTaskInvalidPercentComplete,
+		},
+		{
+			name:      "Negative leveling delay",
+			modify:    func(task *Task) { task.LevelingDelay = -4 },
+			wantError: ErrTaskInvalidLevelingDelay,
+		},
+		{
+			name: "Valid three-point estimate",
+			modify: func(task *Task) {
+				task.OptimisticEffort, task.MostLikelyEffort, task.PessimisticEffort = 4, 8, 16
+			},
+			wantError: nil,
+		},
+		{
+			name: "Most likely above pessimistic",
+			modify: func(task *Task) {
+				task.OptimisticEffort, task.MostLikelyEffort, task.PessimisticEffort = 4, 20, 16
+			},
+			wantError: ErrTaskInvalidEstimate,
+		},
+		{
+			name:      "Optimistic without pessimistic",
+			modify:    func(task *Task) { task.OptimisticEffort = 4 },
+			wantError: ErrTaskInvalidEstimate,
+		},
+		{
+			name:      "Unknown distribution",
+			modify:    func(task *Task) { task.EffortDistribution = 5 },
+			wantError: ErrTaskInvalidDistribution,
+		},
+		{
+			name:      "Unknown constraint",
+			modify:    func(task *Task) { task.ConstraintType = 9 },
+			wantError: ErrTaskInvalidConstraint,
+		},
+		{
+			name: "Must start on with a date",
+			modify: func(task *Task) {
+				task.ConstraintType = TaskConstraintMustStartOn
+				task.ConstraintDate = &day
+			},
+			wantError: nil,
+		},
+		{
+			name:      "Start no earlier than without a date",
+			modify:    func(task *Task) { task.ConstraintType = TaskConstraintStartNoEarlierThan },
+			wantError: ErrTaskConstraintDateRequired,
+		},
+		{
+			name:      "Finish no later than without a date",
+			modify:    func(task *Task) { task.ConstraintType = TaskConstraintFinishNoLaterThan },
+			wantError: ErrTaskConstraintDateRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := valid()
+			tt.modify(&task)
+			err := task.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskValidateClearsUndatedConstraintDate(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	task := Task{Name: "Design", ProjectID: 1, Level: 1, Status: TaskWorkStatusToDo, Priority: TaskPriorityMedium, ConstraintType: TaskConstraintALAP, ConstraintDate: &day}
+	assert.NoError(t, task.Validate())
+	assert.Nil(t, task.ConstraintDate)
+	assert.False(t, task.HasDatedConstraint())
+
+	task.ConstraintType = TaskConstraintFinishNoLaterThan
+	assert.True(t, task.HasDatedConstraint())
+}
+
+func TestTaskProgress(t *testing.T) {
+	task := Task{Status: TaskWorkStatusInProgress, PercentComplete: 40}
+	assert.Equal(t, 40.0, task.Progress())
+
+	task.Status = TaskWorkStatusDone
+	assert.Equal(t, 100.0, task.Progress())
+}
+
+func TestTaskEffortEstimate(t *testing.T) {
+	task := Task{EstimatedEffort: 12}
+	assert.Equal(t, NewEffortEstimate(12, 0), task.EffortEstimate())
+
+	task.OptimisticEffort, task.MostLikelyEffort, task.PessimisticEffort = 6, 12, 30
+	task.EffortDistribution = TaskEffortDistributionPERT
+	estimate := task.EffortEstimate()
+	assert.Equal(t, 14.0, estimate.Expected)
+	assert.Equal(t, 4.0, estimate.StdDev)
+
+	task.EffortDistribution = TaskEffortDistributionTriangular
+	estimate = task.EffortEstimate()
+	assert.Equal(t, 16.0, estimate.Expected)
+	assert.InDelta(t, 26.0, estimate.Variance, 1e-9)
+}
+
+func TestTaskSampleEffort(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 0))
+	task := Task{EstimatedEffort: 12}
+	assert.Equal(t, 12.0, task.SampleEffort(rng))
+
+	task.OptimisticEffort, task.MostLikelyEffort, task.PessimisticEffort = 6, 12, 30
+	for _, distribution := range []uint{TaskEffortDistributionPERT, TaskEffortDistributionTriangular} {
+		task.EffortDistribution = distribution
+		const samples = 20000
+		sum := 0.0
+		for i := 0; i < samples; i++ {
+			effort := task.SampleEffort(rng)
+			assert.GreaterOrEqual(t, effort, 6.0)
+			assert.LessOrEqual(t, effort, 30.0)
+			sum += effort
+		}
+		assert.InDelta(t, task.EffortEstimate().Expected, sum/samples, 0.2)
+	}
+}
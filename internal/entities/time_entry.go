@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTimeEntryInvalidTaskID          = errors.New("time entry must belong to a task")
+	ErrTimeEntryInvalidHumanResourceID = errors.New("time entry must have a valid human resource ID")
+	ErrTimeEntryDateRequired           = errors.New("time entry date is required")
+	ErrTimeEntryInvalidHours           = errors.New("time entry hours must be greater than 0 and at most 24")
+	ErrTimeEntryProjectRequired        = errors.New("time summary requires a project ID")
+
+	TimeEntryAllowedSortField = map[string]string{
+		"id":                "id",
+		"task_id":           "task_id",
+		"human_resource_id": "human_resource_id",
+		"date":              "date",
+		"hours":             "hours",
+		"billable":          "billable",
+		"created_at":        "created_at",
+		"updated_at":        "updated_at",
+	}
+)
+
+// TimeEntry records the hours a human resource actually worked on a task on one day
+type TimeEntry struct {
+	ID              uint       `gorm:"primary_key" json:"id"`
+	TaskID          uint       `gorm:"not null;index" json:"task_id"`
+	HumanResourceID uint       `gorm:"not null;index" json:"human_resource_id"`
+	Date            *time.Time `gorm:"not null;index" json:"date"` // Calendar day in the project's time zone
+	Hours           float64    `gorm:"not null" json:"hours"`
+	Billable        bool       `gorm:"not null;default:false" json:"billable"`
+	Notes           string     `gorm:"type:text" json:"notes"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime:milli" json:"updated_at"`
+
+	// Relationships
+	Task          *Task          `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE" json:"task,omitempty"`
+	HumanResource *HumanResource `gorm:"foreignKey:HumanResourceID" json:"human_resource,omitempty"`
+}
+
+// TableName returns the table name for the time entry entity
+func (TimeEntry) TableName() string {
+	return "time_entries"
+}
+
+// Validate validates the time entry fields
+func (e *TimeEntry) Validate() error {
+	// Trim whitespace from string fields
+	e.Notes = strings.TrimSpace(e.Notes)
+
+	// Validate required fields
+	if e.TaskID == 0 {
+		return ErrTimeEntryInvalidTaskID
+	}
+
+	if e.HumanResourceID == 0 {
+		return ErrTimeEntryInvalidHumanResourceID
+	}
+
+	if e.Date == nil {
+		return ErrTimeEntryDateRequired
+	}
+
+	// Validate hours
+	if e.Hours <= 0 || e.Hours > 24 {
+		return ErrTimeEntryInvalidHours
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a time entry
+func (e *TimeEntry) BeforeCreate(tx *gorm.DB) error {
+	return e.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a time entry
+func (e *TimeEntry) BeforeUpdate(tx *gorm.DB) error {
+	return e.Validate()
+}
+
+// TimeEntryQueryParams defines query parameters for filtering time entries
+type TimeEntryQueryParams struct {
+	ID_In              []uint     `json:"id_in"`
+	TaskID             uint       `json:"task_id"`
+	TaskID_In          []uint     `json:"task_id_in"`
+	ProjectID          uint       `json:"project_id"` // Project of the task worked on
+	ProjectID_In       []uint     `json:"project_id_in"`
+	HumanResourceID    uint       `json:"human_resource_id"`
+	HumanResourceID_In []uint     `json:"human_resource_id_in"`
+	Billable           *bool      `json:"billable"`
+	Date_Gte           *time.Time `json:"date_gte"`
+	Date_Lte           *time.Time `json:"date_lte"`
+	Hours_Gte          *float64   `json:"hours_gte"`
+	Hours_Lte          *float64   `json:"hours_lte"`
+	CreatedAt_Gte      *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte      *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte      *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte      *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// TimeEntryListResponse represents the response for GetTimeEntries
+type TimeEntryListResponse struct {
+	Data  []*TimeEntry `json:"data"`
+	Total int64        `json:"total"`
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntryTableName(t *testing.T) {
+	entry := TimeEntry{}
+	assert.Equal(t, "time_entries", entry.TableName())
+}
+
+func TestTimeEntryValidate(t *testing.T) {
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		entry     TimeEntry
+		wantError error
+	}{
+		{
+			name:      "Valid entry",
+			entry:     TimeEntry{TaskID: 1, HumanResourceID: 1, Date: &date, Hours: 7.5, Billable: true},
+			wantError: nil,
+		},
+		{
+			name:      "Missing task",
+			entry:     TimeEntry{HumanResourceID: 1, Date: &date, Hours: 8},
+			wantError: ErrTimeEntryInvalidTaskID,
+		},
+		{
+			name:      "Missing human resource",
+			entry:     TimeEntry{TaskID: 1, Date: &date, Hours: 8},
+			wantError: ErrTimeEntryInvalidHumanResourceID,
+		},
+		{
+			name:      "Missing date",
+			entry:     TimeEntry{TaskID: 1, HumanResourceID: 1, Hours: 8},
+			wantError: ErrTimeEntryDateRequired,
+		},
+		{
+			name:      "Zero hours",
+			entry:     TimeEntry{TaskID: 1, HumanResourceID: 1, Date: &date},
+			wantError: ErrTimeEntryInvalidHours,
+		},
+		{
+			name:      "More than a day",
+			entry:     TimeEntry{TaskID: 1, HumanResourceID: 1, Date: &date, Hours: 25},
+			wantError: ErrTimeEntryInvalidHours,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.entry.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// WeekTime is the time logged in one week, which starts on Monday in the project's time zone
+type WeekTime struct {
+	WeekStart     time.Time `json:"week_start"`
+	Hours         float64   `json:"hours"`
+	BillableHours float64   `json:"billable_hours"`
+}
+
+// PersonTime is the time one human resource logged
+type PersonTime struct {
+	HumanResourceID uint    `json:"human_resource_id"`
+	Name            string  `json:"name"`
+	Hours           float64 `json:"hours"`
+	BillableHours   float64 `json:"billable_hours"`
+}
+
+// TaskTime is the time logged on one task
+type TaskTime struct {
+	TaskID        uint    `json:"task_id"`
+	Name          string  `json:"name"`
+	Hours         float64 `json:"hours"`
+	BillableHours float64 `json:"billable_hours"`
+}
+
+// TimeSummary totals the time entries of a project by week, person and task
+type TimeSummary struct {
+	ProjectID     uint          `json:"project_id"`
+	Hours         float64       `json:"hours"`
+	BillableHours float64       `json:"billable_hours"`
+	ByWeek        []*WeekTime   `json:"by_week"`   // Ordered by week
+	ByPerson      []*PersonTime `json:"by_person"` // Ordered by human resource ID
+	ByTask        []*TaskTime   `json:"by_task"`   // Ordered by task ID
+}
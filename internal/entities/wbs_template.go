@@ -0,0 +1,261 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrWBSTemplateNameRequired      = errors.New("WBS template name is required")
+	ErrWBSTemplateTasksRequired     = errors.New("WBS template must have at least one task")
+	ErrWBSTemplateTaskNameRequired  = errors.New("WBS template task name is required")
+	ErrWBSTemplateInvalidEffort     = errors.New("WBS template effort must be non-negative")
+	ErrWBSTemplateSummaryEffort     = errors.New("WBS template tasks with subtasks take their effort and role demand from the subtasks")
+	ErrWBSTemplateRoleNameRequired  = errors.New("WBS template role demand must name a role")
+	ErrWBSTemplateInvalidScale      = errors.New("WBS template scale and total effort must be non-negative")
+	ErrWBSTemplateInvalidID         = errors.New("WBS template ID is required")
+	ErrWBSTemplateInvalidProjectID  = errors.New("WBS template must be instantiated in a project")
+	ErrWBSTemplateMilestoneMismatch = errors.New("milestone belongs to a different project")
+
+	WBSTemplateAllowedSortField = map[string]string{
+		"id":         "id",
+		"name":       "name",
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+	}
+)
+
+// WBSTemplateRole is the effort a template task needs from a project role, matched by role name and level
+// when the template is instantiated
+type WBSTemplateRole struct {
+	Role   string  `json:"role"`
+	Level  uint    `json:"level"`  // Role level; 0 matches the role at any level
+	Effort float64 `json:"effort"` // Relative working hours, scaled like the task effort
+}
+
+// WBSTemplateTask is one task of a template's hierarchy
+type WBSTemplateTask struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Effort      float64            `json:"effort"`    // Relative working hours of a work package; scaled when instantiated
+	Priority    uint               `json:"priority"`  // Default priority; 0 means medium
+	Milestone   string             `json:"milestone"` // Name of the milestone the task and its subtasks are grouped under; empty inherits it
+	Roles       []*WBSTemplateRole `json:"roles"`
+	Children    []*WBSTemplateTask `json:"children"`
+}
+
+// validate validates the template task and its subtasks
+func (t *WBSTemplateTask) validate() error {
+	t.Name = strings.TrimSpace(t.Name)
+	t.Description = strings.TrimSpace(t.Description)
+	t.Milestone = strings.TrimSpace(t.Milestone)
+
+	if t.Name == "" {
+		return ErrWBSTemplateTaskNameRequired
+	}
+
+	if t.Effort < 0 {
+		return ErrWBSTemplateInvalidEffort
+	}
+
+	if t.Priority != TaskPriorityUnknown {
+		task := Task{Priority: t.Priority}
+		if err := task.validatePriority(); err != nil {
+			return err
+		}
+	}
+
+	if len(t.Children) > 0 && (t.Effort > 0 || len(t.Roles) > 0) {
+		return ErrWBSTemplateSummaryEffort
+	}
+
+	for _, r := range t.Roles {
+		r.Role = strings.TrimSpace(r.Role)
+		if r.Role == "" {
+			return ErrWBSTemplateRoleNameRequired
+		}
+		if r.Effort < 0 {
+			return ErrWBSTemplateInvalidEffort
+		}
+	}
+
+	for _, c := range t.Children {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// totalEffort returns the effort of the work packages under the template task
+func (t *WBSTemplateTask) totalEffort() float64 {
+	total := t.Effort
+	for _, c := range t.Children {
+		total += c.totalEffort()
+	}
+	return total
+}
+
+// WBSTemplateTasks is a custom type for storing a template's task hierarchy as JSON in SQLite
+type WBSTemplateTasks []*WBSTemplateTask
+
+// Value implements the driver.Valuer interface for database storage
+func (t WBSTemplateTasks) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]*WBSTemplateTask(t))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface for database retrieval
+func (t *WBSTemplateTasks) Scan(value interface{}) error {
+	if value == nil {
+		*t = WBSTemplateTasks{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into WBSTemplateTasks", value)
+	}
+
+	if len(bytes) == 0 {
+		*t = WBSTemplateTasks{}
+		return nil
+	}
+
+	var tasks []*WBSTemplateTask
+	if err := json.Unmarshal(bytes, &tasks); err != nil {
+		return err
+	}
+	*t = tasks
+	return nil
+}
+
+// WBSTemplate is a reusable task hierarchy, such as an auth module or a mobile release,
+// that can be instantiated in any project
+type WBSTemplate struct {
+	ID          uint             `gorm:"primary_key" json:"id"`
+	Name        string           `gorm:"not null;uniqueIndex" json:"name"`
+	Description string           `gorm:"type:text" json:"description"`
+	Tasks       WBSTemplateTasks `gorm:"type:text" json:"tasks"` // Top-level tasks with their subtasks
+	CreatedAt   time.Time        `gorm:"autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time        `gorm:"autoUpdateTime:milli" json:"updated_at"`
+}
+
+// TableName returns the table name for the WBS template entity
+func (WBSTemplate) TableName() string {
+	return "wbs_templates"
+}
+
+// TotalEffort returns the effort of all work packages of the template
+func (t *WBSTemplate) TotalEffort() float64 {
+	total := 0.0
+	for _, task := range t.Tasks {
+		total += task.totalEffort()
+	}
+	return total
+}
+
+// Validate validates the WBS template fields
+func (t *WBSTemplate) Validate() error {
+	// Trim whitespace from string fields
+	t.Name = strings.TrimSpace(t.Name)
+	t.Description = strings.TrimSpace(t.Description)
+
+	// Validate required fields
+	if t.Name == "" {
+		return ErrWBSTemplateNameRequired
+	}
+
+	if len(t.Tasks) == 0 {
+		return ErrWBSTemplateTasksRequired
+	}
+
+	for _, task := range t.Tasks {
+		if err := task.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeforeCreate is a GORM hook that runs before creating a WBS template
+func (t *WBSTemplate) BeforeCreate(tx *gorm.DB) error {
+	return t.Validate()
+}
+
+// BeforeUpdate is a GORM hook that runs before updating a WBS template
+func (t *WBSTemplate) BeforeUpdate(tx *gorm.DB) error {
+	return t.Validate()
+}
+
+// WBSTemplateQueryParams defines query parameters for filtering WBS templates
+type WBSTemplateQueryParams struct {
+	ID_In            []uint     `json:"id_in"`
+	Name             string     `json:"name"`
+	Name_Like        string     `json:"name_like"`
+	Description_Like string     `json:"description_like"`
+	CreatedAt_Gte    *time.Time `json:"created_at_gte"`
+	CreatedAt_Lte    *time.Time `json:"created_at_lte"`
+	UpdatedAt_Gte    *time.Time `json:"updated_at_gte"`
+	UpdatedAt_Lte    *time.Time `json:"updated_at_lte"`
+	*QueryParams
+}
+
+// WBSTemplateListResponse represents the response for GetWBSTemplates
+type WBSTemplateListResponse struct {
+	Data  []*WBSTemplate `json:"data"`
+	Total int64          `json:"total"`
+}
+
+// WBSTemplateInstantiation tells where and at what size a template is instantiated
+type WBSTemplateInstantiation struct {
+	TemplateID  uint    `json:"template_id"`
+	ProjectID   uint    `json:"project_id"`
+	MilestoneID *uint   `json:"milestone_id"` // Milestone of the tasks the template does not group
+	ParentID    *uint   `json:"parent_id"`    // Task the template's top-level tasks are created under
+	Scale       float64 `json:"scale"`        // Multiplier of the template efforts; 0 means 1
+	TotalEffort float64 `json:"total_effort"` // Hours the work packages add up to; overrides Scale when set
+}
+
+// Validate validates the instantiation options
+func (o *WBSTemplateInstantiation) Validate() error {
+	if o.TemplateID == 0 {
+		return ErrWBSTemplateInvalidID
+	}
+	if o.ProjectID == 0 {
+		return ErrWBSTemplateInvalidProjectID
+	}
+	if o.Scale < 0 || o.TotalEffort < 0 {
+		return ErrWBSTemplateInvalidScale
+	}
+	return nil
+}
+
+// WBSTemplateInstance holds the rows an instantiated template creates. Until they are created,
+// tasks point to their parent and to new milestones, and role estimates to their task.
+type WBSTemplateInstance struct {
+	TemplateID     uint                `json:"template_id"`
+	ProjectID      uint                `json:"project_id"`
+	Milestones     []*Milestone        `json:"milestones"`      // Milestones created for the template's groups
+	Tasks          []*Task             `json:"tasks"`           // Parents before their subtasks
+	RoleEstimates  []*TaskRoleEstimate `json:"role_estimates"`  // Role demand matched to the project's roles
+	UnmatchedRoles []string            `json:"unmatched_roles"` // Role demand without a matching project role, left out
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWBSTemplateTableName(t *testing.T) {
+	template := WBSTemplate{}
+	assert.Equal(t, "wbs_templates", template.TableName())
+}
+
+func TestWBSTemplateValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  WBSTemplate
+		wantError error
+	}{
+		{
+			name: "Valid template",
+			template: WBSTemplate{Name: "Auth module", Tasks: WBSTemplateTasks{
+				{Name: "Auth", Children: []*WBSTemplateTask{
+					{Name: "Login", Effort: 16, Priority: TaskPriorityHigh, Roles: []*WBSTemplateRole{{Role: "Backend", Level: RoleLevelSenior, Effort: 16}}},
+					{Name: "Password reset", Effort: 8},
+				}},
+			}},
+			wantError: nil,
+		},
+		{
+			name:      "Missing name",
+			template:  WBSTemplate{Name: "  ", Tasks: WBSTemplateTasks{{Name: "Login"}}},
+			wantError: ErrWBSTemplateNameRequired,
+		},
+		{
+			name:      "No tasks",
+			template:  WBSTemplate{Name: "Empty"},
+			wantError: ErrWBSTemplateTasksRequired,
+		},
+		{
+			name:      "Unnamed subtask",
+			template:  WBSTemplate{Name: "Auth", Tasks: WBSTemplateTasks{{Name: "Auth", Children: []*WBSTemplateTask{{Effort: 8}}}}},
+			wantError: ErrWBSTemplateTaskNameRequired,
+		},
+		{
+			name:      "Negative effort",
+			template:  WBSTemplate{Name: "Auth", Tasks: WBSTemplateTasks{{Name: "Login", Effort: -1}}},
+			wantError: ErrWBSTemplateInvalidEffort,
+		},
+		{
+			name:      "Invalid priority",
+			template:  WBSTemplate{Name: "Auth", Tasks: WBSTemplateTasks{{Name: "Login", Priority: 9}}},
+			wantError: ErrTaskInvalidPriority,
+		},
+		{
+			name:      "Effort on a summary task",
+			template:  WBSTemplate{Name: "Auth", Tasks: WBSTemplateTasks{{Name: "Auth", Effort: 8, Children: []*WBSTemplateTask{{Name: "Login"}}}}},
+			wantError: ErrWBSTemplateSummaryEffort,
+		},
+		{
+			name:      "Unnamed role",
+			template:  WBSTemplate{Name: "Auth", Tasks: WBSTemplateTasks{{Name: "Login", Roles: []*WBSTemplateRole{{Effort: 8}}}}},
+			wantError: ErrWBSTemplateRoleNameRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWBSTemplateTasksValueScan(t *testing.T) {
+	tasks := WBSTemplateTasks{{Name: "Auth", Milestone: "Beta", Children: []*WBSTemplateTask{{Name: "Login", Effort: 16}}}}
+	value, err := tasks.Value()
+	assert.NoError(t, err)
+
+	var scanned WBSTemplateTasks
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, tasks, scanned)
+
+	template := WBSTemplate{Tasks: scanned}
+	assert.Equal(t, 16.0, template.TotalEffort())
+}
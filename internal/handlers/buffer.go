@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// BufferHandler handles buffer operations for Wails bindings
+type BufferHandler struct {
+	ctx     context.Context
+	service *services.BufferService
+}
+
+// NewBufferHandler creates a new BufferHandler
+func NewBufferHandler(ctx context.Context, service *services.BufferService) *BufferHandler {
+	return &BufferHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetBuffers retrieves multiple buffers with optional query parameters
+func (h *BufferHandler) GetBuffers(params *entities.BufferQueryParams) (*entities.BufferListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("buffer service not initialized")
+	}
+	return h.service.GetBuffers(h.ctx, params)
+}
+
+// GetBuffer retrieves a single buffer by ID
+func (h *BufferHandler) GetBuffer(id uint) (*entities.Buffer, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("buffer service not initialized")
+	}
+	return h.service.GetBuffer(h.ctx, id)
+}
+
+// CreateBuffer adds a contingency or management reserve to a project or milestone
+func (h *BufferHandler) CreateBuffer(buffer *entities.Buffer) (*entities.Buffer, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("buffer service not initialized")
+	}
+	return h.service.CreateBuffer(h.ctx, buffer)
+}
+
+// UpdateBuffer updates an existing buffer
+func (h *BufferHandler) UpdateBuffer(buffer *entities.Buffer) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("buffer service not initialized")
+	}
+	return h.service.UpdateBuffer(h.ctx, buffer)
+}
+
+// DeleteBuffer deletes a buffer by ID
+func (h *BufferHandler) DeleteBuffer(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("buffer service not initialized")
+	}
+	return h.service.DeleteBuffer(h.ctx, id)
+}
+
+// GetBufferReport compares the internal and quoted figures of a project and returns its fever chart data
+func (h *BufferHandler) GetBufferReport(projectID uint) (*entities.ProjectBufferReport, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("buffer service not initialized")
+	}
+	return h.service.GetBufferReport(h.ctx, projectID)
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// CalendarHandler handles calendar-related operations for Wails bindings
+type CalendarHandler struct {
+	ctx     context.Context
+	service *services.CalendarService
+	costs   costRecalculator
+}
+
+// NewCalendarHandler creates a new CalendarHandler
+func NewCalendarHandler(ctx context.Context, service *services.CalendarService, laborCostService *services.LaborCostService) *CalendarHandler {
+	return &CalendarHandler{
+		ctx:     ctx,
+		service: service,
+		costs:   costRecalculator{ctx: ctx, service: laborCostService},
+	}
+}
+
+// GetCalendars retrieves multiple calendars with optional query parameters
+func (h *CalendarHandler) GetCalendars(params *entities.CalendarQueryParams) (*entities.CalendarListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetCalendars(h.ctx, params)
+}
+
+// GetCalendar retrieves a single calendar with its exceptions by ID
+func (h *CalendarHandler) GetCalendar(id uint) (*entities.Calendar, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetCalendar(h.ctx, id)
+}
+
+// CreateCalendar creates a new calendar
+func (h *CalendarHandler) CreateCalendar(calendar *entities.Calendar) (*entities.Calendar, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.CreateCalendar(h.ctx, calendar)
+}
+
+// UpdateCalendar updates an existing calendar
+func (h *CalendarHandler) UpdateCalendar(calendar *entities.Calendar) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("calendar service not initialized")
+	}
+	rows, err := h.service.UpdateCalendar(h.ctx, calendar)
+	if err != nil {
+		return 0, err
+	}
+	h.costs.allChanged()
+	return rows, nil
+}
+
+// DeleteCalendar deletes a calendar by ID
+func (h *CalendarHandler) DeleteCalendar(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("calendar service not initialized")
+	}
+	if err := h.service.DeleteCalendar(h.ctx, id); err != nil {
+		return err
+	}
+	h.costs.allChanged()
+	return nil
+}
+
+// GetCalendarExceptions retrieves multiple calendar exceptions with optional query parameters
+func (h *CalendarHandler) GetCalendarExceptions(params *entities.CalendarExceptionQueryParams) (*entities.CalendarExceptionListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetCalendarExceptions(h.ctx, params)
+}
+
+// GetCalendarException retrieves a single calendar exception by ID
+func (h *CalendarHandler) GetCalendarException(id uint) (*entities.CalendarException, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetCalendarException(h.ctx, id)
+}
+
+// GetCalendarExceptionsByCalendar retrieves all exceptions of a specific calendar
+func (h *CalendarHandler) GetCalendarExceptionsByCalendar(calendarID uint) (*entities.CalendarExceptionListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetCalendarExceptionsByCalendar(h.ctx, calendarID)
+}
+
+// CreateCalendarException creates a new calendar exception
+func (h *CalendarHandler) CreateCalendarException(exception *entities.CalendarException) (*entities.CalendarException, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	created, err := h.service.CreateCalendarException(h.ctx, exception)
+	if err != nil {
+		return nil, err
+	}
+	h.costs.allChanged()
+	return created, nil
+}
+
+// UpdateCalendarException updates an existing calendar exception
+func (h *CalendarHandler) UpdateCalendarException(exception *entities.CalendarException) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("calendar service not initialized")
+	}
+	rows, err := h.service.UpdateCalendarException(h.ctx, exception)
+	if err != nil {
+		return 0, err
+	}
+	h.costs.allChanged()
+	return rows, nil
+}
+
+// DeleteCalendarException deletes a calendar exception by ID
+func (h *CalendarHandler) DeleteCalendarException(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("calendar service not initialized")
+	}
+	if err := h.service.DeleteCalendarException(h.ctx, id); err != nil {
+		return err
+	}
+	h.costs.allChanged()
+	return nil
+}
+
+// GetProjectCalendar returns the calendar a project works on
+func (h *CalendarHandler) GetProjectCalendar(projectID uint) (*entities.Calendar, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetProjectCalendar(h.ctx, projectID)
+}
+
+// AddWorkingHours returns the instant reached after working the given hours from `from` on a calendar
+func (h *CalendarHandler) AddWorkingHours(calendarID uint, from time.Time, hours float64) (time.Time, error) {
+	if h.service == nil {
+		return time.Time{}, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.AddWorkingHours(h.ctx, calendarID, from, hours)
+}
+
+// GetWorkingHoursBetween returns the working hours between two instants on a calendar
+func (h *CalendarHandler) GetWorkingHoursBetween(calendarID uint, from, to time.Time) (float64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetWorkingHoursBetween(h.ctx, calendarID, from, to)
+}
+
+// AddProjectWorkingHours returns the instant reached after working the given hours from `from` on a project's calendar
+func (h *CalendarHandler) AddProjectWorkingHours(projectID uint, from time.Time, hours float64) (time.Time, error) {
+	if h.service == nil {
+		return time.Time{}, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.AddProjectWorkingHours(h.ctx, projectID, from, hours)
+}
+
+// GetProjectWorkingHoursBetween returns the working hours between two instants on a project's calendar
+func (h *CalendarHandler) GetProjectWorkingHoursBetween(projectID uint, from, to time.Time) (float64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("calendar service not initialized")
+	}
+	return h.service.GetProjectWorkingHoursBetween(h.ctx, projectID, from, to)
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// CalibrationHandler handles estimate calibration for Wails bindings
+type CalibrationHandler struct {
+	ctx     context.Context
+	service *services.CalibrationService
+}
+
+// NewCalibrationHandler creates a new CalibrationHandler
+func NewCalibrationHandler(ctx context.Context, service *services.CalibrationService) *CalibrationHandler {
+	return &CalibrationHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetEstimateCalibration returns the accuracy of past estimates overall and per person, level, project type and client
+func (h *CalibrationHandler) GetEstimateCalibration() (*entities.EstimateCalibration, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calibration service not initialized")
+	}
+	return h.service.GetEstimateCalibration(h.ctx)
+}
+
+// SuggestEstimate suggests a calibrated estimate for a task being estimated
+func (h *CalibrationHandler) SuggestEstimate(request *entities.EstimateSuggestionRequest) (*entities.CalibratedEstimate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calibration service not initialized")
+	}
+	return h.service.SuggestEstimate(h.ctx, request)
+}
+
+// ApplyCalibration stores calibrated estimates of a project's open tasks in a new draft scenario
+func (h *CalibrationHandler) ApplyCalibration(projectID uint, name string) (*entities.CalibrationResult, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("calibration service not initialized")
+	}
+	return h.service.ApplyCalibration(h.ctx, projectID, name)
+}
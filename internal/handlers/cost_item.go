@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// CostItemHandler handles cost item operations for Wails bindings
+type CostItemHandler struct {
+	ctx     context.Context
+	service *services.CostItemService
+}
+
+// NewCostItemHandler creates a new CostItemHandler
+func NewCostItemHandler(ctx context.Context, service *services.CostItemService) *CostItemHandler {
+	return &CostItemHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetCostItems retrieves multiple cost items with optional query parameters
+func (h *CostItemHandler) GetCostItems(params *entities.CostItemQueryParams) (*entities.CostItemListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("cost item service not initialized")
+	}
+	return h.service.GetCostItems(h.ctx, params)
+}
+
+// GetCostItem retrieves a single cost item by ID
+func (h *CostItemHandler) GetCostItem(id uint) (*entities.CostItem, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("cost item service not initialized")
+	}
+	return h.service.GetCostItem(h.ctx, id)
+}
+
+// CreateCostItem adds a one-off or monthly cost, such as hosting or licences, to a project
+func (h *CostItemHandler) CreateCostItem(item *entities.CostItem) (*entities.CostItem, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("cost item service not initialized")
+	}
+	return h.service.CreateCostItem(h.ctx, item)
+}
+
+// UpdateCostItem updates an existing cost item
+func (h *CostItemHandler) UpdateCostItem(item *entities.CostItem) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("cost item service not initialized")
+	}
+	return h.service.UpdateCostItem(h.ctx, item)
+}
+
+// DeleteCostItem deletes a cost item by ID
+func (h *CostItemHandler) DeleteCostItem(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("cost item service not initialized")
+	}
+	return h.service.DeleteCostItem(h.ctx, id)
+}
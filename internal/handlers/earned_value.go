@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// EarnedValueHandler handles baseline and earned value operations for Wails bindings
+type EarnedValueHandler struct {
+	ctx     context.Context
+	service *services.EarnedValueService
+}
+
+// NewEarnedValueHandler creates a new EarnedValueHandler
+func NewEarnedValueHandler(ctx context.Context, service *services.EarnedValueService) *EarnedValueHandler {
+	return &EarnedValueHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// SetProjectBaseline saves the current plan of a project's tasks as its baseline and returns the number of tasks baselined
+func (h *EarnedValueHandler) SetProjectBaseline(projectID uint) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("earned value service not initialized")
+	}
+	return h.service.SetBaseline(h.ctx, projectID)
+}
+
+// GetEarnedValue retrieves the PV, EV, AC, variances, CPI, SPI and estimates of a project and its
+// milestones on a status date; a zero status date is today
+func (h *EarnedValueHandler) GetEarnedValue(projectID uint, statusDate time.Time) (*entities.ProjectEarnedValue, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("earned value service not initialized")
+	}
+	return h.service.GetEarnedValue(h.ctx, projectID, statusDate)
+}
+
+// GetEarnedValueSeries retrieves the monthly PV, EV and AC of a project for charts
+func (h *EarnedValueHandler) GetEarnedValueSeries(projectID uint, statusDate time.Time) (*entities.EarnedValueSeries, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("earned value service not initialized")
+	}
+	return h.service.GetEarnedValueSeries(h.ctx, projectID, statusDate)
+}
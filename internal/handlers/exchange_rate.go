@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// ExchangeRateHandler handles exchange rate operations for Wails bindings
+type ExchangeRateHandler struct {
+	ctx     context.Context
+	service *services.ExchangeRateService
+}
+
+// NewExchangeRateHandler creates a new ExchangeRateHandler
+func NewExchangeRateHandler(ctx context.Context, service *services.ExchangeRateService) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetExchangeRates retrieves multiple exchange rates with optional query parameters
+func (h *ExchangeRateHandler) GetExchangeRates(params *entities.ExchangeRateQueryParams) (*entities.ExchangeRateListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.GetExchangeRates(h.ctx, params)
+}
+
+// GetExchangeRate retrieves a single exchange rate by ID
+func (h *ExchangeRateHandler) GetExchangeRate(id uint) (*entities.ExchangeRate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.GetExchangeRate(h.ctx, id)
+}
+
+// CreateExchangeRate records what one unit of a currency is worth in another from a date
+func (h *ExchangeRateHandler) CreateExchangeRate(rate *entities.ExchangeRate) (*entities.ExchangeRate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.CreateExchangeRate(h.ctx, rate)
+}
+
+// UpdateExchangeRate updates an existing exchange rate
+func (h *ExchangeRateHandler) UpdateExchangeRate(rate *entities.ExchangeRate) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.UpdateExchangeRate(h.ctx, rate)
+}
+
+// DeleteExchangeRate deletes an exchange rate by ID
+func (h *ExchangeRateHandler) DeleteExchangeRate(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.DeleteExchangeRate(h.ctx, id)
+}
+
+// ConvertAmount converts an amount between currencies at the rate in effect on a date; a zero date is today
+func (h *ExchangeRateHandler) ConvertAmount(amount entities.Money, from, to string, date time.Time) (*entities.CurrencyConversion, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.ConvertAmount(h.ctx, amount, from, to, date)
+}
+
+// GetPortfolioCost retrieves the budget and cost of several projects, such as client projects in USD,
+// EUR and VND, converted into one reporting currency at the exchange rates of a date
+func (h *ExchangeRateHandler) GetPortfolioCost(params *entities.PortfolioCostParams) (*entities.PortfolioCost, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("exchange rate service not initialized")
+	}
+	return h.service.GetPortfolioCost(h.ctx, params)
+}
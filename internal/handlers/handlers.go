@@ -9,17 +9,55 @@ type Handlers struct {
 	*ProjectRoleHandler
 	*MilestoneHandler
 	*TaskHandler
+	*TaskDependencyHandler
+	*TaskAssignmentHandler
+	*SchedulingHandler
+	*CalendarHandler
+	*LevelingHandler
+	*RollupHandler
+	*ScenarioHandler
+	*SimulationHandler
+	*TaskRoleEstimateHandler
+	*TimeEntryHandler
+	*CalibrationHandler
+	*WBSTemplateHandler
+	*BufferHandler
+	*SprintHandler
+	*SizingHandler
+	*RateCardHandler
+	*CostItemHandler
+	*EarnedValueHandler
+	*ExchangeRateHandler
 }
 
 // NewHandlers creates a new Handlers instance with all handler dependencies
-func NewHandlers(clientHandler *ClientHandler, hrHandler *HumanResourceHandler, projectHandler *ProjectHandler, projectResourceHandler *ProjectResourceHandler, projectRoleHandler *ProjectRoleHandler, milestoneHandler *MilestoneHandler, taskHandler *TaskHandler) *Handlers {
+func NewHandlers(clientHandler *ClientHandler, hrHandler *HumanResourceHandler, projectHandler *ProjectHandler, projectResourceHandler *ProjectResourceHandler, projectRoleHandler *ProjectRoleHandler, milestoneHandler *MilestoneHandler, taskHandler *TaskHandler, taskDependencyHandler *TaskDependencyHandler, taskAssignmentHandler *TaskAssignmentHandler, schedulingHandler *SchedulingHandler, calendarHandler *CalendarHandler, levelingHandler *LevelingHandler, rollupHandler *RollupHandler, scenarioHandler *ScenarioHandler, simulationHandler *SimulationHandler, taskRoleEstimateHandler *TaskRoleEstimateHandler, timeEntryHandler *TimeEntryHandler, calibrationHandler *CalibrationHandler, wbsTemplateHandler *WBSTemplateHandler, bufferHandler *BufferHandler, sprintHandler *SprintHandler, sizingHandler *SizingHandler, rateCardHandler *RateCardHandler, costItemHandler *CostItemHandler, earnedValueHandler *EarnedValueHandler, exchangeRateHandler *ExchangeRateHandler) *Handlers {
 	return &Handlers{
-		ClientHandler:          clientHandler,
-		HumanResourceHandler:   hrHandler,
-		ProjectHandler:         projectHandler,
-		ProjectResourceHandler: projectResourceHandler,
-		ProjectRoleHandler:     projectRoleHandler,
-		MilestoneHandler:       milestoneHandler,
-		TaskHandler:            taskHandler,
+		ClientHandler:           clientHandler,
+		HumanResourceHandler:    hrHandler,
+		ProjectHandler:          projectHandler,
+		ProjectResourceHandler:  projectResourceHandler,
+		ProjectRoleHandler:      projectRoleHandler,
+		MilestoneHandler:        milestoneHandler,
+		TaskHandler:             taskHandler,
+		TaskDependencyHandler:   taskDependencyHandler,
+		TaskAssignmentHandler:   taskAssignmentHandler,
+		SchedulingHandler:       schedulingHandler,
+		CalendarHandler:         calendarHandler,
+		LevelingHandler:         levelingHandler,
+		RollupHandler:           rollupHandler,
+		ScenarioHandler:         scenarioHandler,
+		SimulationHandler:       simulationHandler,
+		TaskRoleEstimateHandler: taskRoleEstimateHandler,
+		TimeEntryHandler:        timeEntryHandler,
+		CalibrationHandler:      calibrationHandler,
+		WBSTemplateHandler:      wbsTemplateHandler,
+		BufferHandler:           bufferHandler,
+		SprintHandler:           sprintHandler,
+		SizingHandler:           sizingHandler,
+		RateCardHandler:         rateCardHandler,
+		CostItemHandler:         costItemHandler,
+		EarnedValueHandler:      earnedValueHandler,
+		ExchangeRateHandler:     exchangeRateHandler,
 	}
 }
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// costRecalculator saves the labor cost of the allocations again after a change to the allocations,
+// rates or calendars it is calculated from
+type costRecalculator struct {
+	ctx     context.Context
+	service *services.LaborCostService
+}
+
+// projectChanged recalculates the costs of the allocations of a project. The change is already saved,
+// so a failing calculation is logged rather than returned.
+func (c costRecalculator) projectChanged(projectID uint) {
+	if c.service == nil {
+		return
+	}
+	if err := c.service.RecalculateProjectCosts(c.ctx, projectID); err != nil {
+		internal.Logger.Error("failed to recalculate labor costs", "project_id", projectID, "error", err)
+	}
+}
+
+// allChanged recalculates the costs of the allocations of every project
+func (c costRecalculator) allChanged() {
+	if c.service == nil {
+		return
+	}
+	if err := c.service.RecalculateAllCosts(c.ctx); err != nil {
+		internal.Logger.Error("failed to recalculate labor costs", "error", err)
+	}
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/ducminhgd/plan-craft/internal/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateCardHandler_RecalculatesAllocationCosts(t *testing.T) {
+	db := setupHandlerTestDB(t)
+	ctx := context.Background()
+
+	projectRepo := repositories.NewProjectRepository(db)
+	hrRepo := repositories.NewHRRepository(db)
+	projectResourceRepo := repositories.NewProjectResourceRepository(db)
+	rateCardService := services.NewRateCardService(repositories.NewRateCardRepository(db), projectRepo, hrRepo, projectResourceRepo, repositories.NewProjectRoleRepository(db))
+	laborCostService := services.NewLaborCostService(projectRepo, projectResourceRepo, hrRepo, repositories.NewCalendarRepository(db), repositories.NewTaskRepository(db), repositories.NewMilestoneRepository(db), repositories.NewTaskAssignmentRepository(db), rateCardService)
+	handler := NewRateCardHandler(ctx, rateCardService, laborCostService)
+
+	// A week of full-time work
+	start, end := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+	client := &entities.Client{Name: "Acme", Email: "acme@example.com", Status: entities.ClientStatusActive}
+	assert.NoError(t, db.Create(client).Error)
+	project := &entities.Project{Name: "Website", ClientID: client.ID, StartDate: &start, EndDate: &end, Status: entities.ProjectStatusActive}
+	assert.NoError(t, db.Create(project).Error)
+	hr := &entities.HumanResource{Name: "Alice", Title: "Engineer", Level: "Senior", Status: entities.HumanResourceStatusActive}
+	assert.NoError(t, db.Create(hr).Error)
+	allocation := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+	assert.NoError(t, db.Create(allocation).Error)
+
+	cost := func() entities.Money {
+		pr, err := projectResourceRepo.GetOne(ctx, allocation.ID)
+		assert.NoError(t, err)
+		return pr.Cost
+	}
+
+	rateCard, err := handler.CreateRateCard(&entities.RateCard{ProjectID: &project.ID, HumanResourceID: &hr.ID, CostRate: entities.NewMoney(50), EffectiveFrom: start})
+	assert.NoError(t, err)
+	assert.Equal(t, entities.NewMoney(2000), cost())
+
+	rateCard.CostRate = entities.NewMoney(60)
+	_, err = handler.UpdateRateCard(rateCard)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.NewMoney(2400), cost())
+
+	assert.NoError(t, handler.DeleteRateCard(rateCard.ID))
+	assert.Zero(t, cost())
+}
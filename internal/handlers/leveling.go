@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// LevelingHandler handles resource leveling for Wails bindings
+type LevelingHandler struct {
+	ctx     context.Context
+	service *services.LevelingService
+}
+
+// NewLevelingHandler creates a new LevelingHandler
+func NewLevelingHandler(ctx context.Context, service *services.LevelingService) *LevelingHandler {
+	return &LevelingHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// PreviewResourceLeveling reports the tasks leveling would move without saving anything
+func (h *LevelingHandler) PreviewResourceLeveling() (*entities.LevelingReport, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("leveling service not initialized")
+	}
+	return h.service.PreviewResourceLeveling(h.ctx)
+}
+
+// ApplyResourceLeveling levels all active projects and saves the task delays
+func (h *LevelingHandler) ApplyResourceLeveling() (*entities.LevelingReport, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("leveling service not initialized")
+	}
+	return h.service.ApplyResourceLeveling(h.ctx)
+}
@@ -4,21 +4,74 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ducminhgd/plan-craft/internal"
 	"github.com/ducminhgd/plan-craft/internal/entities"
 	"github.com/ducminhgd/plan-craft/internal/services"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
-// MilestoneHandler handles milestone-related operations for Wails bindings
-type MilestoneHandler struct {
+// MilestoneForecastChangedEvent is emitted with the new forecast when a milestone forecast changes
+const MilestoneForecastChangedEvent = "milestone:forecast-changed"
+
+// emitEvent sends an event to the frontend
+var emitEvent = runtime.EventsEmit
+
+// forecastNotifier recalculates the milestone forecasts after a change to the plan of a project
+// and emits MilestoneForecastChangedEvent for the forecasts that changed
+type forecastNotifier struct {
 	ctx     context.Context
 	service *services.MilestoneService
 }
 
+// projectChanged refreshes the forecasts of a project. The change is already saved,
+// so a failing forecast is logged rather than returned.
+func (n forecastNotifier) projectChanged(projectID uint) {
+	if n.service == nil {
+		return
+	}
+	forecasts, err := n.service.RefreshForecasts(n.ctx, projectID)
+	if err != nil {
+		internal.Logger.Error("failed to refresh milestone forecasts", "project_id", projectID, "error", err)
+		return
+	}
+	n.emit(forecasts...)
+}
+
+// tasksChanged refreshes the forecasts of the projects of the given tasks
+func (n forecastNotifier) tasksChanged(taskIDs ...uint) {
+	if n.service == nil {
+		return
+	}
+	forecasts, err := n.service.RefreshTaskForecasts(n.ctx, taskIDs...)
+	if err != nil {
+		internal.Logger.Error("failed to refresh milestone forecasts", "task_ids", taskIDs, "error", err)
+		return
+	}
+	n.emit(forecasts...)
+}
+
+// emit notifies the frontend of the forecasts that changed
+func (n forecastNotifier) emit(forecasts ...*entities.MilestoneForecast) {
+	for _, f := range forecasts {
+		if f.Changed {
+			emitEvent(n.ctx, MilestoneForecastChangedEvent, f)
+		}
+	}
+}
+
+// MilestoneHandler handles milestone-related operations for Wails bindings
+type MilestoneHandler struct {
+	ctx       context.Context
+	service   *services.MilestoneService
+	forecasts forecastNotifier
+}
+
 // NewMilestoneHandler creates a new MilestoneHandler
 func NewMilestoneHandler(ctx context.Context, service *services.MilestoneService) *MilestoneHandler {
 	return &MilestoneHandler{
-		ctx:     ctx,
-		service: service,
+		ctx:       ctx,
+		service:   service,
+		forecasts: forecastNotifier{ctx: ctx, service: service},
 	}
 }
 
@@ -43,7 +96,12 @@ func (h *MilestoneHandler) CreateMilestone(milestone *entities.Milestone) (*enti
 	if h.service == nil {
 		return nil, fmt.Errorf("milestone service not initialized")
 	}
-	return h.service.CreateMilestone(h.ctx, milestone)
+	created, err := h.service.CreateMilestone(h.ctx, milestone)
+	if err != nil {
+		return nil, err
+	}
+	h.forecasts.projectChanged(created.ProjectID)
+	return created, nil
 }
 
 // UpdateMilestone updates an existing milestone
@@ -51,7 +109,12 @@ func (h *MilestoneHandler) UpdateMilestone(milestone *entities.Milestone) (int64
 	if h.service == nil {
 		return 0, fmt.Errorf("milestone service not initialized")
 	}
-	return h.service.UpdateMilestone(h.ctx, milestone)
+	rows, err := h.service.UpdateMilestone(h.ctx, milestone)
+	if err != nil {
+		return 0, err
+	}
+	h.forecasts.projectChanged(milestone.ProjectID)
+	return rows, nil
 }
 
 // DeleteMilestone deletes a milestone by ID
@@ -61,3 +124,29 @@ func (h *MilestoneHandler) DeleteMilestone(id uint) error {
 	}
 	return h.service.DeleteMilestone(h.ctx, id)
 }
+
+// GetMilestoneForecast forecasts the completion of a milestone
+func (h *MilestoneHandler) GetMilestoneForecast(id uint) (*entities.MilestoneForecast, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("milestone service not initialized")
+	}
+	forecast, err := h.service.GetMilestoneForecast(h.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	h.forecasts.emit(forecast)
+	return forecast, nil
+}
+
+// GetMilestoneForecasts forecasts the completion of the active milestones of a project
+func (h *MilestoneHandler) GetMilestoneForecasts(projectID uint) ([]*entities.MilestoneForecast, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("milestone service not initialized")
+	}
+	forecasts, err := h.service.GetMilestoneForecasts(h.ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	h.forecasts.emit(forecasts...)
+	return forecasts, nil
+}
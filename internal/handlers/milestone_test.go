@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/ducminhgd/plan-craft/internal/services"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupHandlerTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	err = db.AutoMigrate(
+		&entities.Client{},
+		&entities.Calendar{},
+		&entities.CalendarException{},
+		&entities.HumanResource{},
+		&entities.Project{},
+		&entities.ProjectResource{},
+		&entities.Milestone{},
+		&entities.Task{},
+		&entities.TaskDependency{},
+		&entities.TaskAssignment{},
+		&entities.ProjectRole{},
+		&entities.RateCard{},
+	)
+	if err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+// captureEvents records the events emitted to the frontend for the duration of the test
+func captureEvents(t *testing.T) *[]*entities.MilestoneForecast {
+	var forecasts []*entities.MilestoneForecast
+	original := emitEvent
+	emitEvent = func(ctx context.Context, eventName string, optionalData ...interface{}) {
+		if eventName == MilestoneForecastChangedEvent {
+			forecasts = append(forecasts, optionalData[0].(*entities.MilestoneForecast))
+		}
+	}
+	t.Cleanup(func() {
+		emitEvent = original
+	})
+	return &forecasts
+}
+
+func TestTaskHandler_EmitsMilestoneForecastChanges(t *testing.T) {
+	db := setupHandlerTestDB(t)
+	events := captureEvents(t)
+	ctx := context.Background()
+
+	projectRepo := repositories.NewProjectRepository(db)
+	taskRepo := repositories.NewTaskRepository(db)
+	milestoneService := services.NewMilestoneService(
+		repositories.NewMilestoneRepository(db),
+		projectRepo,
+		taskRepo,
+		repositories.NewProjectResourceRepository(db),
+		repositories.NewTaskAssignmentRepository(db),
+		repositories.NewCalendarRepository(db),
+	)
+	taskService := services.NewTaskService(taskRepo, projectRepo, repositories.NewTaskDependencyRepository(db))
+	handler := NewTaskHandler(ctx, taskService, milestoneService)
+
+	client := &entities.Client{Name: "Acme", Email: "acme@example.com", Status: entities.ClientStatusActive}
+	assert.NoError(t, db.Create(client).Error)
+	project := &entities.Project{Name: "Website", ClientID: client.ID, Status: entities.ProjectStatusActive}
+	assert.NoError(t, db.Create(project).Error)
+	hr := &entities.HumanResource{Name: "Alice", Title: "Engineer", Level: "Senior", Status: entities.HumanResourceStatusActive}
+	assert.NoError(t, db.Create(hr).Error)
+	pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+	assert.NoError(t, db.Create(pr).Error)
+	due := time.Now().AddDate(1, 0, 0)
+	milestone := &entities.Milestone{Name: "Launch", ProjectID: project.ID, EndDate: &due}
+	assert.NoError(t, db.Create(milestone).Error)
+
+	task, err := handler.CreateTask(&entities.Task{Name: "Build", ProjectID: project.ID, MilestoneID: &milestone.ID, EstimatedEffort: 16})
+	assert.NoError(t, err)
+	if assert.Len(t, *events, 1, "the first forecast is a change") {
+		assert.Equal(t, milestone.ID, (*events)[0].MilestoneID)
+		assert.Nil(t, (*events)[0].ForecastDate, "no one works on the milestone yet")
+	}
+
+	assert.NoError(t, db.Create(&entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: pr.ID, HumanResourceID: hr.ID, PlannedHours: 16, Units: 100}).Error)
+	task.Description = "Pages and layout"
+	_, err = handler.UpdateTask(task)
+	assert.NoError(t, err)
+	if !assert.Len(t, *events, 2, "an assignee gives the milestone a forecast") || !assert.NotNil(t, (*events)[1].ForecastDate) {
+		return
+	}
+
+	task.EstimatedEffort = 160
+	_, err = handler.UpdateTask(task)
+	assert.NoError(t, err)
+	if assert.Len(t, *events, 3, "more effort moves the forecast") {
+		assert.True(t, (*events)[2].ForecastDate.After(*(*events)[1].ForecastDate))
+	}
+
+	_, err = handler.UpdateTask(task)
+	assert.NoError(t, err)
+	assert.Len(t, *events, 3, "an unchanged forecast is not emitted")
+
+	assert.NoError(t, handler.DeleteTask(task.ID))
+	assert.Len(t, *events, 4, "deleting the work moves the forecast")
+}
@@ -12,13 +12,15 @@ import (
 type ProjectHandler struct {
 	ctx     context.Context
 	service *services.ProjectService
+	costs   costRecalculator
 }
 
 // NewProjectHandler creates a new ProjectHandler
-func NewProjectHandler(ctx context.Context, service *services.ProjectService) *ProjectHandler {
+func NewProjectHandler(ctx context.Context, service *services.ProjectService, laborCostService *services.LaborCostService) *ProjectHandler {
 	return &ProjectHandler{
 		ctx:     ctx,
 		service: service,
+		costs:   costRecalculator{ctx: ctx, service: laborCostService},
 	}
 }
 
@@ -51,7 +53,12 @@ func (h *ProjectHandler) UpdateProject(project *entities.Project) (int64, error)
 	if h.service == nil {
 		return 0, fmt.Errorf("project service not initialized")
 	}
-	return h.service.UpdateProject(h.ctx, project)
+	rows, err := h.service.UpdateProject(h.ctx, project)
+	if err != nil {
+		return 0, err
+	}
+	h.costs.projectChanged(project.ID)
+	return rows, nil
 }
 
 // DeleteProject deletes a project by ID
@@ -61,3 +68,28 @@ func (h *ProjectHandler) DeleteProject(id uint) error {
 	}
 	return h.service.DeleteProject(h.ctx, id)
 }
+
+// ConvertEffort converts effort between hours, days, man-weeks and man-months using the project's
+// work time settings. An empty unit is the project's display unit.
+func (h *ProjectHandler) ConvertEffort(projectID uint, value float64, from, to entities.EffortUnit) (float64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("project service not initialized")
+	}
+	return h.service.ConvertEffort(h.ctx, projectID, value, from, to)
+}
+
+// GetProjectLaborCost retrieves the labor cost of a project with its totals per resource, role, milestone and month
+func (h *ProjectHandler) GetProjectLaborCost(projectID uint) (*entities.ProjectLaborCost, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("project service not initialized")
+	}
+	return h.service.GetProjectLaborCost(h.ctx, projectID)
+}
+
+// GetProjectCost retrieves the total cost of a project, labor and cost items, with its totals per cost type, milestone and month
+func (h *ProjectHandler) GetProjectCost(projectID uint) (*entities.ProjectCost, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("project service not initialized")
+	}
+	return h.service.GetProjectCost(h.ctx, projectID)
+}
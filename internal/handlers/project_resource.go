@@ -10,15 +10,19 @@ import (
 
 // ProjectResourceHandler handles project resource-related operations for Wails bindings
 type ProjectResourceHandler struct {
-	ctx     context.Context
-	service *services.ProjectResourceService
+	ctx       context.Context
+	service   *services.ProjectResourceService
+	forecasts forecastNotifier
+	costs     costRecalculator
 }
 
 // NewProjectResourceHandler creates a new ProjectResourceHandler
-func NewProjectResourceHandler(ctx context.Context, service *services.ProjectResourceService) *ProjectResourceHandler {
+func NewProjectResourceHandler(ctx context.Context, service *services.ProjectResourceService, milestoneService *services.MilestoneService, laborCostService *services.LaborCostService) *ProjectResourceHandler {
 	return &ProjectResourceHandler{
-		ctx:     ctx,
-		service: service,
+		ctx:       ctx,
+		service:   service,
+		forecasts: forecastNotifier{ctx: ctx, service: milestoneService},
+		costs:     costRecalculator{ctx: ctx, service: laborCostService},
 	}
 }
 
@@ -43,7 +47,13 @@ func (h *ProjectResourceHandler) CreateProjectResource(projectResource *entities
 	if h.service == nil {
 		return nil, fmt.Errorf("project resource service not initialized")
 	}
-	return h.service.CreateProjectResource(h.ctx, projectResource)
+	created, err := h.service.CreateProjectResource(h.ctx, projectResource)
+	if err != nil {
+		return nil, err
+	}
+	h.costs.projectChanged(created.ProjectID)
+	h.forecasts.projectChanged(created.ProjectID)
+	return created, nil
 }
 
 // UpdateProjectResource updates an existing project resource allocation
@@ -51,7 +61,13 @@ func (h *ProjectResourceHandler) UpdateProjectResource(projectResource *entities
 	if h.service == nil {
 		return 0, fmt.Errorf("project resource service not initialized")
 	}
-	return h.service.UpdateProjectResource(h.ctx, projectResource)
+	rows, err := h.service.UpdateProjectResource(h.ctx, projectResource)
+	if err != nil {
+		return 0, err
+	}
+	h.costs.projectChanged(projectResource.ProjectID)
+	h.forecasts.projectChanged(projectResource.ProjectID)
+	return rows, nil
 }
 
 // DeleteProjectResource deletes a project resource allocation by ID
@@ -59,7 +75,15 @@ func (h *ProjectResourceHandler) DeleteProjectResource(id uint) error {
 	if h.service == nil {
 		return fmt.Errorf("project resource service not initialized")
 	}
-	return h.service.DeleteProjectResource(h.ctx, id)
+	projectResource, err := h.service.GetProjectResource(h.ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeleteProjectResource(h.ctx, id); err != nil {
+		return err
+	}
+	h.forecasts.projectChanged(projectResource.ProjectID)
+	return nil
 }
 
 // GetByProjectAndResource retrieves a project resource by project ID and human resource ID
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// RateCardHandler handles rate card operations for Wails bindings
+type RateCardHandler struct {
+	ctx     context.Context
+	service *services.RateCardService
+	costs   costRecalculator
+}
+
+// NewRateCardHandler creates a new RateCardHandler
+func NewRateCardHandler(ctx context.Context, service *services.RateCardService, laborCostService *services.LaborCostService) *RateCardHandler {
+	return &RateCardHandler{
+		ctx:     ctx,
+		service: service,
+		costs:   costRecalculator{ctx: ctx, service: laborCostService},
+	}
+}
+
+// GetRateCards retrieves multiple rate cards with optional query parameters
+func (h *RateCardHandler) GetRateCards(params *entities.RateCardQueryParams) (*entities.RateCardListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("rate card service not initialized")
+	}
+	return h.service.GetRateCards(h.ctx, params)
+}
+
+// GetRateCard retrieves a single rate card by ID
+func (h *RateCardHandler) GetRateCard(id uint) (*entities.RateCard, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("rate card service not initialized")
+	}
+	return h.service.GetRateCard(h.ctx, id)
+}
+
+// CreateRateCard creates a new rate card for a person or a role level
+func (h *RateCardHandler) CreateRateCard(rateCard *entities.RateCard) (*entities.RateCard, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("rate card service not initialized")
+	}
+	created, err := h.service.CreateRateCard(h.ctx, rateCard)
+	if err != nil {
+		return nil, err
+	}
+	h.rateChanged(created)
+	return created, nil
+}
+
+// UpdateRateCard updates an existing rate card
+func (h *RateCardHandler) UpdateRateCard(rateCard *entities.RateCard) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("rate card service not initialized")
+	}
+	previous, err := h.service.GetRateCard(h.ctx, rateCard.ID)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := h.service.UpdateRateCard(h.ctx, rateCard)
+	if err != nil {
+		return 0, err
+	}
+	// A rate card moved to another project leaves the costs of its former project to recalculate
+	if (previous.ProjectID == nil) != (rateCard.ProjectID == nil) || (previous.ProjectID != nil && *previous.ProjectID != *rateCard.ProjectID) {
+		h.rateChanged(previous)
+	}
+	h.rateChanged(rateCard)
+	return rows, nil
+}
+
+// DeleteRateCard deletes a rate card by ID
+func (h *RateCardHandler) DeleteRateCard(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("rate card service not initialized")
+	}
+	rateCard, err := h.service.GetRateCard(h.ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeleteRateCard(h.ctx, id); err != nil {
+		return err
+	}
+	h.rateChanged(rateCard)
+	return nil
+}
+
+// rateChanged recalculates the costs of the project of a rate card, or of every project for a standard rate
+func (h *RateCardHandler) rateChanged(rateCard *entities.RateCard) {
+	if rateCard.ProjectID == nil {
+		h.costs.allChanged()
+		return
+	}
+	h.costs.projectChanged(*rateCard.ProjectID)
+}
+
+// ResolvePersonRate retrieves the rate card that applies to a person in a project on a date
+func (h *RateCardHandler) ResolvePersonRate(humanResourceID, projectID uint, date time.Time) (*entities.ResolvedRate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("rate card service not initialized")
+	}
+	return h.service.ResolvePersonRate(h.ctx, humanResourceID, projectID, date)
+}
+
+// ResolveRoleRate retrieves the rate card that applies to a role and level in a project on a date
+func (h *RateCardHandler) ResolveRoleRate(roleName string, roleLevel, projectID uint, date time.Time) (*entities.ResolvedRate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("rate card service not initialized")
+	}
+	return h.service.ResolveRoleRate(h.ctx, roleName, roleLevel, projectID, date)
+}
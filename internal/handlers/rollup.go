@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// RollupHandler handles work breakdown structure rollups for Wails bindings
+type RollupHandler struct {
+	ctx     context.Context
+	service *services.RollupService
+}
+
+// NewRollupHandler creates a new RollupHandler
+func NewRollupHandler(ctx context.Context, service *services.RollupService) *RollupHandler {
+	return &RollupHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetProjectRollup computes rolled-up effort, estimates, progress and status for every task and milestone of a project
+func (h *RollupHandler) GetProjectRollup(projectID uint) (*entities.ProjectRollup, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("rollup service not initialized")
+	}
+	return h.service.GetProjectRollup(h.ctx, projectID)
+}
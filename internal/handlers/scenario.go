@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// ScenarioHandler handles what-if scenario operations for Wails bindings
+type ScenarioHandler struct {
+	ctx     context.Context
+	service *services.ScenarioService
+}
+
+// NewScenarioHandler creates a new ScenarioHandler
+func NewScenarioHandler(ctx context.Context, service *services.ScenarioService) *ScenarioHandler {
+	return &ScenarioHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetScenarios retrieves multiple scenarios with optional query parameters
+func (h *ScenarioHandler) GetScenarios(params *entities.ScenarioQueryParams) (*entities.ScenarioListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.GetScenarios(h.ctx, params)
+}
+
+// GetScenario retrieves a single scenario by ID together with its forked rows
+func (h *ScenarioHandler) GetScenario(id uint) (*entities.Scenario, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.GetScenario(h.ctx, id)
+}
+
+// CreateScenario forks the live plan of a project into a new scenario
+func (h *ScenarioHandler) CreateScenario(scenario *entities.Scenario) (*entities.Scenario, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.CreateScenario(h.ctx, scenario)
+}
+
+// UpdateScenario renames a draft scenario or changes its description
+func (h *ScenarioHandler) UpdateScenario(scenario *entities.Scenario) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.UpdateScenario(h.ctx, scenario)
+}
+
+// DeleteScenario deletes a scenario by ID
+func (h *ScenarioHandler) DeleteScenario(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.DeleteScenario(h.ctx, id)
+}
+
+// UpdateScenarioTask changes a task in a draft scenario
+func (h *ScenarioHandler) UpdateScenarioTask(task *entities.ScenarioTask) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.UpdateScenarioTask(h.ctx, task)
+}
+
+// UpdateScenarioResource changes a resource allocation in a draft scenario
+func (h *ScenarioHandler) UpdateScenarioResource(resource *entities.ScenarioResource) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.UpdateScenarioResource(h.ctx, resource)
+}
+
+// UpdateScenarioAssignment changes a task assignment in a draft scenario
+func (h *ScenarioHandler) UpdateScenarioAssignment(assignment *entities.ScenarioAssignment) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.UpdateScenarioAssignment(h.ctx, assignment)
+}
+
+// UpdateScenarioMilestone changes a milestone in a draft scenario
+func (h *ScenarioHandler) UpdateScenarioMilestone(milestone *entities.ScenarioMilestone) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.UpdateScenarioMilestone(h.ctx, milestone)
+}
+
+// CompareScenario compares the dates and cost of a scenario against the live plan
+func (h *ScenarioHandler) CompareScenario(id uint) (*entities.ScenarioComparison, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.CompareScenario(h.ctx, id)
+}
+
+// PromoteScenario makes a draft scenario the live plan of its project
+func (h *ScenarioHandler) PromoteScenario(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("scenario service not initialized")
+	}
+	return h.service.PromoteScenario(h.ctx, id)
+}
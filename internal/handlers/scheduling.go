@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// SchedulingHandler handles schedule computations for Wails bindings
+type SchedulingHandler struct {
+	ctx     context.Context
+	service *services.SchedulingService
+}
+
+// NewSchedulingHandler creates a new SchedulingHandler
+func NewSchedulingHandler(ctx context.Context, service *services.SchedulingService) *SchedulingHandler {
+	return &SchedulingHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetProjectSchedule computes the Critical Path Method schedule of a project
+func (h *SchedulingHandler) GetProjectSchedule(projectID uint) (*entities.ProjectSchedule, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("scheduling service not initialized")
+	}
+	return h.service.GetProjectSchedule(h.ctx, projectID)
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// SimulationHandler handles Monte Carlo simulations for Wails bindings
+type SimulationHandler struct {
+	ctx     context.Context
+	service *services.SimulationService
+}
+
+// NewSimulationHandler creates a new SimulationHandler
+func NewSimulationHandler(ctx context.Context, service *services.SimulationService) *SimulationHandler {
+	return &SimulationHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// SimulateProject returns the P50/P80/P90 finish dates and costs of a project and its milestones.
+// The run is cancelled with the handler's context.
+func (h *SimulationHandler) SimulateProject(projectID uint, options *entities.SimulationOptions) (*entities.ProjectSimulation, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("simulation service not initialized")
+	}
+	return h.service.SimulateProject(h.ctx, projectID, options)
+}
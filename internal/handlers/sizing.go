@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// SizingHandler handles task sizing operations for Wails bindings
+type SizingHandler struct {
+	ctx     context.Context
+	service *services.SizingService
+}
+
+// NewSizingHandler creates a new SizingHandler
+func NewSizingHandler(ctx context.Context, service *services.SizingService) *SizingHandler {
+	return &SizingHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetSizeMappings retrieves multiple size mappings with optional query parameters
+func (h *SizingHandler) GetSizeMappings(params *entities.SizeMappingQueryParams) (*entities.SizeMappingListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.GetSizeMappings(h.ctx, params)
+}
+
+// GetSizeMapping retrieves a single size mapping by ID
+func (h *SizingHandler) GetSizeMapping(id uint) (*entities.SizeMapping, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.GetSizeMapping(h.ctx, id)
+}
+
+// CreateSizeMapping adds a row to a project's sizing table
+func (h *SizingHandler) CreateSizeMapping(mapping *entities.SizeMapping) (*entities.SizeMapping, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.CreateSizeMapping(h.ctx, mapping)
+}
+
+// UpdateSizeMapping updates a row of a project's sizing table
+func (h *SizingHandler) UpdateSizeMapping(mapping *entities.SizeMapping) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.UpdateSizeMapping(h.ctx, mapping)
+}
+
+// DeleteSizeMapping deletes a row of a project's sizing table
+func (h *SizingHandler) DeleteSizeMapping(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.DeleteSizeMapping(h.ctx, id)
+}
+
+// SizeTasks gives a batch of tasks the same size and derives their effort from it
+func (h *SizingHandler) SizeTasks(sizing *entities.TaskSizing) (*entities.SizingResult, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.SizeTasks(h.ctx, sizing)
+}
+
+// OverrideTaskEffort sets the effort of a sized task by hand; nil derives it from the size again
+func (h *SizingHandler) OverrideTaskEffort(taskID uint, effort *float64) (*entities.Task, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.OverrideTaskEffort(h.ctx, taskID, effort)
+}
+
+// ResizeProject derives the effort of all sized tasks of a project from its sizing table again
+func (h *SizingHandler) ResizeProject(projectID uint) (*entities.SizingResult, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.ResizeProject(h.ctx, projectID)
+}
+
+// GetSizingSummary retrieves the rough estimate of a project's backlog from its task sizes
+func (h *SizingHandler) GetSizingSummary(projectID uint) (*entities.SizingSummary, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sizing service not initialized")
+	}
+	return h.service.GetSizingSummary(h.ctx, projectID)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// SprintHandler handles sprint operations for Wails bindings
+type SprintHandler struct {
+	ctx     context.Context
+	service *services.SprintService
+}
+
+// NewSprintHandler creates a new SprintHandler
+func NewSprintHandler(ctx context.Context, service *services.SprintService) *SprintHandler {
+	return &SprintHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetSprints retrieves multiple sprints with optional query parameters
+func (h *SprintHandler) GetSprints(params *entities.SprintQueryParams) (*entities.SprintListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.GetSprints(h.ctx, params)
+}
+
+// GetSprint retrieves a single sprint by ID
+func (h *SprintHandler) GetSprint(id uint) (*entities.Sprint, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.GetSprint(h.ctx, id)
+}
+
+// CreateSprint creates a new sprint in an agile or hybrid project
+func (h *SprintHandler) CreateSprint(sprint *entities.Sprint) (*entities.Sprint, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.CreateSprint(h.ctx, sprint)
+}
+
+// UpdateSprint updates an existing sprint
+func (h *SprintHandler) UpdateSprint(sprint *entities.Sprint) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.UpdateSprint(h.ctx, sprint)
+}
+
+// DeleteSprint deletes a sprint by ID and moves its tasks back to the backlog
+func (h *SprintHandler) DeleteSprint(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.DeleteSprint(h.ctx, id)
+}
+
+// AssignTasksToSprint plans the given tasks in a sprint
+func (h *SprintHandler) AssignTasksToSprint(sprintID uint, taskIDs []uint) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.AssignTasksToSprint(h.ctx, sprintID, taskIDs)
+}
+
+// MoveTasksToBacklog takes the given tasks out of their sprints
+func (h *SprintHandler) MoveTasksToBacklog(taskIDs []uint) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.MoveTasksToBacklog(h.ctx, taskIDs)
+}
+
+// GetVelocityForecast returns the velocity of a project's sprints and forecasts the sprints its backlog needs.
+// A window of 0 averages the default number of recent sprints.
+func (h *SprintHandler) GetVelocityForecast(projectID uint, window int) (*entities.VelocityForecast, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("sprint service not initialized")
+	}
+	return h.service.GetVelocityForecast(h.ctx, projectID, window)
+}
@@ -10,15 +10,17 @@ import (
 
 // TaskHandler handles task-related operations for Wails bindings
 type TaskHandler struct {
-	ctx     context.Context
-	service *services.TaskService
+	ctx       context.Context
+	service   *services.TaskService
+	forecasts forecastNotifier
 }
 
 // NewTaskHandler creates a new TaskHandler
-func NewTaskHandler(ctx context.Context, service *services.TaskService) *TaskHandler {
+func NewTaskHandler(ctx context.Context, service *services.TaskService, milestoneService *services.MilestoneService) *TaskHandler {
 	return &TaskHandler{
-		ctx:     ctx,
-		service: service,
+		ctx:       ctx,
+		service:   service,
+		forecasts: forecastNotifier{ctx: ctx, service: milestoneService},
 	}
 }
 
@@ -43,7 +45,12 @@ func (h *TaskHandler) CreateTask(task *entities.Task) (*entities.Task, error) {
 	if h.service == nil {
 		return nil, fmt.Errorf("task service not initialized")
 	}
-	return h.service.CreateTask(h.ctx, task)
+	created, err := h.service.CreateTask(h.ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	h.forecasts.projectChanged(created.ProjectID)
+	return created, nil
 }
 
 // UpdateTask updates an existing task
@@ -51,7 +58,12 @@ func (h *TaskHandler) UpdateTask(task *entities.Task) (int64, error) {
 	if h.service == nil {
 		return 0, fmt.Errorf("task service not initialized")
 	}
-	return h.service.UpdateTask(h.ctx, task)
+	rows, err := h.service.UpdateTask(h.ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	h.forecasts.tasksChanged(task.ID)
+	return rows, nil
 }
 
 // DeleteTask deletes a task by ID
@@ -59,5 +71,13 @@ func (h *TaskHandler) DeleteTask(id uint) error {
 	if h.service == nil {
 		return fmt.Errorf("task service not initialized")
 	}
-	return h.service.DeleteTask(h.ctx, id)
+	task, err := h.service.GetTask(h.ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeleteTask(h.ctx, id); err != nil {
+		return err
+	}
+	h.forecasts.projectChanged(task.ProjectID)
+	return nil
 }
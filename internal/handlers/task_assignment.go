@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// TaskAssignmentHandler handles task assignment operations for Wails bindings
+type TaskAssignmentHandler struct {
+	ctx       context.Context
+	service   *services.TaskAssignmentService
+	forecasts forecastNotifier
+}
+
+// NewTaskAssignmentHandler creates a new TaskAssignmentHandler
+func NewTaskAssignmentHandler(ctx context.Context, service *services.TaskAssignmentService, milestoneService *services.MilestoneService) *TaskAssignmentHandler {
+	return &TaskAssignmentHandler{
+		ctx:       ctx,
+		service:   service,
+		forecasts: forecastNotifier{ctx: ctx, service: milestoneService},
+	}
+}
+
+// GetTaskAssignments retrieves multiple task assignments with optional query parameters
+func (h *TaskAssignmentHandler) GetTaskAssignments(params *entities.TaskAssignmentQueryParams) (*entities.TaskAssignmentListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task assignment service not initialized")
+	}
+	return h.service.GetTaskAssignments(h.ctx, params)
+}
+
+// GetTaskAssignmentsByTask retrieves all assignments of a task
+func (h *TaskAssignmentHandler) GetTaskAssignmentsByTask(taskID uint) (*entities.TaskAssignmentListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task assignment service not initialized")
+	}
+	return h.service.GetTaskAssignmentsByTask(h.ctx, taskID)
+}
+
+// GetTaskAssignment retrieves a single task assignment by ID
+func (h *TaskAssignmentHandler) GetTaskAssignment(id uint) (*entities.TaskAssignment, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task assignment service not initialized")
+	}
+	return h.service.GetTaskAssignment(h.ctx, id)
+}
+
+// CreateTaskAssignment assigns a human resource allocated to the task's project to a task
+func (h *TaskAssignmentHandler) CreateTaskAssignment(assignment *entities.TaskAssignment) (*entities.TaskAssignment, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task assignment service not initialized")
+	}
+	created, err := h.service.CreateTaskAssignment(h.ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+	h.forecasts.tasksChanged(created.TaskID)
+	return created, nil
+}
+
+// UpdateTaskAssignment updates an existing task assignment
+func (h *TaskAssignmentHandler) UpdateTaskAssignment(assignment *entities.TaskAssignment) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("task assignment service not initialized")
+	}
+	rows, err := h.service.UpdateTaskAssignment(h.ctx, assignment)
+	if err != nil {
+		return 0, err
+	}
+	h.forecasts.tasksChanged(assignment.TaskID)
+	return rows, nil
+}
+
+// DeleteTaskAssignment deletes a task assignment by ID
+func (h *TaskAssignmentHandler) DeleteTaskAssignment(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("task assignment service not initialized")
+	}
+	assignment, err := h.service.GetTaskAssignment(h.ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeleteTaskAssignment(h.ctx, id); err != nil {
+		return err
+	}
+	h.forecasts.tasksChanged(assignment.TaskID)
+	return nil
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// TaskDependencyHandler handles task dependency-related operations for Wails bindings
+type TaskDependencyHandler struct {
+	ctx       context.Context
+	service   *services.TaskDependencyService
+	forecasts forecastNotifier
+}
+
+// NewTaskDependencyHandler creates a new TaskDependencyHandler
+func NewTaskDependencyHandler(ctx context.Context, service *services.TaskDependencyService, milestoneService *services.MilestoneService) *TaskDependencyHandler {
+	return &TaskDependencyHandler{
+		ctx:       ctx,
+		service:   service,
+		forecasts: forecastNotifier{ctx: ctx, service: milestoneService},
+	}
+}
+
+// GetTaskDependencies retrieves multiple task dependencies with optional query parameters
+func (h *TaskDependencyHandler) GetTaskDependencies(params *entities.TaskDependencyQueryParams) (*entities.TaskDependencyListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task dependency service not initialized")
+	}
+	return h.service.GetTaskDependencies(h.ctx, params)
+}
+
+// GetTaskDependency retrieves a single task dependency by ID
+func (h *TaskDependencyHandler) GetTaskDependency(id uint) (*entities.TaskDependency, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task dependency service not initialized")
+	}
+	return h.service.GetTaskDependency(h.ctx, id)
+}
+
+// GetTaskDependenciesByTask retrieves all dependencies where the task is either predecessor or successor
+func (h *TaskDependencyHandler) GetTaskDependenciesByTask(taskID uint) (*entities.TaskDependencyListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task dependency service not initialized")
+	}
+	return h.service.GetTaskDependenciesByTask(h.ctx, taskID)
+}
+
+// CreateTaskDependency creates a new task dependency
+func (h *TaskDependencyHandler) CreateTaskDependency(dependency *entities.TaskDependency) (*entities.TaskDependency, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task dependency service not initialized")
+	}
+	created, err := h.service.CreateTaskDependency(h.ctx, dependency)
+	if err != nil {
+		return nil, err
+	}
+	h.forecasts.tasksChanged(created.PredecessorID, created.SuccessorID)
+	return created, nil
+}
+
+// UpdateTaskDependency updates an existing task dependency
+func (h *TaskDependencyHandler) UpdateTaskDependency(dependency *entities.TaskDependency) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("task dependency service not initialized")
+	}
+	rows, err := h.service.UpdateTaskDependency(h.ctx, dependency)
+	if err != nil {
+		return 0, err
+	}
+	h.forecasts.tasksChanged(dependency.PredecessorID, dependency.SuccessorID)
+	return rows, nil
+}
+
+// DeleteTaskDependency deletes a task dependency by ID
+func (h *TaskDependencyHandler) DeleteTaskDependency(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("task dependency service not initialized")
+	}
+	dependency, err := h.service.GetTaskDependency(h.ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeleteTaskDependency(h.ctx, id); err != nil {
+		return err
+	}
+	h.forecasts.tasksChanged(dependency.PredecessorID, dependency.SuccessorID)
+	return nil
+}
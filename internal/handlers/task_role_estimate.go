@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// TaskRoleEstimateHandler handles task role estimate operations for Wails bindings
+type TaskRoleEstimateHandler struct {
+	ctx     context.Context
+	service *services.TaskRoleEstimateService
+}
+
+// NewTaskRoleEstimateHandler creates a new TaskRoleEstimateHandler
+func NewTaskRoleEstimateHandler(ctx context.Context, service *services.TaskRoleEstimateService) *TaskRoleEstimateHandler {
+	return &TaskRoleEstimateHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetTaskRoleEstimates retrieves multiple task role estimates with optional query parameters
+func (h *TaskRoleEstimateHandler) GetTaskRoleEstimates(params *entities.TaskRoleEstimateQueryParams) (*entities.TaskRoleEstimateListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task role estimate service not initialized")
+	}
+	return h.service.GetTaskRoleEstimates(h.ctx, params)
+}
+
+// GetTaskRoleEstimate retrieves a single task role estimate by ID
+func (h *TaskRoleEstimateHandler) GetTaskRoleEstimate(id uint) (*entities.TaskRoleEstimate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task role estimate service not initialized")
+	}
+	return h.service.GetTaskRoleEstimate(h.ctx, id)
+}
+
+// CreateTaskRoleEstimate estimates the effort a task needs from one role of its project
+func (h *TaskRoleEstimateHandler) CreateTaskRoleEstimate(estimate *entities.TaskRoleEstimate) (*entities.TaskRoleEstimate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task role estimate service not initialized")
+	}
+	return h.service.CreateTaskRoleEstimate(h.ctx, estimate)
+}
+
+// UpdateTaskRoleEstimate updates an existing task role estimate
+func (h *TaskRoleEstimateHandler) UpdateTaskRoleEstimate(estimate *entities.TaskRoleEstimate) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("task role estimate service not initialized")
+	}
+	return h.service.UpdateTaskRoleEstimate(h.ctx, estimate)
+}
+
+// DeleteTaskRoleEstimate deletes a task role estimate by ID
+func (h *TaskRoleEstimateHandler) DeleteTaskRoleEstimate(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("task role estimate service not initialized")
+	}
+	return h.service.DeleteTaskRoleEstimate(h.ctx, id)
+}
+
+// GetRoleDemand reports the demand per role and month of a project against the roles' headcounts
+func (h *TaskRoleEstimateHandler) GetRoleDemand(projectID uint) (*entities.RoleDemandReport, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("task role estimate service not initialized")
+	}
+	return h.service.GetRoleDemand(h.ctx, projectID)
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// TimeEntryHandler handles time entry operations for Wails bindings
+type TimeEntryHandler struct {
+	ctx     context.Context
+	service *services.TimeEntryService
+}
+
+// NewTimeEntryHandler creates a new TimeEntryHandler
+func NewTimeEntryHandler(ctx context.Context, service *services.TimeEntryService) *TimeEntryHandler {
+	return &TimeEntryHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetTimeEntries retrieves multiple time entrys with optional query parameters
+func (h *TimeEntryHandler) GetTimeEntries(params *entities.TimeEntryQueryParams) (*entities.TimeEntryListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("time entry service not initialized")
+	}
+	return h.service.GetTimeEntries(h.ctx, params)
+}
+
+// GetTimeEntry retrieves a single time entry by ID
+func (h *TimeEntryHandler) GetTimeEntry(id uint) (*entities.TimeEntry, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("time entry service not initialized")
+	}
+	return h.service.GetTimeEntry(h.ctx, id)
+}
+
+// CreateTimeEntry logs the hours a human resource worked on a task on one day
+func (h *TimeEntryHandler) CreateTimeEntry(entry *entities.TimeEntry) (*entities.TimeEntry, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("time entry service not initialized")
+	}
+	return h.service.CreateTimeEntry(h.ctx, entry)
+}
+
+// UpdateTimeEntry updates an existing time entry
+func (h *TimeEntryHandler) UpdateTimeEntry(entry *entities.TimeEntry) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("time entry service not initialized")
+	}
+	return h.service.UpdateTimeEntry(h.ctx, entry)
+}
+
+// DeleteTimeEntry deletes a time entry by ID
+func (h *TimeEntryHandler) DeleteTimeEntry(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("time entry service not initialized")
+	}
+	return h.service.DeleteTimeEntry(h.ctx, id)
+}
+
+// GetTimeSummary totals the time logged on a project by week, person and task
+func (h *TimeEntryHandler) GetTimeSummary(params *entities.TimeEntryQueryParams) (*entities.TimeSummary, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("time entry service not initialized")
+	}
+	return h.service.GetTimeSummary(h.ctx, params)
+}
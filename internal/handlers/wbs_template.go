@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/services"
+)
+
+// WBSTemplateHandler handles WBS template operations for Wails bindings
+type WBSTemplateHandler struct {
+	ctx     context.Context
+	service *services.WBSTemplateService
+}
+
+// NewWBSTemplateHandler creates a new WBSTemplateHandler
+func NewWBSTemplateHandler(ctx context.Context, service *services.WBSTemplateService) *WBSTemplateHandler {
+	return &WBSTemplateHandler{
+		ctx:     ctx,
+		service: service,
+	}
+}
+
+// GetWBSTemplates retrieves multiple WBS templates with optional query parameters
+func (h *WBSTemplateHandler) GetWBSTemplates(params *entities.WBSTemplateQueryParams) (*entities.WBSTemplateListResponse, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("WBS template service not initialized")
+	}
+	return h.service.GetWBSTemplates(h.ctx, params)
+}
+
+// GetWBSTemplate retrieves a single WBS template by ID
+func (h *WBSTemplateHandler) GetWBSTemplate(id uint) (*entities.WBSTemplate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("WBS template service not initialized")
+	}
+	return h.service.GetWBSTemplate(h.ctx, id)
+}
+
+// CreateWBSTemplate adds a reusable task hierarchy to the template library
+func (h *WBSTemplateHandler) CreateWBSTemplate(template *entities.WBSTemplate) (*entities.WBSTemplate, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("WBS template service not initialized")
+	}
+	return h.service.CreateWBSTemplate(h.ctx, template)
+}
+
+// UpdateWBSTemplate updates an existing WBS template
+func (h *WBSTemplateHandler) UpdateWBSTemplate(template *entities.WBSTemplate) (int64, error) {
+	if h.service == nil {
+		return 0, fmt.Errorf("WBS template service not initialized")
+	}
+	return h.service.UpdateWBSTemplate(h.ctx, template)
+}
+
+// DeleteWBSTemplate deletes a WBS template by ID
+func (h *WBSTemplateHandler) DeleteWBSTemplate(id uint) error {
+	if h.service == nil {
+		return fmt.Errorf("WBS template service not initialized")
+	}
+	return h.service.DeleteWBSTemplate(h.ctx, id)
+}
+
+// InstantiateWBSTemplate creates the task tree of a template under a chosen project, milestone and parent task
+func (h *WBSTemplateHandler) InstantiateWBSTemplate(options *entities.WBSTemplateInstantiation) (*entities.WBSTemplateInstance, error) {
+	if h.service == nil {
+		return nil, fmt.Errorf("WBS template service not initialized")
+	}
+	return h.service.InstantiateWBSTemplate(h.ctx, options)
+}
@@ -56,15 +56,43 @@ func InitializeDatabase() (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	// Scale money saved before it was stored in ten-thousandths
+	if err := entities.ScaleMoneyColumns(db); err != nil {
+		return nil, fmt.Errorf("failed to scale money columns: %w", err)
+	}
+
+	// Keep allocation costs entered before they were calculated
+	if err := entities.KeepLegacyCostOverrides(db); err != nil {
+		return nil, fmt.Errorf("failed to keep cost overrides: %w", err)
+	}
+
 	// Auto-migrate entities
 	err = db.AutoMigrate(
 		&entities.Client{},
+		&entities.Calendar{},
+		&entities.CalendarException{},
 		&entities.HumanResource{},
 		&entities.Project{},
 		&entities.ProjectResource{},
 		&entities.ProjectRole{},
 		&entities.Milestone{},
 		&entities.Task{},
+		&entities.TaskDependency{},
+		&entities.TaskAssignment{},
+		&entities.Scenario{},
+		&entities.ScenarioTask{},
+		&entities.ScenarioResource{},
+		&entities.ScenarioAssignment{},
+		&entities.ScenarioMilestone{},
+		&entities.TaskRoleEstimate{},
+		&entities.TimeEntry{},
+		&entities.WBSTemplate{},
+		&entities.Buffer{},
+		&entities.Sprint{},
+		&entities.SizeMapping{},
+		&entities.RateCard{},
+		&entities.CostItem{},
+		&entities.ExchangeRate{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to auto-migrate: %w", err)
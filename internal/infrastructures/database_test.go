@@ -0,0 +1,172 @@
+package infrastructures
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/config"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/ducminhgd/plan-craft/internal/services"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// baselineSchema is the schema of a database created before calendars, dependencies and costs
+var baselineSchema = []string{
+	"CREATE TABLE `clients` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` text NOT NULL,`email` text NOT NULL,`phone` text,`address` text,`contact_person` text,`notes` text,`status` integer NOT NULL DEFAULT 1,`created_at` datetime,`updated_at` datetime)",
+	"CREATE TABLE `human_resources` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` text NOT NULL,`title` text NOT NULL,`level` text NOT NULL,`status` integer NOT NULL DEFAULT 2,`created_at` datetime,`updated_at` datetime)",
+	"CREATE TABLE `projects` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` text NOT NULL,`description` text,`client_id` integer NOT NULL,`start_date` datetime,`end_date` datetime,`status` integer NOT NULL DEFAULT 2,`created_at` datetime,`updated_at` datetime,`hours_per_day` integer DEFAULT 8,`days_per_week` integer DEFAULT 5,`working_days_per_week` text,`timezone` text DEFAULT \"\",`currency` text DEFAULT \"\",CONSTRAINT `fk_projects_client` FOREIGN KEY (`client_id`) REFERENCES `clients`(`id`))",
+	"CREATE INDEX `idx_projects_client_id` ON `projects`(`client_id`)",
+	"CREATE TABLE `project_resources` (`id` integer PRIMARY KEY AUTOINCREMENT,`project_id` integer NOT NULL,`human_resource_id` integer NOT NULL,`role` text,`allocation` real DEFAULT 100,`cost` real DEFAULT 0,`start_date` datetime,`end_date` datetime,`notes` text,`status` integer NOT NULL DEFAULT 2,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_project_resources_human_resource` FOREIGN KEY (`human_resource_id`) REFERENCES `human_resources`(`id`),CONSTRAINT `fk_projects_project_resources` FOREIGN KEY (`project_id`) REFERENCES `projects`(`id`))",
+	"CREATE INDEX `idx_project_resources_human_resource_id` ON `project_resources`(`human_resource_id`)",
+	"CREATE UNIQUE INDEX `idx_project_human_resource` ON `project_resources`(`project_id`,`human_resource_id`)",
+	"CREATE INDEX `idx_project_resources_project_id` ON `project_resources`(`project_id`)",
+	"CREATE TABLE `project_roles` (`id` integer PRIMARY KEY AUTOINCREMENT,`project_id` integer NOT NULL,`name` text NOT NULL,`level` integer NOT NULL,`headcount` integer NOT NULL DEFAULT 1,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_projects_project_roles` FOREIGN KEY (`project_id`) REFERENCES `projects`(`id`))",
+	"CREATE UNIQUE INDEX `idx_project_role_name_level` ON `project_roles`(`project_id`,`name`,`level`)",
+	"CREATE INDEX `idx_project_roles_project_id` ON `project_roles`(`project_id`)",
+	"CREATE TABLE `milestones` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` text NOT NULL,`description` text,`project_id` integer NOT NULL,`start_date` datetime,`end_date` datetime,`status` integer NOT NULL DEFAULT 2,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_milestones_project` FOREIGN KEY (`project_id`) REFERENCES `projects`(`id`))",
+	"CREATE INDEX `idx_milestones_project_id` ON `milestones`(`project_id`)",
+	"CREATE TABLE `tasks` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` text NOT NULL,`description` text,`level` integer NOT NULL DEFAULT 1,`project_id` integer NOT NULL,`milestone_id` integer,`parent_id` integer,`priority` integer NOT NULL DEFAULT 2,`estimated_effort` real NOT NULL DEFAULT 0,`status` integer NOT NULL DEFAULT 1,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_tasks_children` FOREIGN KEY (`parent_id`) REFERENCES `tasks`(`id`),CONSTRAINT `fk_tasks_project` FOREIGN KEY (`project_id`) REFERENCES `projects`(`id`),CONSTRAINT `fk_tasks_milestone` FOREIGN KEY (`milestone_id`) REFERENCES `milestones`(`id`))",
+	"CREATE INDEX `idx_tasks_parent_id` ON `tasks`(`parent_id`)",
+	"CREATE INDEX `idx_tasks_milestone_id` ON `tasks`(`milestone_id`)",
+	"CREATE INDEX `idx_tasks_project_id` ON `tasks`(`project_id`)",
+}
+
+// baselineData fills every baseline table so that rebuilding a referenced table breaks its foreign keys
+var baselineData = []string{
+	"INSERT INTO clients (id, name, email, status) VALUES (1, 'Acme', 'acme@example.com', 2)",
+	"INSERT INTO human_resources (id, name, title, level, status) VALUES (1, 'Alice', 'Engineer', 'Senior', 2)",
+	"INSERT INTO projects (id, name, client_id, start_date, status, working_days_per_week) VALUES (1, 'Website', 1, '2026-01-05 00:00:00+00:00', 2, '[1,2,3,4,5]')",
+	"INSERT INTO project_resources (id, project_id, human_resource_id, role, allocation, cost, status) VALUES (1, 1, 1, 'Developer', 100, 123.45, 2)",
+	"INSERT INTO project_roles (id, project_id, name, level, headcount) VALUES (1, 1, 'Developer', 3, 1)",
+	"INSERT INTO milestones (id, name, project_id, status) VALUES (1, 'Launch', 1, 2)",
+	"INSERT INTO tasks (id, name, level, project_id, milestone_id, estimated_effort, status) VALUES (1, 'Build', 1, 1, 1, 16, 1)",
+	"INSERT INTO tasks (id, name, level, project_id, milestone_id, parent_id, estimated_effort, status) VALUES (2, 'Pages', 2, 1, 1, 1, 8, 1)",
+}
+
+// createBaselineDatabase creates a database file with the baseline schema and data
+func createBaselineDatabase(t *testing.T) string {
+	dbPath := filepath.Join(t.TempDir(), "baseline.db")
+	db, err := gorm.Open(sqlite.Open(dbPath+"?_foreign_keys=ON"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to create baseline database: %v", err)
+	}
+	for _, statement := range append(baselineSchema, baselineData...) {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("Failed to run %q: %v", statement, err)
+		}
+	}
+	closeTestDatabase(t, db)
+	return dbPath
+}
+
+// useDatabaseFile points the configuration at a database file for the duration of the test
+func useDatabaseFile(t *testing.T, dbPath string) {
+	originalCfg := config.Cfg
+	config.Cfg = config.Config{
+		DB: config.DBConfig{
+			DSN:         dbPath,
+			JournalMode: "WAL",
+			Synchronous: "NORMAL",
+			ForeignKeys: "ON",
+			BusyTimeout: "5000",
+			CacheSize:   "-64000",
+			TempStore:   "MEMORY",
+			AutoVacuum:  "INCREMENTAL",
+		},
+		LogLevel: "ERROR",
+	}
+	t.Cleanup(func() {
+		config.Cfg = originalCfg
+	})
+}
+
+func closeTestDatabase(t *testing.T, db *gorm.DB) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("Failed to get sql.DB: %v", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+}
+
+func TestInitializeDatabase_MigratesBaselineDatabase(t *testing.T) {
+	useDatabaseFile(t, createBaselineDatabase(t))
+
+	db, err := InitializeDatabase()
+	if err != nil {
+		t.Fatalf("Failed to migrate baseline database: %v", err)
+	}
+	defer closeTestDatabase(t, db)
+
+	if !db.Migrator().HasColumn("projects", "calendar_id") {
+		t.Error("Expected projects to have a calendar_id column")
+	}
+
+	var counts struct {
+		Projects  int64
+		Resources int64
+		Tasks     int64
+	}
+	db.Table("projects").Count(&counts.Projects)
+	db.Table("project_resources").Count(&counts.Resources)
+	db.Table("tasks").Count(&counts.Tasks)
+	if counts.Projects != 1 || counts.Resources != 1 || counts.Tasks != 2 {
+		t.Errorf("Expected existing rows to be kept, got %+v", counts)
+	}
+
+	// The cost typed in before costs were calculated is kept when they are recalculated
+	var allocation entities.ProjectResource
+	if err := db.First(&allocation, 1).Error; err != nil {
+		t.Fatalf("Failed to read the allocation: %v", err)
+	}
+	if !allocation.CostOverride {
+		t.Error("Expected the allocation cost to be marked as entered by hand")
+	}
+	ctx := context.Background()
+	projectRepo := repositories.NewProjectRepository(db)
+	hrRepo := repositories.NewHRRepository(db)
+	projectResourceRepo := repositories.NewProjectResourceRepository(db)
+	rateCardService := services.NewRateCardService(repositories.NewRateCardRepository(db), projectRepo, hrRepo, projectResourceRepo, repositories.NewProjectRoleRepository(db))
+	laborCostService := services.NewLaborCostService(projectRepo, projectResourceRepo, hrRepo, repositories.NewCalendarRepository(db), repositories.NewTaskRepository(db), repositories.NewMilestoneRepository(db), repositories.NewTaskAssignmentRepository(db), rateCardService)
+	if err := laborCostService.RecalculateProjectCosts(ctx, 1); err != nil {
+		t.Fatalf("Failed to recalculate labor costs: %v", err)
+	}
+	if err := db.First(&allocation, 1).Error; err != nil {
+		t.Fatalf("Failed to read the allocation: %v", err)
+	}
+	if allocation.Cost != entities.NewMoney(123.45) {
+		t.Errorf("Expected the allocation to keep its cost of 123.45, got %s", allocation.Cost)
+	}
+
+	var violations []map[string]interface{}
+	if err := db.Raw("PRAGMA foreign_key_check").Scan(&violations).Error; err != nil {
+		t.Fatalf("Failed to check foreign keys: %v", err)
+	}
+	if len(violations) > 0 {
+		t.Errorf("Expected no foreign key violations, got %v", violations)
+	}
+}
+
+func TestInitializeDatabase_ScalesMoneyOnce(t *testing.T) {
+	useDatabaseFile(t, createBaselineDatabase(t))
+
+	for i := 1; i <= 2; i++ {
+		db, err := InitializeDatabase()
+		if err != nil {
+			t.Fatalf("Failed to open baseline database the %d. time: %v", i, err)
+		}
+
+		var cost int64
+		if err := db.Raw("SELECT cost FROM project_resources WHERE id = 1").Scan(&cost).Error; err != nil {
+			t.Fatalf("Failed to read the resource cost: %v", err)
+		}
+		if cost != 1234500 {
+			t.Errorf("Expected the cost of 123.45 to be stored as 1234500 after opening %d times, got %d", i, cost)
+		}
+		closeTestDatabase(t, db)
+	}
+}
@@ -44,6 +44,8 @@ func InitializeDatabase() error {
 		Logger:                 gormLogger,
 		SkipDefaultTransaction: true, // Improve performance
 		PrepareStmt:            true, // Cache prepared statements
+		// Timestamps are instants and stay in UTC; calendar dates are
+		// interpreted in the project's time zone (see Project.DateOf)
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
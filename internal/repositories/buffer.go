@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BufferRepository is the repository for buffer entities
+type BufferRepository struct {
+	db *gorm.DB
+}
+
+// NewBufferRepository creates a new buffer repository
+func NewBufferRepository(db *gorm.DB) *BufferRepository {
+	return &BufferRepository{db: db}
+}
+
+// Create creates a new buffer and returns it with database-generated fields populated
+func (r *BufferRepository) Create(ctx context.Context, buffer *entities.Buffer) (*entities.Buffer, error) {
+	err := r.db.WithContext(ctx).Create(buffer).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "buffer", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "buffer", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "buffer", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "buffer", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "buffer", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create buffer", "repository", "buffer", "method", "Create", "error", err)
+		return nil, err
+	}
+	return buffer, nil
+}
+
+// GetOne gets a buffer by ID
+func (r *BufferRepository) GetOne(ctx context.Context, id uint) (*entities.Buffer, error) {
+	var buffer entities.Buffer
+	err := r.db.WithContext(ctx).Model(&entities.Buffer{}).First(&buffer, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "buffer", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get buffer", "repository", "buffer", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &buffer, err
+}
+
+// GetMany gets multiple buffers by query parameters
+func (r *BufferRepository) GetMany(ctx context.Context, qParams *entities.BufferQueryParams) ([]*entities.Buffer, int64, error) {
+	var (
+		buffers []*entities.Buffer
+		count   int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.Buffer{})
+
+	if qParams == nil {
+		qParams = &entities.BufferQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("project_id = @ProjectID", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("project_id IN ?", qParams.ProjectID_In)
+	}
+	if qParams.MilestoneID != nil {
+		q = q.Where("milestone_id = @MilestoneID", sql.Named("MilestoneID", *qParams.MilestoneID))
+	}
+	if len(qParams.MilestoneID_In) > 0 {
+		q = q.Where("milestone_id IN ?", qParams.MilestoneID_In)
+	}
+	if qParams.MilestoneID_IsNull != nil {
+		if *qParams.MilestoneID_IsNull {
+			q = q.Where("milestone_id IS NULL")
+		} else {
+			q = q.Where("milestone_id IS NOT NULL")
+		}
+	}
+	if qParams.Kind != "" {
+		q = q.Where("kind = @Kind", sql.Named("Kind", qParams.Kind))
+	}
+	if len(qParams.Kind_In) > 0 {
+		q = q.Where("kind IN ?", qParams.Kind_In)
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count buffers", "repository", "buffer", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.BufferAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&buffers)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get buffers", "repository", "buffer", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return buffers, count, nil
+}
+
+// Update updates a buffer and returns it with updated database fields
+func (r *BufferRepository) Update(ctx context.Context, buffer *entities.Buffer) (int64, error) {
+	result := r.db.WithContext(ctx).Model(buffer).Clauses(clause.Returning{}).Where("id = ?", buffer.ID).Select("*").Updates(&buffer)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "buffer", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "buffer", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "buffer", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "buffer", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update buffer", "repository", "buffer", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a buffer by ID
+func (r *BufferRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Buffer{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete buffer", "repository", "buffer", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
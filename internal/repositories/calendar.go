@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CalendarRepository is the repository for calendar entities
+type CalendarRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarRepository creates a new calendar repository
+func NewCalendarRepository(db *gorm.DB) *CalendarRepository {
+	return &CalendarRepository{db: db}
+}
+
+// Create creates a new calendar and returns it with database-generated fields populated
+func (r *CalendarRepository) Create(ctx context.Context, calendar *entities.Calendar) (*entities.Calendar, error) {
+	err := r.db.WithContext(ctx).Omit("Exceptions").Create(calendar).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "calendar", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "calendar", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "calendar", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "calendar", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create calendar", "repository", "calendar", "method", "Create", "error", err)
+		return nil, err
+	}
+	return calendar, nil
+}
+
+// GetOne gets a calendar by ID together with its exceptions
+func (r *CalendarRepository) GetOne(ctx context.Context, id uint) (*entities.Calendar, error) {
+	var calendar entities.Calendar
+	err := r.db.WithContext(ctx).Model(&entities.Calendar{}).
+		Preload("Exceptions", func(db *gorm.DB) *gorm.DB { return db.Order("start_date") }).
+		First(&calendar, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "calendar", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get calendar", "repository", "calendar", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &calendar, err
+}
+
+// GetMany gets multiple calendars by query parameters
+func (r *CalendarRepository) GetMany(ctx context.Context, qParams *entities.CalendarQueryParams) ([]*entities.Calendar, int64, error) {
+	var (
+		calendars []*entities.Calendar
+		count     int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.Calendar{})
+
+	if qParams == nil {
+		qParams = &entities.CalendarQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.Name != "" {
+		q = q.Where("name = @Name", sql.Named("Name", qParams.Name))
+	}
+	if qParams.Name_Like != "" {
+		q = q.Where("name LIKE ?", "%"+qParams.Name_Like+"%")
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count calendars", "repository", "calendar", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.CalendarAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&calendars)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get calendars", "repository", "calendar", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return calendars, count, nil
+}
+
+// Update updates a calendar and returns it with updated database fields.
+// Exceptions are managed through the calendar exception repository.
+func (r *CalendarRepository) Update(ctx context.Context, calendar *entities.Calendar) (int64, error) {
+	result := r.db.WithContext(ctx).Model(calendar).Clauses(clause.Returning{}).Where("id = ?", calendar.ID).Select("*").Omit("Exceptions").Updates(&calendar)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "calendar", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "calendar", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "calendar", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "calendar", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrDuplicatedKey
+		}
+		internal.Logger.Error("failed to update calendar", "repository", "calendar", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a calendar by ID. Its exceptions are removed with it and
+// projects using it fall back to their weekday configuration.
+func (r *CalendarRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Calendar{}, id)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "calendar", "method", "Delete", "error", err)
+			return entities.ErrForeignKeyViolated
+		}
+		internal.Logger.Error("failed to delete calendar", "repository", "calendar", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
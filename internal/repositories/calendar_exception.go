@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CalendarExceptionRepository is the repository for calendar exception entities
+type CalendarExceptionRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarExceptionRepository creates a new calendar exception repository
+func NewCalendarExceptionRepository(db *gorm.DB) *CalendarExceptionRepository {
+	return &CalendarExceptionRepository{db: db}
+}
+
+// Create creates a new calendar exception and returns it with database-generated fields populated
+func (r *CalendarExceptionRepository) Create(ctx context.Context, exception *entities.CalendarException) (*entities.CalendarException, error) {
+	err := r.db.WithContext(ctx).Create(exception).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "calendar_exception", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "calendar_exception", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "calendar_exception", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "calendar_exception", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "calendar_exception", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create calendar exception", "repository", "calendar_exception", "method", "Create", "error", err)
+		return nil, err
+	}
+	return exception, nil
+}
+
+// GetOne gets a calendar exception by ID
+func (r *CalendarExceptionRepository) GetOne(ctx context.Context, id uint) (*entities.CalendarException, error) {
+	var exception entities.CalendarException
+	err := r.db.WithContext(ctx).Model(&entities.CalendarException{}).First(&exception, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "calendar_exception", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get calendar exception", "repository", "calendar_exception", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &exception, err
+}
+
+// GetMany gets multiple calendar exceptions by query parameters
+func (r *CalendarExceptionRepository) GetMany(ctx context.Context, qParams *entities.CalendarExceptionQueryParams) ([]*entities.CalendarException, int64, error) {
+	var (
+		exceptions []*entities.CalendarException
+		count      int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.CalendarException{})
+
+	if qParams == nil {
+		qParams = &entities.CalendarExceptionQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.CalendarID != 0 {
+		q = q.Where("calendar_id = @CalendarID", sql.Named("CalendarID", qParams.CalendarID))
+	}
+	if len(qParams.CalendarID_In) > 0 {
+		q = q.Where("calendar_id IN ?", qParams.CalendarID_In)
+	}
+	if qParams.Name_Like != "" {
+		q = q.Where("name LIKE ?", "%"+qParams.Name_Like+"%")
+	}
+	if qParams.Type != "" {
+		q = q.Where("type = @Type", sql.Named("Type", qParams.Type))
+	}
+	if len(qParams.Type_In) > 0 {
+		q = q.Where("type IN ?", qParams.Type_In)
+	}
+	if qParams.StartDate_Gte != nil {
+		q = q.Where("start_date >= @StartDate_Gte", sql.Named("StartDate_Gte", qParams.StartDate_Gte))
+	}
+	if qParams.StartDate_Lte != nil {
+		q = q.Where("start_date <= @StartDate_Lte", sql.Named("StartDate_Lte", qParams.StartDate_Lte))
+	}
+	if qParams.EndDate_Gte != nil {
+		q = q.Where("end_date >= @EndDate_Gte", sql.Named("EndDate_Gte", qParams.EndDate_Gte))
+	}
+	if qParams.EndDate_Lte != nil {
+		q = q.Where("end_date <= @EndDate_Lte", sql.Named("EndDate_Lte", qParams.EndDate_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count calendar exceptions", "repository", "calendar_exception", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.CalendarExceptionAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&exceptions)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get calendar exceptions", "repository", "calendar_exception", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return exceptions, count, nil
+}
+
+// Update updates a calendar exception and returns it with updated database fields
+func (r *CalendarExceptionRepository) Update(ctx context.Context, exception *entities.CalendarException) (int64, error) {
+	result := r.db.WithContext(ctx).Model(exception).Clauses(clause.Returning{}).Where("id = ?", exception.ID).Select("*").Updates(&exception)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "calendar_exception", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "calendar_exception", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "calendar_exception", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "calendar_exception", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update calendar exception", "repository", "calendar_exception", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a calendar exception by ID
+func (r *CalendarExceptionRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.CalendarException{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete calendar exception", "repository", "calendar_exception", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CostItemRepository is the repository for cost item entities
+type CostItemRepository struct {
+	db *gorm.DB
+}
+
+// NewCostItemRepository creates a new cost item repository
+func NewCostItemRepository(db *gorm.DB) *CostItemRepository {
+	return &CostItemRepository{db: db}
+}
+
+// Create creates a new cost item and returns it with database-generated fields populated
+func (r *CostItemRepository) Create(ctx context.Context, item *entities.CostItem) (*entities.CostItem, error) {
+	err := r.db.WithContext(ctx).Create(item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "cost_item", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "cost_item", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "cost_item", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "cost_item", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "cost_item", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create cost item", "repository", "cost_item", "method", "Create", "error", err)
+		return nil, err
+	}
+	return item, nil
+}
+
+// GetOne gets a cost item by ID
+func (r *CostItemRepository) GetOne(ctx context.Context, id uint) (*entities.CostItem, error) {
+	var item entities.CostItem
+	err := r.db.WithContext(ctx).Model(&entities.CostItem{}).First(&item, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "cost_item", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get cost item", "repository", "cost_item", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &item, err
+}
+
+// GetMany gets multiple cost items by query parameters
+func (r *CostItemRepository) GetMany(ctx context.Context, qParams *entities.CostItemQueryParams) ([]*entities.CostItem, int64, error) {
+	var (
+		items []*entities.CostItem
+		count int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.CostItem{})
+
+	if qParams == nil {
+		qParams = &entities.CostItemQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.Name_Like != "" {
+		q = q.Where("name LIKE ?", "%"+qParams.Name_Like+"%")
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("project_id = @ProjectID", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("project_id IN ?", qParams.ProjectID_In)
+	}
+	if qParams.MilestoneID != nil {
+		q = q.Where("milestone_id = @MilestoneID", sql.Named("MilestoneID", *qParams.MilestoneID))
+	}
+	if qParams.MilestoneID_IsNull != nil {
+		if *qParams.MilestoneID_IsNull {
+			q = q.Where("milestone_id IS NULL")
+		} else {
+			q = q.Where("milestone_id IS NOT NULL")
+		}
+	}
+	if qParams.TaskID != nil {
+		q = q.Where("task_id = @TaskID", sql.Named("TaskID", *qParams.TaskID))
+	}
+	if qParams.TaskID_IsNull != nil {
+		if *qParams.TaskID_IsNull {
+			q = q.Where("task_id IS NULL")
+		} else {
+			q = q.Where("task_id IS NOT NULL")
+		}
+	}
+	if qParams.CostType != "" {
+		q = q.Where("cost_type = @CostType", sql.Named("CostType", qParams.CostType))
+	}
+	if len(qParams.CostType_In) > 0 {
+		q = q.Where("cost_type IN ?", qParams.CostType_In)
+	}
+	if qParams.Frequency != "" {
+		q = q.Where("frequency = @Frequency", sql.Named("Frequency", qParams.Frequency))
+	}
+	if len(qParams.Frequency_In) > 0 {
+		q = q.Where("frequency IN ?", qParams.Frequency_In)
+	}
+	if qParams.StartDate_Gte != nil {
+		q = q.Where("start_date >= @StartDate_Gte", sql.Named("StartDate_Gte", qParams.StartDate_Gte))
+	}
+	if qParams.StartDate_Lte != nil {
+		q = q.Where("start_date <= @StartDate_Lte", sql.Named("StartDate_Lte", qParams.StartDate_Lte))
+	}
+	if qParams.EndDate_Gte != nil {
+		q = q.Where("end_date >= @EndDate_Gte", sql.Named("EndDate_Gte", qParams.EndDate_Gte))
+	}
+	if qParams.EndDate_Lte != nil {
+		q = q.Where("end_date <= @EndDate_Lte", sql.Named("EndDate_Lte", qParams.EndDate_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count cost items", "repository", "cost_item", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.CostItemAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&items)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get cost items", "repository", "cost_item", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return items, count, nil
+}
+
+// Update updates a cost item and returns it with updated database fields
+func (r *CostItemRepository) Update(ctx context.Context, item *entities.CostItem) (int64, error) {
+	result := r.db.WithContext(ctx).Model(item).Clauses(clause.Returning{}).Where("id = ?", item.ID).Select("*").Updates(&item)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "cost_item", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "cost_item", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "cost_item", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "cost_item", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update cost item", "repository", "cost_item", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a cost item by ID
+func (r *CostItemRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.CostItem{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete cost item", "repository", "cost_item", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
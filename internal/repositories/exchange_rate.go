@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ExchangeRateRepository is the repository for exchange rate entities
+type ExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRateRepository creates a new exchange rate repository
+func NewExchangeRateRepository(db *gorm.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
+
+// Create creates a new exchange rate and returns it with database-generated fields populated
+func (r *ExchangeRateRepository) Create(ctx context.Context, rate *entities.ExchangeRate) (*entities.ExchangeRate, error) {
+	err := r.db.WithContext(ctx).Create(rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "exchange_rate", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "exchange_rate", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "exchange_rate", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "exchange_rate", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "exchange_rate", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create exchange rate", "repository", "exchange_rate", "method", "Create", "error", err)
+		return nil, err
+	}
+	return rate, nil
+}
+
+// GetOne gets an exchange rate by ID
+func (r *ExchangeRateRepository) GetOne(ctx context.Context, id uint) (*entities.ExchangeRate, error) {
+	var rate entities.ExchangeRate
+	err := r.db.WithContext(ctx).Model(&entities.ExchangeRate{}).First(&rate, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "exchange_rate", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get exchange rate", "repository", "exchange_rate", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &rate, err
+}
+
+// GetMany gets multiple exchange rates by query parameters
+func (r *ExchangeRateRepository) GetMany(ctx context.Context, qParams *entities.ExchangeRateQueryParams) ([]*entities.ExchangeRate, int64, error) {
+	var (
+		rates []*entities.ExchangeRate
+		count int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.ExchangeRate{})
+
+	if qParams == nil {
+		qParams = &entities.ExchangeRateQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.FromCurrency != "" {
+		q = q.Where("from_currency = @FromCurrency", sql.Named("FromCurrency", qParams.FromCurrency))
+	}
+	if len(qParams.FromCurrency_In) > 0 {
+		q = q.Where("from_currency IN ?", qParams.FromCurrency_In)
+	}
+	if qParams.ToCurrency != "" {
+		q = q.Where("to_currency = @ToCurrency", sql.Named("ToCurrency", qParams.ToCurrency))
+	}
+	if len(qParams.ToCurrency_In) > 0 {
+		q = q.Where("to_currency IN ?", qParams.ToCurrency_In)
+	}
+	if qParams.EffectiveFrom_Gte != nil {
+		q = q.Where("effective_from >= @EffectiveFrom_Gte", sql.Named("EffectiveFrom_Gte", qParams.EffectiveFrom_Gte))
+	}
+	if qParams.EffectiveFrom_Lte != nil {
+		q = q.Where("effective_from <= @EffectiveFrom_Lte", sql.Named("EffectiveFrom_Lte", qParams.EffectiveFrom_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count exchange rates", "repository", "exchange_rate", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.ExchangeRateAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&rates)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get exchange rates", "repository", "exchange_rate", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return rates, count, nil
+}
+
+// Update updates an exchange rate and returns it with updated database fields
+func (r *ExchangeRateRepository) Update(ctx context.Context, rate *entities.ExchangeRate) (int64, error) {
+	result := r.db.WithContext(ctx).Model(rate).Clauses(clause.Returning{}).Where("id = ?", rate.ID).Select("*").Updates(&rate)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "exchange_rate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "exchange_rate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "exchange_rate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "exchange_rate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update exchange rate", "repository", "exchange_rate", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes an exchange rate by ID
+func (r *ExchangeRateRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.ExchangeRate{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete exchange rate", "repository", "exchange_rate", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/ducminhgd/plan-craft/internal"
 	"github.com/ducminhgd/plan-craft/internal/entities"
@@ -84,12 +85,27 @@ func (r *ProjectRepository) GetMany(ctx context.Context, qParams *entities.Proje
 	if qParams.Name != "" {
 		q = q.Where("name = @Name", sql.Named("Name", qParams.Name))
 	}
+	if qParams.Type != "" {
+		q = q.Where("type = @Type", sql.Named("Type", qParams.Type))
+	}
+	if len(qParams.Type_In) > 0 {
+		q = q.Where("type IN ?", qParams.Type_In)
+	}
+	if qParams.Methodology != "" {
+		q = q.Where("methodology = @Methodology", sql.Named("Methodology", qParams.Methodology))
+	}
+	if len(qParams.Methodology_In) > 0 {
+		q = q.Where("methodology IN ?", qParams.Methodology_In)
+	}
 	if qParams.ClientID != 0 {
 		q = q.Where("client_id = @ClientID", sql.Named("ClientID", qParams.ClientID))
 	}
 	if len(qParams.ClientID_In) > 0 {
 		q = q.Where("client_id IN ?", qParams.ClientID_In)
 	}
+	if qParams.CalendarID != 0 {
+		q = q.Where("calendar_id = @CalendarID", sql.Named("CalendarID", qParams.CalendarID))
+	}
 
 	// Group LIKE conditions with OR for search functionality
 	if qParams.Name_Like != "" || qParams.Description_Like != "" {
@@ -194,6 +210,86 @@ func (r *ProjectRepository) Update(ctx context.Context, project *entities.Projec
 	return result.RowsAffected, nil
 }
 
+// projectDateColumns lists the calendar-day columns of the records that belong to a project,
+// with the condition that selects the records of one project
+var projectDateColumns = []struct {
+	table   string
+	columns []string
+	scope   string
+}{
+	{"milestones", []string{"start_date", "end_date"}, "project_id = ?"},
+	{"project_resources", []string{"start_date", "end_date"}, "project_id = ?"},
+	{"tasks", []string{"planned_start", "planned_finish", "constraint_date", "baseline_start", "baseline_finish"}, "project_id = ?"},
+	{"sprints", []string{"start_date", "end_date"}, "project_id = ?"},
+	{"cost_items", []string{"start_date", "end_date"}, "project_id = ?"},
+	{"time_entries", []string{"date"}, "task_id IN (SELECT id FROM tasks WHERE project_id = ?)"},
+	{"scenario_tasks", []string{"constraint_date"}, "scenario_id IN (SELECT id FROM scenarios WHERE project_id = ?)"},
+	{"scenario_milestones", []string{"start_date", "end_date"}, "scenario_id IN (SELECT id FROM scenarios WHERE project_id = ?)"},
+}
+
+// UpdateRebasingDates updates a project and moves the calendar-day dates of its records with rebase,
+// in one transaction. It is used when the project's time zone changes.
+func (r *ProjectRepository) UpdateRebasingDates(ctx context.Context, project *entities.Project, rebase func(time.Time) time.Time) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(project).Clauses(clause.Returning{}).Where("id = ?", project.ID).Select("*").Updates(&project)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		for _, dated := range projectDateColumns {
+			for _, column := range dated.columns {
+				var rows []struct {
+					ID   uint
+					Date time.Time
+				}
+				err := tx.Table(dated.table).Select("id, "+column+" AS date").
+					Where(dated.scope, project.ID).Where(column + " IS NOT NULL").Scan(&rows).Error
+				if err != nil {
+					return err
+				}
+				for _, row := range rows {
+					if err := tx.Table(dated.table).Where("id = ?", row.ID).UpdateColumn(column, rebase(row.Date)).Error; err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "project", "method", "UpdateRebasingDates", "error", err)
+			return 0, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "project", "method", "UpdateRebasingDates", "error", err)
+			return 0, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "project", "method", "UpdateRebasingDates", "error", err)
+			return 0, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update project", "repository", "project", "method", "UpdateRebasingDates", "error", err)
+		return 0, err
+	}
+	return rowsAffected, nil
+}
+
+// UpdateCalendar sets the calendar of the given projects; nil makes them use their own working days
+func (r *ProjectRepository) UpdateCalendar(ctx context.Context, ids []uint, calendarID *uint) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Project{}).Where("id IN ?", ids).UpdateColumn("calendar_id", calendarID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "project", "method", "UpdateCalendar", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		internal.Logger.Error("failed to update calendar", "repository", "project", "method", "UpdateCalendar", "error", err)
+		return result.RowsAffected, err
+	}
+	return result.RowsAffected, nil
+}
+
 // Delete deletes a project by ID
 func (r *ProjectRepository) Delete(ctx context.Context, id uint) error {
 	err := r.db.WithContext(ctx).Delete(&entities.Project{}, id).Error
@@ -111,6 +111,9 @@ func (r *ProjectResourceRepository) GetMany(ctx context.Context, qParams *entiti
 	if qParams.Cost_Lte != nil {
 		q = q.Where("cost <= @Cost_Lte", sql.Named("Cost_Lte", *qParams.Cost_Lte))
 	}
+	if qParams.CostOverride != nil {
+		q = q.Where("cost_override = @CostOverride", sql.Named("CostOverride", *qParams.CostOverride))
+	}
 	if qParams.Status != entities.ProjectResourceStatusUnknown {
 		q = q.Where("status = @Status", sql.Named("Status", qParams.Status))
 	}
@@ -0,0 +1,217 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RateCardRepository is the repository for rate card entities
+type RateCardRepository struct {
+	db *gorm.DB
+}
+
+// NewRateCardRepository creates a new rate card repository
+func NewRateCardRepository(db *gorm.DB) *RateCardRepository {
+	return &RateCardRepository{db: db}
+}
+
+// Create creates a new rate card and returns it with database-generated fields populated
+func (r *RateCardRepository) Create(ctx context.Context, rateCard *entities.RateCard) (*entities.RateCard, error) {
+	err := r.db.WithContext(ctx).Create(rateCard).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "rate_card", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "rate_card", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "rate_card", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "rate_card", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "rate_card", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create rate card", "repository", "rate_card", "method", "Create", "error", err)
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// GetOne gets a rate card by ID
+func (r *RateCardRepository) GetOne(ctx context.Context, id uint) (*entities.RateCard, error) {
+	var rateCard entities.RateCard
+	err := r.db.WithContext(ctx).Model(&entities.RateCard{}).First(&rateCard, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "rate_card", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get rate card", "repository", "rate_card", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &rateCard, err
+}
+
+// GetMany gets multiple rate cards by query parameters
+func (r *RateCardRepository) GetMany(ctx context.Context, qParams *entities.RateCardQueryParams) ([]*entities.RateCard, int64, error) {
+	var (
+		estimates []*entities.RateCard
+		count     int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.RateCard{})
+
+	if qParams == nil {
+		qParams = &entities.RateCardQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.ProjectID != nil {
+		q = q.Where("project_id = @ProjectID", sql.Named("ProjectID", *qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("project_id IN ?", qParams.ProjectID_In)
+	}
+	if qParams.ProjectID_IsNull != nil {
+		if *qParams.ProjectID_IsNull {
+			q = q.Where("project_id IS NULL")
+		} else {
+			q = q.Where("project_id IS NOT NULL")
+		}
+	}
+	if qParams.HumanResourceID != nil {
+		q = q.Where("human_resource_id = @HumanResourceID", sql.Named("HumanResourceID", *qParams.HumanResourceID))
+	}
+	if len(qParams.HumanResourceID_In) > 0 {
+		q = q.Where("human_resource_id IN ?", qParams.HumanResourceID_In)
+	}
+	if qParams.HumanResourceID_IsNull != nil {
+		if *qParams.HumanResourceID_IsNull {
+			q = q.Where("human_resource_id IS NULL")
+		} else {
+			q = q.Where("human_resource_id IS NOT NULL")
+		}
+	}
+	if qParams.RoleName != "" {
+		q = q.Where("role_name = @RoleName COLLATE NOCASE", sql.Named("RoleName", qParams.RoleName))
+	}
+	if qParams.RoleName_Like != "" {
+		q = q.Where("role_name LIKE ?", "%"+qParams.RoleName_Like+"%")
+	}
+	if qParams.RoleLevel != 0 {
+		q = q.Where("role_level = @RoleLevel", sql.Named("RoleLevel", qParams.RoleLevel))
+	}
+	if qParams.RateType != "" {
+		q = q.Where("rate_type = @RateType", sql.Named("RateType", qParams.RateType))
+	}
+	if len(qParams.RateType_In) > 0 {
+		q = q.Where("rate_type IN ?", qParams.RateType_In)
+	}
+	if qParams.Currency != "" {
+		q = q.Where("currency = @Currency", sql.Named("Currency", qParams.Currency))
+	}
+	if qParams.EffectiveFrom_Gte != nil {
+		q = q.Where("effective_from >= @EffectiveFrom_Gte", sql.Named("EffectiveFrom_Gte", qParams.EffectiveFrom_Gte))
+	}
+	if qParams.EffectiveFrom_Lte != nil {
+		q = q.Where("effective_from <= @EffectiveFrom_Lte", sql.Named("EffectiveFrom_Lte", qParams.EffectiveFrom_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count rate cards", "repository", "rate_card", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.RateCardAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&estimates)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get rate cards", "repository", "rate_card", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return estimates, count, nil
+}
+
+// Update updates a rate card and returns it with updated database fields
+func (r *RateCardRepository) Update(ctx context.Context, rateCard *entities.RateCard) (int64, error) {
+	result := r.db.WithContext(ctx).Model(rateCard).Clauses(clause.Returning{}).Where("id = ?", rateCard.ID).Select("*").Updates(&rateCard)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "rate_card", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "rate_card", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "rate_card", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "rate_card", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update rate card", "repository", "rate_card", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a rate card by ID
+func (r *RateCardRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.RateCard{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete rate card", "repository", "rate_card", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ScenarioRepository is the repository for scenario entities and their forked rows
+type ScenarioRepository struct {
+	db *gorm.DB
+}
+
+// NewScenarioRepository creates a new scenario repository
+func NewScenarioRepository(db *gorm.DB) *ScenarioRepository {
+	return &ScenarioRepository{db: db}
+}
+
+// Create creates a new scenario and forks the live plan of its project into it in a single
+// transaction: every task, active resource allocation, task assignment and active milestone.
+func (r *ScenarioRepository) Create(ctx context.Context, scenario *entities.Scenario) (*entities.Scenario, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(scenario).Error; err != nil {
+			return err
+		}
+
+		var tasks []*entities.Task
+		if err := tx.Where("project_id = ?", scenario.ProjectID).Find(&tasks).Error; err != nil {
+			return err
+		}
+		scenario.Tasks = make([]*entities.ScenarioTask, 0, len(tasks))
+		for _, t := range tasks {
+			scenario.Tasks = append(scenario.Tasks, entities.NewScenarioTask(scenario.ID, t))
+		}
+
+		var resources []*entities.ProjectResource
+		if err := tx.Where("project_id = ? AND status = ?", scenario.ProjectID, entities.ProjectResourceStatusActive).Find(&resources).Error; err != nil {
+			return err
+		}
+		scenario.Resources = make([]*entities.ScenarioResource, 0, len(resources))
+		for _, pr := range resources {
+			scenario.Resources = append(scenario.Resources, entities.NewScenarioResource(scenario.ID, pr))
+		}
+
+		var assignments []*entities.TaskAssignment
+		if err := tx.Where("task_id IN (SELECT id FROM tasks WHERE project_id = ?)", scenario.ProjectID).Find(&assignments).Error; err != nil {
+			return err
+		}
+		scenario.Assignments = make([]*entities.ScenarioAssignment, 0, len(assignments))
+		for _, a := range assignments {
+			scenario.Assignments = append(scenario.Assignments, entities.NewScenarioAssignment(scenario.ID, a))
+		}
+
+		var milestones []*entities.Milestone
+		if err := tx.Where("project_id = ? AND status = ?", scenario.ProjectID, entities.MilestoneStatusActive).Find(&milestones).Error; err != nil {
+			return err
+		}
+		scenario.Milestones = make([]*entities.ScenarioMilestone, 0, len(milestones))
+		for _, m := range milestones {
+			scenario.Milestones = append(scenario.Milestones, entities.NewScenarioMilestone(scenario.ID, m))
+		}
+
+		for _, rows := range []any{&scenario.Tasks, &scenario.Resources, &scenario.Assignments, &scenario.Milestones} {
+			if err := tx.Omit(clause.Associations).CreateInBatches(rows, 100).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "scenario", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "scenario", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "scenario", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "scenario", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create scenario", "repository", "scenario", "method", "Create", "error", err)
+		return nil, err
+	}
+	return scenario, nil
+}
+
+// GetOne gets a scenario by ID together with its forked rows
+func (r *ScenarioRepository) GetOne(ctx context.Context, id uint) (*entities.Scenario, error) {
+	var scenario entities.Scenario
+	err := r.db.WithContext(ctx).Model(&entities.Scenario{}).
+		Preload("Tasks").Preload("Resources").Preload("Assignments").Preload("Milestones").
+		First(&scenario, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "scenario", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get scenario", "repository", "scenario", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &scenario, err
+}
+
+// GetMany gets multiple scenarios by query parameters, without their forked rows
+func (r *ScenarioRepository) GetMany(ctx context.Context, qParams *entities.ScenarioQueryParams) ([]*entities.Scenario, int64, error) {
+	var (
+		scenarios []*entities.Scenario
+		count     int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.Scenario{})
+
+	if qParams == nil {
+		qParams = &entities.ScenarioQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("project_id = @ProjectID", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("project_id IN ?", qParams.ProjectID_In)
+	}
+	if qParams.Name_Like != "" {
+		q = q.Where("name LIKE ?", "%"+qParams.Name_Like+"%")
+	}
+	if qParams.Status != 0 {
+		q = q.Where("status = @Status", sql.Named("Status", qParams.Status))
+	}
+	if len(qParams.Status_In) > 0 {
+		q = q.Where("status IN ?", qParams.Status_In)
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count scenarios", "repository", "scenario", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.ScenarioAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&scenarios)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get scenarios", "repository", "scenario", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return scenarios, count, nil
+}
+
+// Update updates the name and description of a scenario
+func (r *ScenarioRepository) Update(ctx context.Context, scenario *entities.Scenario) (int64, error) {
+	return r.updateRow(ctx, "Update", scenario, r.db.Where("id = ?", scenario.ID), "name", "description")
+}
+
+// UpdateTask updates the editable values of a scenario task
+func (r *ScenarioRepository) UpdateTask(ctx context.Context, task *entities.ScenarioTask) (int64, error) {
+	return r.updateRow(ctx, "UpdateTask", task, r.db.Where("id = ? AND scenario_id = ?", task.ID, task.ScenarioID),
+		"milestone_id", "estimated_effort", "duration", "constraint_type", "constraint_date")
+}
+
+// UpdateResource updates the editable values of a scenario resource
+func (r *ScenarioRepository) UpdateResource(ctx context.Context, resource *entities.ScenarioResource) (int64, error) {
+	return r.updateRow(ctx, "UpdateResource", resource, r.db.Where("id = ? AND scenario_id = ?", resource.ID, resource.ScenarioID),
+		"allocation", "cost", "headcount")
+}
+
+// UpdateAssignment updates the editable values of a scenario assignment
+func (r *ScenarioRepository) UpdateAssignment(ctx context.Context, assignment *entities.ScenarioAssignment) (int64, error) {
+	return r.updateRow(ctx, "UpdateAssignment", assignment, r.db.Where("id = ? AND scenario_id = ?", assignment.ID, assignment.ScenarioID),
+		"planned_hours", "units")
+}
+
+// UpdateMilestone updates the dates of a scenario milestone
+func (r *ScenarioRepository) UpdateMilestone(ctx context.Context, milestone *entities.ScenarioMilestone) (int64, error) {
+	return r.updateRow(ctx, "UpdateMilestone", milestone, r.db.Where("id = ? AND scenario_id = ?", milestone.ID, milestone.ScenarioID),
+		"start_date", "end_date")
+}
+
+// updateRow updates the given columns of a scenario or one of its rows
+func (r *ScenarioRepository) updateRow(ctx context.Context, method string, row any, where *gorm.DB, columns ...string) (int64, error) {
+	result := r.db.WithContext(ctx).Model(row).Where(where).Select(append(columns, "updated_at")).Updates(row)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "scenario", "method", method, "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "scenario", "method", method, "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "scenario", "method", method, "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "scenario", "method", method, "error", err)
+			return result.RowsAffected, entities.ErrDuplicatedKey
+		}
+		internal.Logger.Error("failed to update scenario", "repository", "scenario", "method", method, "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a scenario and its forked rows by ID
+func (r *ScenarioRepository) Delete(ctx context.Context, id uint) error {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, row := range []any{&entities.ScenarioTask{}, &entities.ScenarioResource{}, &entities.ScenarioAssignment{}, &entities.ScenarioMilestone{}} {
+			if err := tx.Where("scenario_id = ?", id).Delete(row).Error; err != nil {
+				return err
+			}
+		}
+		result := tx.Delete(&entities.Scenario{}, id)
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "scenario", "method", "Delete", "error", err)
+			return entities.ErrForeignKeyViolated
+		}
+		internal.Logger.Error("failed to delete scenario", "repository", "scenario", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if rowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Promote writes the forked rows of a scenario back to the live plan and marks the scenario
+// promoted in a single transaction. Allocations with no headcount left become inactive.
+func (r *ScenarioRepository) Promote(ctx context.Context, scenario *entities.Scenario, promotedAt time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, t := range scenario.Tasks {
+			err := tx.Model(&entities.Task{}).Where("id = ?", t.TaskID).UpdateColumns(map[string]any{
+				"milestone_id":     t.MilestoneID,
+				"estimated_effort": t.EstimatedEffort,
+				"duration":         t.Duration,
+				"constraint_type":  t.ConstraintType,
+				"constraint_date":  t.ConstraintDate,
+				"updated_at":       promotedAt,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, res := range scenario.Resources {
+			columns := map[string]any{"allocation": res.Allocation, "cost": res.Cost, "updated_at": promotedAt}
+			if res.Headcount == 0 {
+				columns = map[string]any{"status": entities.ProjectResourceStatusInactive, "updated_at": promotedAt}
+			}
+			if err := tx.Model(&entities.ProjectResource{}).Where("id = ?", res.ProjectResourceID).UpdateColumns(columns).Error; err != nil {
+				return err
+			}
+		}
+		for _, a := range scenario.Assignments {
+			err := tx.Model(&entities.TaskAssignment{}).Where("id = ?", a.TaskAssignmentID).UpdateColumns(map[string]any{
+				"planned_hours": a.PlannedHours,
+				"units":         a.Units,
+				"updated_at":    promotedAt,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, m := range scenario.Milestones {
+			err := tx.Model(&entities.Milestone{}).Where("id = ?", m.MilestoneID).UpdateColumns(map[string]any{
+				"start_date": m.StartDate,
+				"end_date":   m.EndDate,
+				"updated_at": promotedAt,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&entities.Scenario{}).Where("id = ?", scenario.ID).UpdateColumns(map[string]any{
+			"status":      entities.ScenarioStatusPromoted,
+			"promoted_at": promotedAt,
+			"updated_at":  promotedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		internal.Logger.Error("failed to promote scenario", "repository", "scenario", "method", "Promote", "error", err)
+		return err
+	}
+	scenario.Status = entities.ScenarioStatusPromoted
+	scenario.PromotedAt = &promotedAt
+	return nil
+}
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SizeMappingRepository is the repository for size mapping entities
+type SizeMappingRepository struct {
+	db *gorm.DB
+}
+
+// NewSizeMappingRepository creates a new size mapping repository
+func NewSizeMappingRepository(db *gorm.DB) *SizeMappingRepository {
+	return &SizeMappingRepository{db: db}
+}
+
+// Create creates a new size mapping and returns it with database-generated fields populated
+func (r *SizeMappingRepository) Create(ctx context.Context, mapping *entities.SizeMapping) (*entities.SizeMapping, error) {
+	err := r.db.WithContext(ctx).Create(mapping).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "size_mapping", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "size_mapping", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "size_mapping", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "size_mapping", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "size_mapping", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create size mapping", "repository", "size_mapping", "method", "Create", "error", err)
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// GetOne gets a size mapping by ID
+func (r *SizeMappingRepository) GetOne(ctx context.Context, id uint) (*entities.SizeMapping, error) {
+	var mapping entities.SizeMapping
+	err := r.db.WithContext(ctx).Model(&entities.SizeMapping{}).First(&mapping, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "size_mapping", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get size mapping", "repository", "size_mapping", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &mapping, err
+}
+
+// GetMany gets multiple size mappings by query parameters
+func (r *SizeMappingRepository) GetMany(ctx context.Context, qParams *entities.SizeMappingQueryParams) ([]*entities.SizeMapping, int64, error) {
+	var (
+		mappings []*entities.SizeMapping
+		count    int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.SizeMapping{})
+
+	if qParams == nil {
+		qParams = &entities.SizeMappingQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("project_id = @ProjectID", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("project_id IN ?", qParams.ProjectID_In)
+	}
+	if qParams.ProjectRoleID != 0 {
+		q = q.Where("project_role_id = @ProjectRoleID", sql.Named("ProjectRoleID", qParams.ProjectRoleID))
+	}
+	if len(qParams.ProjectRoleID_In) > 0 {
+		q = q.Where("project_role_id IN ?", qParams.ProjectRoleID_In)
+	}
+	if qParams.Unit != "" {
+		q = q.Where("unit = @Unit", sql.Named("Unit", qParams.Unit))
+	}
+	if len(qParams.Unit_In) > 0 {
+		q = q.Where("unit IN ?", qParams.Unit_In)
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count size mappings", "repository", "size_mapping", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.SizeMappingAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&mappings)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get size mappings", "repository", "size_mapping", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return mappings, count, nil
+}
+
+// Update updates a size mapping and returns it with updated database fields
+func (r *SizeMappingRepository) Update(ctx context.Context, mapping *entities.SizeMapping) (int64, error) {
+	result := r.db.WithContext(ctx).Model(mapping).Clauses(clause.Returning{}).Where("id = ?", mapping.ID).Select("*").Updates(&mapping)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "size_mapping", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "size_mapping", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "size_mapping", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "size_mapping", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update size mapping", "repository", "size_mapping", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a size mapping by ID
+func (r *SizeMappingRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.SizeMapping{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete size mapping", "repository", "size_mapping", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SprintRepository is the repository for sprint entities
+type SprintRepository struct {
+	db *gorm.DB
+}
+
+// NewSprintRepository creates a new sprint repository
+func NewSprintRepository(db *gorm.DB) *SprintRepository {
+	return &SprintRepository{db: db}
+}
+
+// Create creates a new sprint and returns it with database-generated fields populated
+func (r *SprintRepository) Create(ctx context.Context, sprint *entities.Sprint) (*entities.Sprint, error) {
+	err := r.db.WithContext(ctx).Create(sprint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "sprint", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "sprint", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "sprint", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "sprint", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "sprint", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create sprint", "repository", "sprint", "method", "Create", "error", err)
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// GetOne gets a sprint by ID
+func (r *SprintRepository) GetOne(ctx context.Context, id uint) (*entities.Sprint, error) {
+	var sprint entities.Sprint
+	err := r.db.WithContext(ctx).Model(&entities.Sprint{}).First(&sprint, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "sprint", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get sprint", "repository", "sprint", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &sprint, err
+}
+
+// GetMany gets multiple sprints by query parameters
+func (r *SprintRepository) GetMany(ctx context.Context, qParams *entities.SprintQueryParams) ([]*entities.Sprint, int64, error) {
+	var (
+		sprints []*entities.Sprint
+		count   int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.Sprint{})
+
+	if qParams == nil {
+		qParams = &entities.SprintQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.Name != "" {
+		q = q.Where("name = @Name", sql.Named("Name", qParams.Name))
+	}
+	if qParams.Name_Like != "" {
+		q = q.Where("name LIKE ?", "%"+qParams.Name_Like+"%")
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("project_id = @ProjectID", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("project_id IN ?", qParams.ProjectID_In)
+	}
+	if qParams.Status != entities.SprintStatusUnknown {
+		q = q.Where("status = @Status", sql.Named("Status", qParams.Status))
+	}
+	if len(qParams.Status_In) > 0 {
+		q = q.Where("status IN ?", qParams.Status_In)
+	}
+	if qParams.StartDate_Gte != nil {
+		q = q.Where("start_date >= @StartDate_Gte", sql.Named("StartDate_Gte", qParams.StartDate_Gte))
+	}
+	if qParams.StartDate_Lte != nil {
+		q = q.Where("start_date <= @StartDate_Lte", sql.Named("StartDate_Lte", qParams.StartDate_Lte))
+	}
+	if qParams.EndDate_Gte != nil {
+		q = q.Where("end_date >= @EndDate_Gte", sql.Named("EndDate_Gte", qParams.EndDate_Gte))
+	}
+	if qParams.EndDate_Lte != nil {
+		q = q.Where("end_date <= @EndDate_Lte", sql.Named("EndDate_Lte", qParams.EndDate_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count sprints", "repository", "sprint", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.SprintAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&sprints)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get sprints", "repository", "sprint", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return sprints, count, nil
+}
+
+// Update updates a sprint and returns it with updated database fields
+func (r *SprintRepository) Update(ctx context.Context, sprint *entities.Sprint) (int64, error) {
+	result := r.db.WithContext(ctx).Model(sprint).Clauses(clause.Returning{}).Where("id = ?", sprint.ID).Select("*").Updates(&sprint)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "sprint", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "sprint", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "sprint", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "sprint", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update sprint", "repository", "sprint", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a sprint by ID
+func (r *SprintRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Sprint{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete sprint", "repository", "sprint", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
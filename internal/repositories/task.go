@@ -112,6 +112,19 @@ func (r *TaskRepository) GetMany(ctx context.Context, qParams *entities.TaskQuer
 			q = q.Where("milestone_id IS NOT NULL")
 		}
 	}
+	if qParams.SprintID != nil {
+		q = q.Where("sprint_id = @SprintID", sql.Named("SprintID", *qParams.SprintID))
+	}
+	if len(qParams.SprintID_In) > 0 {
+		q = q.Where("sprint_id IN ?", qParams.SprintID_In)
+	}
+	if qParams.SprintID_IsNull != nil {
+		if *qParams.SprintID_IsNull {
+			q = q.Where("sprint_id IS NULL")
+		} else {
+			q = q.Where("sprint_id IS NOT NULL")
+		}
+	}
 	if qParams.ParentID != nil {
 		q = q.Where("parent_id = @ParentID", sql.Named("ParentID", *qParams.ParentID))
 	}
@@ -158,6 +171,70 @@ func (r *TaskRepository) GetMany(ctx context.Context, qParams *entities.TaskQuer
 	if qParams.EstimatedEffort_Lte != nil {
 		q = q.Where("estimated_effort <= @EstimatedEffort_Lte", sql.Named("EstimatedEffort_Lte", *qParams.EstimatedEffort_Lte))
 	}
+	if qParams.StoryPoints_Gte != nil {
+		q = q.Where("story_points >= @StoryPoints_Gte", sql.Named("StoryPoints_Gte", *qParams.StoryPoints_Gte))
+	}
+	if qParams.StoryPoints_Lte != nil {
+		q = q.Where("story_points <= @StoryPoints_Lte", sql.Named("StoryPoints_Lte", *qParams.StoryPoints_Lte))
+	}
+	if qParams.Size != "" {
+		q = q.Where("size = @Size", sql.Named("Size", qParams.Size))
+	}
+	if len(qParams.Size_In) > 0 {
+		q = q.Where("size IN ?", qParams.Size_In)
+	}
+	if qParams.EffortOverride != nil {
+		q = q.Where("effort_override = @EffortOverride", sql.Named("EffortOverride", *qParams.EffortOverride))
+	}
+	if qParams.EffortDistribution != 0 {
+		q = q.Where("effort_distribution = @EffortDistribution", sql.Named("EffortDistribution", qParams.EffortDistribution))
+	}
+	if len(qParams.EffortDistribution_In) > 0 {
+		q = q.Where("effort_distribution IN ?", qParams.EffortDistribution_In)
+	}
+	if qParams.HasThreePointEstimate != nil {
+		if *qParams.HasThreePointEstimate {
+			q = q.Where("pessimistic_effort > 0")
+		} else {
+			q = q.Where("pessimistic_effort = 0")
+		}
+	}
+	if qParams.PercentComplete_Gte != nil {
+		q = q.Where("percent_complete >= @PercentComplete_Gte", sql.Named("PercentComplete_Gte", *qParams.PercentComplete_Gte))
+	}
+	if qParams.PercentComplete_Lte != nil {
+		q = q.Where("percent_complete <= @PercentComplete_Lte", sql.Named("PercentComplete_Lte", *qParams.PercentComplete_Lte))
+	}
+	if qParams.PlannedStart_Gte != nil {
+		q = q.Where("planned_start >= @PlannedStart_Gte", sql.Named("PlannedStart_Gte", qParams.PlannedStart_Gte))
+	}
+	if qParams.PlannedStart_Lte != nil {
+		q = q.Where("planned_start <= @PlannedStart_Lte", sql.Named("PlannedStart_Lte", qParams.PlannedStart_Lte))
+	}
+	if qParams.PlannedFinish_Gte != nil {
+		q = q.Where("planned_finish >= @PlannedFinish_Gte", sql.Named("PlannedFinish_Gte", qParams.PlannedFinish_Gte))
+	}
+	if qParams.PlannedFinish_Lte != nil {
+		q = q.Where("planned_finish <= @PlannedFinish_Lte", sql.Named("PlannedFinish_Lte", qParams.PlannedFinish_Lte))
+	}
+	if qParams.Duration_Gte != nil {
+		q = q.Where("duration >= @Duration_Gte", sql.Named("Duration_Gte", *qParams.Duration_Gte))
+	}
+	if qParams.Duration_Lte != nil {
+		q = q.Where("duration <= @Duration_Lte", sql.Named("Duration_Lte", *qParams.Duration_Lte))
+	}
+	if qParams.ConstraintType != entities.TaskConstraintUnknown {
+		q = q.Where("constraint_type = @ConstraintType", sql.Named("ConstraintType", qParams.ConstraintType))
+	}
+	if len(qParams.ConstraintType_In) > 0 {
+		q = q.Where("constraint_type IN ?", qParams.ConstraintType_In)
+	}
+	if qParams.ConstraintDate_Gte != nil {
+		q = q.Where("constraint_date >= @ConstraintDate_Gte", sql.Named("ConstraintDate_Gte", qParams.ConstraintDate_Gte))
+	}
+	if qParams.ConstraintDate_Lte != nil {
+		q = q.Where("constraint_date <= @ConstraintDate_Lte", sql.Named("ConstraintDate_Lte", qParams.ConstraintDate_Lte))
+	}
 	if qParams.CreatedAt_Gte != nil {
 		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
 	}
@@ -201,7 +278,7 @@ func (r *TaskRepository) GetMany(ctx context.Context, qParams *entities.TaskQuer
 
 // Update updates a task and returns it with updated database fields
 func (r *TaskRepository) Update(ctx context.Context, task *entities.Task) (int64, error) {
-	result := r.db.WithContext(ctx).Model(task).Clauses(clause.Returning{}).Where("id = ?", task.ID).Select("*").Updates(&task)
+	result := r.db.WithContext(ctx).Model(task).Clauses(clause.Returning{}).Where("id = ?", task.ID).Select("*").Omit("leveling_delay", "baseline_start", "baseline_finish", "baseline_effort", "baseline_cost", "baselined_at").Updates(&task)
 	if err := result.Error; err != nil {
 		if errors.Is(err, gorm.ErrInvalidData) {
 			internal.Logger.Error("invalid data", "repository", "task", "method", "Update", "error", err)
@@ -246,3 +323,87 @@ func (r *TaskRepository) Delete(ctx context.Context, id uint) error {
 	}
 	return nil
 }
+
+// UpdateLevelingDelays sets the leveling delay of each task in the map in a single transaction
+func (r *TaskRepository) UpdateLevelingDelays(ctx context.Context, delays map[uint]float64) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, delay := range delays {
+			result := tx.Model(&entities.Task{}).Where("id = ?", id).UpdateColumn("leveling_delay", delay)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return entities.ErrRecordNotFound
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		internal.Logger.Error("failed to update leveling delays", "repository", "task", "method", "UpdateLevelingDelays", "error", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateLevels sets the level of each task in the map in a single transaction
+func (r *TaskRepository) UpdateLevels(ctx context.Context, levels map[uint]int) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, level := range levels {
+			result := tx.Model(&entities.Task{}).Where("id = ?", id).UpdateColumn("level", level)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return entities.ErrRecordNotFound
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		internal.Logger.Error("failed to update levels", "repository", "task", "method", "UpdateLevels", "error", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateBaselines sets the baseline of each task in the map in a single transaction
+func (r *TaskRepository) UpdateBaselines(ctx context.Context, baselines map[uint]*entities.TaskBaseline) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, baseline := range baselines {
+			result := tx.Model(&entities.Task{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+				"baseline_start":  baseline.Start,
+				"baseline_finish": baseline.Finish,
+				"baseline_effort": baseline.Effort,
+				"baseline_cost":   baseline.Cost,
+				"baselined_at":    baseline.SetAt,
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return entities.ErrRecordNotFound
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		internal.Logger.Error("failed to update baselines", "repository", "task", "method", "UpdateBaselines", "error", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateSprint moves the given tasks into a sprint, or back to the backlog when sprintID is nil,
+// and returns the number of tasks moved
+func (r *TaskRepository) UpdateSprint(ctx context.Context, ids []uint, sprintID *uint) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Task{}).Where("id IN ?", ids).UpdateColumn("sprint_id", sprintID)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task", "method", "UpdateSprint", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		internal.Logger.Error("failed to update sprint", "repository", "task", "method", "UpdateSprint", "error", err)
+		return result.RowsAffected, err
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TaskAssignmentRepository is the repository for task assignment entities
+type TaskAssignmentRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskAssignmentRepository creates a new task assignment repository
+func NewTaskAssignmentRepository(db *gorm.DB) *TaskAssignmentRepository {
+	return &TaskAssignmentRepository{db: db}
+}
+
+// Create creates a new task assignment and returns it with database-generated fields populated
+func (r *TaskAssignmentRepository) Create(ctx context.Context, assignment *entities.TaskAssignment) (*entities.TaskAssignment, error) {
+	err := r.db.WithContext(ctx).Create(assignment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "task_assignment", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "task_assignment", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "task_assignment", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_assignment", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "task_assignment", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create task assignment", "repository", "task_assignment", "method", "Create", "error", err)
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// GetOne gets a task assignment by ID
+func (r *TaskAssignmentRepository) GetOne(ctx context.Context, id uint) (*entities.TaskAssignment, error) {
+	var assignment entities.TaskAssignment
+	err := r.db.WithContext(ctx).Model(&entities.TaskAssignment{}).First(&assignment, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "task_assignment", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get task assignment", "repository", "task_assignment", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &assignment, err
+}
+
+// GetMany gets multiple task assignments by query parameters
+func (r *TaskAssignmentRepository) GetMany(ctx context.Context, qParams *entities.TaskAssignmentQueryParams) ([]*entities.TaskAssignment, int64, error) {
+	var (
+		assignments []*entities.TaskAssignment
+		count       int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.TaskAssignment{})
+
+	if qParams == nil {
+		qParams = &entities.TaskAssignmentQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.TaskID != 0 {
+		q = q.Where("task_id = @TaskID", sql.Named("TaskID", qParams.TaskID))
+	}
+	if len(qParams.TaskID_In) > 0 {
+		q = q.Where("task_id IN ?", qParams.TaskID_In)
+	}
+	if qParams.ProjectResourceID != 0 {
+		q = q.Where("project_resource_id = @ProjectResourceID", sql.Named("ProjectResourceID", qParams.ProjectResourceID))
+	}
+	if len(qParams.ProjectResourceID_In) > 0 {
+		q = q.Where("project_resource_id IN ?", qParams.ProjectResourceID_In)
+	}
+	if qParams.HumanResourceID != 0 {
+		q = q.Where("human_resource_id = @HumanResourceID", sql.Named("HumanResourceID", qParams.HumanResourceID))
+	}
+	if len(qParams.HumanResourceID_In) > 0 {
+		q = q.Where("human_resource_id IN ?", qParams.HumanResourceID_In)
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("task_id IN (SELECT id FROM tasks WHERE project_id = @ProjectID)", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("task_id IN (SELECT id FROM tasks WHERE project_id IN ?)", qParams.ProjectID_In)
+	}
+	if qParams.PlannedHours_Gte != nil {
+		q = q.Where("planned_hours >= @PlannedHours_Gte", sql.Named("PlannedHours_Gte", *qParams.PlannedHours_Gte))
+	}
+	if qParams.PlannedHours_Lte != nil {
+		q = q.Where("planned_hours <= @PlannedHours_Lte", sql.Named("PlannedHours_Lte", *qParams.PlannedHours_Lte))
+	}
+	if qParams.Units_Gte != nil {
+		q = q.Where("units >= @Units_Gte", sql.Named("Units_Gte", *qParams.Units_Gte))
+	}
+	if qParams.Units_Lte != nil {
+		q = q.Where("units <= @Units_Lte", sql.Named("Units_Lte", *qParams.Units_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count task assignments", "repository", "task_assignment", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.TaskAssignmentAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&assignments)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get task assignments", "repository", "task_assignment", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return assignments, count, nil
+}
+
+// Update updates a task assignment and returns it with updated database fields
+func (r *TaskAssignmentRepository) Update(ctx context.Context, assignment *entities.TaskAssignment) (int64, error) {
+	result := r.db.WithContext(ctx).Model(assignment).Clauses(clause.Returning{}).Where("id = ?", assignment.ID).Select("*").Updates(&assignment)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "task_assignment", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "task_assignment", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_assignment", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "task_assignment", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update task assignment", "repository", "task_assignment", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a task assignment by ID
+func (r *TaskAssignmentRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.TaskAssignment{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete task assignment", "repository", "task_assignment", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TaskDependencyRepository is the repository for task dependency entities
+type TaskDependencyRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskDependencyRepository creates a new task dependency repository
+func NewTaskDependencyRepository(db *gorm.DB) *TaskDependencyRepository {
+	return &TaskDependencyRepository{db: db}
+}
+
+// Create creates a new task dependency and returns it with database-generated fields populated
+func (r *TaskDependencyRepository) Create(ctx context.Context, dependency *entities.TaskDependency) (*entities.TaskDependency, error) {
+	err := r.db.WithContext(ctx).Create(dependency).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "task_dependency", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "task_dependency", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "task_dependency", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_dependency", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "task_dependency", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create task dependency", "repository", "task_dependency", "method", "Create", "error", err)
+		return nil, err
+	}
+	return dependency, nil
+}
+
+// GetOne gets a task dependency by ID
+func (r *TaskDependencyRepository) GetOne(ctx context.Context, id uint) (*entities.TaskDependency, error) {
+	var dependency entities.TaskDependency
+	err := r.db.WithContext(ctx).Model(&entities.TaskDependency{}).First(&dependency, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "task_dependency", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get task dependency", "repository", "task_dependency", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &dependency, err
+}
+
+// GetMany gets multiple task dependencies by query parameters
+func (r *TaskDependencyRepository) GetMany(ctx context.Context, qParams *entities.TaskDependencyQueryParams) ([]*entities.TaskDependency, int64, error) {
+	var (
+		dependencies []*entities.TaskDependency
+		count        int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.TaskDependency{})
+
+	if qParams == nil {
+		qParams = &entities.TaskDependencyQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.PredecessorID != 0 {
+		q = q.Where("predecessor_id = @PredecessorID", sql.Named("PredecessorID", qParams.PredecessorID))
+	}
+	if len(qParams.PredecessorID_In) > 0 {
+		q = q.Where("predecessor_id IN ?", qParams.PredecessorID_In)
+	}
+	if qParams.SuccessorID != 0 {
+		q = q.Where("successor_id = @SuccessorID", sql.Named("SuccessorID", qParams.SuccessorID))
+	}
+	if len(qParams.SuccessorID_In) > 0 {
+		q = q.Where("successor_id IN ?", qParams.SuccessorID_In)
+	}
+	if qParams.TaskID != 0 {
+		q = q.Where("predecessor_id = @TaskID OR successor_id = @TaskID", sql.Named("TaskID", qParams.TaskID))
+	}
+	if qParams.ProjectID != 0 {
+		projectTasks := r.db.Model(&entities.Task{}).Select("id").Where("project_id = ?", qParams.ProjectID)
+		q = q.Where(r.db.Where("predecessor_id IN (?)", projectTasks).Or("successor_id IN (?)", projectTasks))
+	}
+	if qParams.Type != "" {
+		q = q.Where("type = @Type", sql.Named("Type", qParams.Type))
+	}
+	if len(qParams.Type_In) > 0 {
+		q = q.Where("type IN ?", qParams.Type_In)
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count task dependencies", "repository", "task_dependency", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.TaskDependencyAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&dependencies)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get task dependencies", "repository", "task_dependency", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return dependencies, count, nil
+}
+
+// Update updates a task dependency and returns it with updated database fields
+func (r *TaskDependencyRepository) Update(ctx context.Context, dependency *entities.TaskDependency) (int64, error) {
+	result := r.db.WithContext(ctx).Model(dependency).Clauses(clause.Returning{}).Where("id = ?", dependency.ID).Select("*").Updates(&dependency)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "task_dependency", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "task_dependency", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_dependency", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "task_dependency", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "task_dependency", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrDuplicatedKey
+		}
+		internal.Logger.Error("failed to update task dependency", "repository", "task_dependency", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a task dependency by ID
+func (r *TaskDependencyRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.TaskDependency{}, id)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_dependency", "method", "Delete", "error", err)
+			return entities.ErrForeignKeyViolated
+		}
+		internal.Logger.Error("failed to delete task dependency", "repository", "task_dependency", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
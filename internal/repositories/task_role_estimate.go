@@ -0,0 +1,191 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TaskRoleEstimateRepository is the repository for task role estimate entities
+type TaskRoleEstimateRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskRoleEstimateRepository creates a new task role estimate repository
+func NewTaskRoleEstimateRepository(db *gorm.DB) *TaskRoleEstimateRepository {
+	return &TaskRoleEstimateRepository{db: db}
+}
+
+// Create creates a new task role estimate and returns it with database-generated fields populated
+func (r *TaskRoleEstimateRepository) Create(ctx context.Context, estimate *entities.TaskRoleEstimate) (*entities.TaskRoleEstimate, error) {
+	err := r.db.WithContext(ctx).Create(estimate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "task_role_estimate", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "task_role_estimate", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "task_role_estimate", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_role_estimate", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "task_role_estimate", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create task role estimate", "repository", "task_role_estimate", "method", "Create", "error", err)
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// GetOne gets a task role estimate by ID
+func (r *TaskRoleEstimateRepository) GetOne(ctx context.Context, id uint) (*entities.TaskRoleEstimate, error) {
+	var estimate entities.TaskRoleEstimate
+	err := r.db.WithContext(ctx).Model(&entities.TaskRoleEstimate{}).First(&estimate, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "task_role_estimate", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get task role estimate", "repository", "task_role_estimate", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &estimate, err
+}
+
+// GetMany gets multiple task role estimates by query parameters
+func (r *TaskRoleEstimateRepository) GetMany(ctx context.Context, qParams *entities.TaskRoleEstimateQueryParams) ([]*entities.TaskRoleEstimate, int64, error) {
+	var (
+		estimates []*entities.TaskRoleEstimate
+		count     int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.TaskRoleEstimate{})
+
+	if qParams == nil {
+		qParams = &entities.TaskRoleEstimateQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.TaskID != 0 {
+		q = q.Where("task_id = @TaskID", sql.Named("TaskID", qParams.TaskID))
+	}
+	if len(qParams.TaskID_In) > 0 {
+		q = q.Where("task_id IN ?", qParams.TaskID_In)
+	}
+	if qParams.ProjectRoleID != 0 {
+		q = q.Where("project_role_id = @ProjectRoleID", sql.Named("ProjectRoleID", qParams.ProjectRoleID))
+	}
+	if len(qParams.ProjectRoleID_In) > 0 {
+		q = q.Where("project_role_id IN ?", qParams.ProjectRoleID_In)
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("task_id IN (SELECT id FROM tasks WHERE project_id = @ProjectID)", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("task_id IN (SELECT id FROM tasks WHERE project_id IN ?)", qParams.ProjectID_In)
+	}
+	if qParams.Effort_Gte != nil {
+		q = q.Where("effort >= @Effort_Gte", sql.Named("Effort_Gte", *qParams.Effort_Gte))
+	}
+	if qParams.Effort_Lte != nil {
+		q = q.Where("effort <= @Effort_Lte", sql.Named("Effort_Lte", *qParams.Effort_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count task role estimates", "repository", "task_role_estimate", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.TaskRoleEstimateAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&estimates)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get task role estimates", "repository", "task_role_estimate", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return estimates, count, nil
+}
+
+// Update updates a task role estimate and returns it with updated database fields
+func (r *TaskRoleEstimateRepository) Update(ctx context.Context, estimate *entities.TaskRoleEstimate) (int64, error) {
+	result := r.db.WithContext(ctx).Model(estimate).Clauses(clause.Returning{}).Where("id = ?", estimate.ID).Select("*").Updates(&estimate)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "task_role_estimate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "task_role_estimate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "task_role_estimate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "task_role_estimate", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update task role estimate", "repository", "task_role_estimate", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a task role estimate by ID
+func (r *TaskRoleEstimateRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.TaskRoleEstimate{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete task role estimate", "repository", "task_role_estimate", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
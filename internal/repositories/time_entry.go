@@ -0,0 +1,222 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TimeEntryRepository is the repository for time entry entities
+type TimeEntryRepository struct {
+	db *gorm.DB
+}
+
+// NewTimeEntryRepository creates a new time entry repository
+func NewTimeEntryRepository(db *gorm.DB) *TimeEntryRepository {
+	return &TimeEntryRepository{db: db}
+}
+
+// Create creates a new time entry and returns it with database-generated fields populated
+func (r *TimeEntryRepository) Create(ctx context.Context, entry *entities.TimeEntry) (*entities.TimeEntry, error) {
+	err := r.db.WithContext(ctx).Create(entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "time_entry", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "time_entry", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "time_entry", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "time_entry", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "time_entry", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create time entry", "repository", "time_entry", "method", "Create", "error", err)
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetOne gets a time entry by ID
+func (r *TimeEntryRepository) GetOne(ctx context.Context, id uint) (*entities.TimeEntry, error) {
+	var entry entities.TimeEntry
+	err := r.db.WithContext(ctx).Model(&entities.TimeEntry{}).First(&entry, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "time_entry", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get time entry", "repository", "time_entry", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &entry, err
+}
+
+// GetMany gets multiple time entries by query parameters
+func (r *TimeEntryRepository) GetMany(ctx context.Context, qParams *entities.TimeEntryQueryParams) ([]*entities.TimeEntry, int64, error) {
+	var (
+		entries []*entities.TimeEntry
+		count   int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.TimeEntry{})
+
+	if qParams == nil {
+		qParams = &entities.TimeEntryQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.TaskID != 0 {
+		q = q.Where("task_id = @TaskID", sql.Named("TaskID", qParams.TaskID))
+	}
+	if len(qParams.TaskID_In) > 0 {
+		q = q.Where("task_id IN ?", qParams.TaskID_In)
+	}
+	if qParams.HumanResourceID != 0 {
+		q = q.Where("human_resource_id = @HumanResourceID", sql.Named("HumanResourceID", qParams.HumanResourceID))
+	}
+	if len(qParams.HumanResourceID_In) > 0 {
+		q = q.Where("human_resource_id IN ?", qParams.HumanResourceID_In)
+	}
+	if qParams.ProjectID != 0 {
+		q = q.Where("task_id IN (SELECT id FROM tasks WHERE project_id = @ProjectID)", sql.Named("ProjectID", qParams.ProjectID))
+	}
+	if len(qParams.ProjectID_In) > 0 {
+		q = q.Where("task_id IN (SELECT id FROM tasks WHERE project_id IN ?)", qParams.ProjectID_In)
+	}
+	if qParams.Billable != nil {
+		q = q.Where("billable = @Billable", sql.Named("Billable", *qParams.Billable))
+	}
+	if qParams.Date_Gte != nil {
+		q = q.Where("date >= @Date_Gte", sql.Named("Date_Gte", qParams.Date_Gte))
+	}
+	if qParams.Date_Lte != nil {
+		q = q.Where("date <= @Date_Lte", sql.Named("Date_Lte", qParams.Date_Lte))
+	}
+	if qParams.Hours_Gte != nil {
+		q = q.Where("hours >= @Hours_Gte", sql.Named("Hours_Gte", *qParams.Hours_Gte))
+	}
+	if qParams.Hours_Lte != nil {
+		q = q.Where("hours <= @Hours_Lte", sql.Named("Hours_Lte", *qParams.Hours_Lte))
+	}
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count time entries", "repository", "time_entry", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.TimeEntryAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&entries)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get time entries", "repository", "time_entry", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return entries, count, nil
+}
+
+// Update updates a time entry and returns it with updated database fields
+func (r *TimeEntryRepository) Update(ctx context.Context, entry *entities.TimeEntry) (int64, error) {
+	result := r.db.WithContext(ctx).Model(entry).Clauses(clause.Returning{}).Where("id = ?", entry.ID).Select("*").Updates(&entry)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "time_entry", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "time_entry", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "time_entry", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "time_entry", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update time entry", "repository", "time_entry", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a time entry by ID
+func (r *TimeEntryRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.TimeEntry{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete time entry", "repository", "time_entry", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SumHoursByTask returns the hours logged on each task of a project
+func (r *TimeEntryRepository) SumHoursByTask(ctx context.Context, projectID uint) (map[uint]float64, error) {
+	var rows []struct {
+		TaskID uint
+		Hours  float64
+	}
+	err := r.db.WithContext(ctx).Model(&entities.TimeEntry{}).
+		Select("task_id, SUM(hours) AS hours").
+		Where("task_id IN (SELECT id FROM tasks WHERE project_id = ?)", projectID).
+		Group("task_id").
+		Scan(&rows).Error
+	if err != nil {
+		internal.Logger.Error("failed to sum time entries", "repository", "time_entry", "method", "SumHoursByTask", "error", err)
+		return nil, err
+	}
+	hours := make(map[uint]float64, len(rows))
+	for _, row := range rows {
+		hours[row.TaskID] = row.Hours
+	}
+	return hours, nil
+}
@@ -0,0 +1,239 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WBSTemplateRepository is the repository for WBS template entities
+type WBSTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewWBSTemplateRepository creates a new WBS template repository
+func NewWBSTemplateRepository(db *gorm.DB) *WBSTemplateRepository {
+	return &WBSTemplateRepository{db: db}
+}
+
+// Create creates a new WBS template and returns it with database-generated fields populated
+func (r *WBSTemplateRepository) Create(ctx context.Context, template *entities.WBSTemplate) (*entities.WBSTemplate, error) {
+	err := r.db.WithContext(ctx).Create(template).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "wbs_template", "method", "Create", "error", err)
+			return nil, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "wbs_template", "method", "Create", "error", err)
+			return nil, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "wbs_template", "method", "Create", "error", err)
+			return nil, entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "wbs_template", "method", "Create", "error", err)
+			return nil, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "wbs_template", "method", "Create", "error", err)
+			return nil, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to create WBS template", "repository", "wbs_template", "method", "Create", "error", err)
+		return nil, err
+	}
+	return template, nil
+}
+
+// GetOne gets a WBS template by ID
+func (r *WBSTemplateRepository) GetOne(ctx context.Context, id uint) (*entities.WBSTemplate, error) {
+	var template entities.WBSTemplate
+	err := r.db.WithContext(ctx).Model(&entities.WBSTemplate{}).First(&template, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			internal.Logger.Error("record not found", "repository", "wbs_template", "method", "GetOne", "error", err)
+			return nil, entities.ErrRecordNotFound
+		}
+		internal.Logger.Error("failed to get WBS template", "repository", "wbs_template", "method", "GetOne", "error", err)
+		return nil, err
+	}
+	return &template, err
+}
+
+// GetMany gets multiple WBS templates by query parameters
+func (r *WBSTemplateRepository) GetMany(ctx context.Context, qParams *entities.WBSTemplateQueryParams) ([]*entities.WBSTemplate, int64, error) {
+	var (
+		templates []*entities.WBSTemplate
+		count     int64 = 0
+	)
+	q := r.db.WithContext(ctx).Model(&entities.WBSTemplate{})
+
+	if qParams == nil {
+		qParams = &entities.WBSTemplateQueryParams{}
+	}
+
+	if len(qParams.ID_In) > 0 {
+		q = q.Where("id IN @ID_In", sql.Named("ID_In", qParams.ID_In))
+	}
+	if qParams.Name != "" {
+		q = q.Where("name = @Name", sql.Named("Name", qParams.Name))
+	}
+
+	// Group LIKE conditions with OR for search functionality
+	if qParams.Name_Like != "" || qParams.Description_Like != "" {
+		orConditions := r.db.Where("1 = 0") // Start with false condition
+
+		if qParams.Name_Like != "" {
+			orConditions = orConditions.Or("name LIKE ?", "%"+qParams.Name_Like+"%")
+		}
+		if qParams.Description_Like != "" {
+			orConditions = orConditions.Or("description LIKE ?", "%"+qParams.Description_Like+"%")
+		}
+
+		q = q.Where(orConditions)
+	}
+
+	if qParams.CreatedAt_Gte != nil {
+		q = q.Where("created_at >= @CreatedAt_Gte", sql.Named("CreatedAt_Gte", qParams.CreatedAt_Gte))
+	}
+	if qParams.CreatedAt_Lte != nil {
+		q = q.Where("created_at <= @CreatedAt_Lte", sql.Named("CreatedAt_Lte", qParams.CreatedAt_Lte))
+	}
+	if qParams.UpdatedAt_Gte != nil {
+		q = q.Where("updated_at >= @UpdatedAt_Gte", sql.Named("UpdatedAt_Gte", qParams.UpdatedAt_Gte))
+	}
+	if qParams.UpdatedAt_Lte != nil {
+		q = q.Where("updated_at <= @UpdatedAt_Lte", sql.Named("UpdatedAt_Lte", qParams.UpdatedAt_Lte))
+	}
+
+	q = q.Session(&gorm.Session{})
+	result := q.Count(&count)
+	if result.Error != nil {
+		internal.Logger.Error("failed to count WBS templates", "repository", "wbs_template", "method", "GetMany", "error", result.Error)
+		return nil, 0, result.Error
+	}
+
+	// Apply sorting params
+	if qParams.QueryParams != nil {
+		if qParams.Sorts != nil {
+			for _, sort := range qParams.Sorts {
+				q = sort.Apply(q, entities.WBSTemplateAllowedSortField)
+			}
+		}
+		if qParams.Pagination != nil {
+			q = qParams.Pagination.Apply(q)
+		}
+	}
+
+	// Execute query
+	result = q.Find(&templates)
+	if result.Error != nil {
+		internal.Logger.Error("failed to get WBS templates", "repository", "wbs_template", "method", "GetMany", "error", result.Error)
+		return nil, count, result.Error
+	}
+	return templates, count, nil
+}
+
+// Update updates a WBS template and returns it with updated database fields
+func (r *WBSTemplateRepository) Update(ctx context.Context, template *entities.WBSTemplate) (int64, error) {
+	result := r.db.WithContext(ctx).Model(template).Clauses(clause.Returning{}).Where("id = ?", template.ID).Select("*").Updates(&template)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "wbs_template", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrUnsupportedRelation) {
+			internal.Logger.Error("unsupported relation", "repository", "wbs_template", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrUnsupportedRelation
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "wbs_template", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "wbs_template", "method", "Update", "error", err)
+			return result.RowsAffected, entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to update WBS template", "repository", "wbs_template", "method", "Update", "error", err)
+		return result.RowsAffected, err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return 0, entities.ErrRecordNotFound
+	}
+	return result.RowsAffected, nil
+}
+
+// Delete deletes a WBS template by ID
+func (r *WBSTemplateRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.WBSTemplate{}, id)
+	if err := result.Error; err != nil {
+		internal.Logger.Error("failed to delete WBS template", "repository", "wbs_template", "method", "Delete", "error", err)
+		return err
+	}
+	// Check if no rows were affected (record not found)
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Instantiate creates the milestones, tasks and role estimates of an instantiated template in a
+// single transaction. Each task gets the ID of its parent and new milestone once they are created,
+// and each role estimate the ID of its task.
+func (r *WBSTemplateRepository) Instantiate(ctx context.Context, instance *entities.WBSTemplateInstance) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range instance.Milestones {
+			if err := tx.Omit(clause.Associations).Create(m).Error; err != nil {
+				return err
+			}
+		}
+		for _, t := range instance.Tasks {
+			if t.Parent != nil {
+				t.ParentID = &t.Parent.ID
+			}
+			if t.Milestone != nil {
+				t.MilestoneID = &t.Milestone.ID
+			}
+			if err := tx.Omit(clause.Associations).Create(t).Error; err != nil {
+				return err
+			}
+		}
+		for _, e := range instance.RoleEstimates {
+			if e.Task != nil {
+				e.TaskID = e.Task.ID
+			}
+			if err := tx.Omit(clause.Associations).Create(e).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrInvalidData) {
+			internal.Logger.Error("invalid data", "repository", "wbs_template", "method", "Instantiate", "error", err)
+			return entities.ErrInvalidData
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			internal.Logger.Error("duplicated key", "repository", "wbs_template", "method", "Instantiate", "error", err)
+			return entities.ErrDuplicatedKey
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			internal.Logger.Error("foreign key violated", "repository", "wbs_template", "method", "Instantiate", "error", err)
+			return entities.ErrForeignKeyViolated
+		}
+		if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+			internal.Logger.Error("check constraint violated", "repository", "wbs_template", "method", "Instantiate", "error", err)
+			return entities.ErrCheckConstraintViolated
+		}
+		internal.Logger.Error("failed to instantiate WBS template", "repository", "wbs_template", "method", "Instantiate", "error", err)
+		return err
+	}
+	return nil
+}
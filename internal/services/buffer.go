@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// BufferRepository defines the interface for buffer data operations
+type BufferRepository interface {
+	Create(ctx context.Context, buffer *entities.Buffer) (*entities.Buffer, error)
+	GetOne(ctx context.Context, id uint) (*entities.Buffer, error)
+	GetMany(ctx context.Context, qParams *entities.BufferQueryParams) ([]*entities.Buffer, int64, error)
+	Update(ctx context.Context, buffer *entities.Buffer) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// BufferService handles the contingency and management reserve of projects and milestones
+type BufferService struct {
+	repo                BufferRepository
+	projectRepo         ProjectRepository
+	taskRepo            TaskRepository
+	dependencyRepo      TaskDependencyRepository
+	calendarRepo        CalendarRepository
+	milestoneRepo       MilestoneRepository
+	projectResourceRepo ProjectResourceRepository
+	rollupService       *RollupService
+}
+
+// NewBufferService creates a new buffer service
+func NewBufferService(repo BufferRepository, projectRepo ProjectRepository, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository, milestoneRepo MilestoneRepository, projectResourceRepo ProjectResourceRepository, rollupService *RollupService) *BufferService {
+	return &BufferService{
+		repo:                repo,
+		projectRepo:         projectRepo,
+		taskRepo:            taskRepo,
+		dependencyRepo:      dependencyRepo,
+		calendarRepo:        calendarRepo,
+		milestoneRepo:       milestoneRepo,
+		projectResourceRepo: projectResourceRepo,
+		rollupService:       rollupService,
+	}
+}
+
+// CreateBuffer creates a new buffer. A project and each of its milestones hold at most one buffer of each kind.
+func (s *BufferService) CreateBuffer(ctx context.Context, buffer *entities.Buffer) (*entities.Buffer, error) {
+	if err := s.validateScope(ctx, buffer); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, buffer)
+}
+
+// GetBuffer retrieves a single buffer by ID
+func (s *BufferService) GetBuffer(ctx context.Context, id uint) (*entities.Buffer, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetBuffers retrieves multiple buffers with optional query parameters
+func (s *BufferService) GetBuffers(ctx context.Context, params *entities.BufferQueryParams) (*entities.BufferListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.BufferListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateBuffer updates an existing buffer
+func (s *BufferService) UpdateBuffer(ctx context.Context, buffer *entities.Buffer) (int64, error) {
+	if err := s.validateScope(ctx, buffer); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, buffer)
+}
+
+// DeleteBuffer deletes a buffer by ID
+func (s *BufferService) DeleteBuffer(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// validateScope checks that the milestone of a buffer belongs to its project and that no other
+// buffer of the same kind is held on the same project or milestone
+func (s *BufferService) validateScope(ctx context.Context, buffer *entities.Buffer) error {
+	if err := buffer.Validate(); err != nil {
+		return err
+	}
+	isNull := buffer.MilestoneID == nil
+	params := &entities.BufferQueryParams{
+		ProjectID:          buffer.ProjectID,
+		MilestoneID:        buffer.MilestoneID,
+		MilestoneID_IsNull: &isNull,
+		Kind:               buffer.Kind,
+	}
+	if buffer.MilestoneID != nil {
+		milestone, err := s.milestoneRepo.GetOne(ctx, *buffer.MilestoneID)
+		if err != nil {
+			return err
+		}
+		if milestone.ProjectID != buffer.ProjectID {
+			return entities.ErrBufferMilestoneMismatch
+		}
+	}
+	existing, _, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return err
+	}
+	for _, b := range existing {
+		if b.ID != buffer.ID {
+			return entities.ErrBufferDuplicate
+		}
+	}
+	return nil
+}
+
+// GetBufferReport compares the internal effort, cost and finish of a project and its milestones with
+// the quoted ones, which include their contingency and management reserve. The base cost is that of
+// the active resource allocations, shared among milestones by effort. Buffers push the finish back by
+// the same share of the scheduled working hours as they add to the effort. The fever chart data
+// count the hours logged beyond the estimate of the completed work as contingency consumption.
+func (s *BufferService) GetBufferReport(ctx context.Context, projectID uint) (*entities.ProjectBufferReport, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	rollup, err := s.rollupService.GetProjectRollup(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	buffers, _, err := s.repo.GetMany(ctx, &entities.BufferQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	resources, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{ProjectID: projectID, Status: entities.ProjectResourceStatusActive})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		plan     *projectPlan
+		schedule *entities.ProjectSchedule
+	)
+	if project.StartDate != nil {
+		if plan, err = loadProjectPlan(ctx, s.taskRepo, s.dependencyRepo, s.calendarRepo, project); err != nil {
+			return nil, err
+		}
+		schedule = plan.schedule()
+	}
+
+	report := &entities.ProjectBufferReport{
+		ProjectID:  projectID,
+		Currency:   project.Currency,
+		Milestones: make([]*entities.MilestoneBufferReport, 0, len(rollup.Milestones)),
+	}
+	report.Effort.Base = rollup.EstimatedEffort
+	for _, pr := range resources {
+		report.Cost.Base += pr.Cost.Float64()
+	}
+	report.Effort.Quoted, report.Cost.Quoted = report.Effort.Base, report.Cost.Base
+	for _, b := range buffers {
+		if b.MilestoneID == nil {
+			report.AddBuffer(b)
+		}
+	}
+
+	for _, mr := range rollup.Milestones {
+		m := &entities.MilestoneBufferReport{MilestoneID: mr.MilestoneID, Name: mr.Name}
+		m.Effort.Base = mr.EstimatedEffort
+		if rollup.EstimatedEffort > 0 {
+			m.Cost.Base = report.Cost.Base * mr.EstimatedEffort / rollup.EstimatedEffort
+		}
+		m.Effort.Quoted, m.Cost.Quoted = m.Effort.Base, m.Cost.Base
+		for _, b := range buffers {
+			if b.MilestoneID != nil && *b.MilestoneID == mr.MilestoneID {
+				m.AddBuffer(b)
+			}
+		}
+		if schedule != nil {
+			for _, ts := range schedule.Tasks {
+				if ts.MilestoneID != nil && *ts.MilestoneID == mr.MilestoneID && !ts.IsSummary &&
+					(m.BaseFinish == nil || ts.EarlyFinish.After(*m.BaseFinish)) {
+					finish := ts.EarlyFinish
+					m.BaseFinish = &finish
+				}
+			}
+			pushBackFinish(plan, &m.BufferTotals)
+		}
+		m.Consumption = entities.NewBufferConsumption(mr.CompletedEffort, mr.PercentComplete, mr.ActualHours, m.Effort.Contingency)
+
+		report.Effort.Contingency += m.Effort.Contingency
+		report.Effort.ManagementReserve += m.Effort.ManagementReserve
+		report.Cost.Contingency += m.Cost.Contingency
+		report.Cost.ManagementReserve += m.Cost.ManagementReserve
+		report.Milestones = append(report.Milestones, m)
+	}
+	report.Effort.Quoted = report.Effort.Base + report.Effort.Contingency + report.Effort.ManagementReserve
+	report.Cost.Quoted = report.Cost.Base + report.Cost.Contingency + report.Cost.ManagementReserve
+
+	if schedule != nil {
+		finish := schedule.FinishDate
+		report.BaseFinish = &finish
+		pushBackFinish(plan, &report.BufferTotals)
+	}
+	report.Consumption = entities.NewBufferConsumption(rollup.CompletedEffort, rollup.PercentComplete, rollup.ActualHours, report.Effort.Contingency)
+	return report, nil
+}
+
+// pushBackFinish sets the finishes with buffers from the base finish, adding the share of the
+// working hours up to it that the buffers add to the base effort
+func pushBackFinish(plan *projectPlan, totals *entities.BufferTotals) {
+	if totals.BaseFinish == nil {
+		return
+	}
+	span := plan.calendar.WorkingHoursBetween(plan.startDate(), *totals.BaseFinish)
+	finish := func(buffer float64) *time.Time {
+		t := *totals.BaseFinish
+		if totals.Effort.Base > 0 && buffer > 0 {
+			t = plan.calendar.AddWorkingHours(t, span*buffer/totals.Effort.Base)
+		}
+		return &t
+	}
+	totals.ContingencyFinish = finish(totals.Effort.Contingency)
+	totals.QuotedFinish = finish(totals.Effort.Contingency + totals.Effort.ManagementReserve)
+}
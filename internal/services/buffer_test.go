@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	projectRepo := repositories.NewProjectRepository(db)
+	taskRepo := repositories.NewTaskRepository(db)
+	milestoneRepo := repositories.NewMilestoneRepository(db)
+	service := NewBufferService(
+		repositories.NewBufferRepository(db),
+		projectRepo,
+		taskRepo,
+		repositories.NewTaskDependencyRepository(db),
+		repositories.NewCalendarRepository(db),
+		milestoneRepo,
+		repositories.NewProjectResourceRepository(db),
+		NewRollupService(projectRepo, taskRepo, milestoneRepo, repositories.NewTimeEntryRepository(db)),
+	)
+	ctx := context.Background()
+
+	project := createScheduledTestProject(t, db, "Buffered")
+	beta := createTestMilestoneForService(t, db, project.ID, "Beta", nil)
+	design := createEffortTestTask(t, db, project.ID, "Design", nil, 40)
+	build := createEffortTestTask(t, db, project.ID, "Build", nil, 40)
+	createTestDependency(t, db, design.ID, build.ID, entities.DependencyFinishToStart, 0)
+	assert.NoError(t, db.Model(design).Updates(map[string]interface{}{"milestone_id": beta.ID, "status": entities.TaskWorkStatusDone}).Error)
+
+	alice := createTestHumanResourceForService(t, db, "Alice")
+	assert.NoError(t, db.Create(&entities.ProjectResource{ProjectID: project.ID, HumanResourceID: alice.ID, Allocation: 100, Cost: entities.NewMoney(8000), Status: entities.ProjectResourceStatusActive}).Error)
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		date := day.AddDate(0, 0, i)
+		assert.NoError(t, db.Create(&entities.TimeEntry{TaskID: design.ID, HumanResourceID: alice.ID, Date: &date, Hours: 10}).Error)
+	}
+
+	contingency, err := service.CreateBuffer(ctx, &entities.Buffer{ProjectID: project.ID, Kind: entities.BufferKindContingency, Basis: entities.BufferBasisPercent, Value: 10})
+	assert.NoError(t, err)
+	_, err = service.CreateBuffer(ctx, &entities.Buffer{ProjectID: project.ID, Kind: entities.BufferKindManagementReserve, Basis: entities.BufferBasisMoney, Value: 1000})
+	assert.NoError(t, err)
+	_, err = service.CreateBuffer(ctx, &entities.Buffer{ProjectID: project.ID, MilestoneID: &beta.ID, Kind: entities.BufferKindContingency, Basis: entities.BufferBasisHours, Value: 8})
+	assert.NoError(t, err)
+
+	t.Run("One buffer of each kind per project or milestone", func(t *testing.T) {
+		_, err := service.CreateBuffer(ctx, &entities.Buffer{ProjectID: project.ID, Kind: entities.BufferKindContingency, Basis: entities.BufferBasisHours, Value: 20})
+		assert.Equal(t, entities.ErrBufferDuplicate, err)
+		_, err = service.CreateBuffer(ctx, &entities.Buffer{ProjectID: project.ID, MilestoneID: &beta.ID, Kind: entities.BufferKindContingency, Basis: entities.BufferBasisPercent, Value: 5})
+		assert.Equal(t, entities.ErrBufferDuplicate, err)
+
+		contingency.Value = 10
+		_, err = service.UpdateBuffer(ctx, contingency)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Milestone of another project", func(t *testing.T) {
+		other := createTestProjectForService(t, db, "Other")
+		_, err := service.CreateBuffer(ctx, &entities.Buffer{ProjectID: other.ID, MilestoneID: &beta.ID, Kind: entities.BufferKindContingency, Basis: entities.BufferBasisPercent, Value: 5})
+		assert.Equal(t, entities.ErrBufferMilestoneMismatch, err)
+	})
+
+	t.Run("Quoted figures include the buffers", func(t *testing.T) {
+		report, err := service.GetBufferReport(ctx, project.ID)
+		if !assert.NoError(t, err) || !assert.Len(t, report.Milestones, 1) {
+			return
+		}
+
+		m := report.Milestones[0]
+		assert.Equal(t, entities.BufferFigures{Base: 40, Contingency: 8, Quoted: 48}, m.Effort)
+		assert.Equal(t, entities.BufferFigures{Base: 4000, Contingency: 800, Quoted: 4800}, m.Cost)
+		assert.Equal(t, at(9, 17), *m.BaseFinish)
+		assert.Equal(t, at(12, 17), *m.ContingencyFinish)
+		assert.Equal(t, at(12, 17), *m.QuotedFinish)
+
+		assert.InDelta(t, 80, report.Effort.Base, 1e-9)
+		assert.InDelta(t, 16, report.Effort.Contingency, 1e-9)
+		assert.InDelta(t, 10, report.Effort.ManagementReserve, 1e-9)
+		assert.InDelta(t, 106, report.Effort.Quoted, 1e-9)
+		assert.InDelta(t, 8000, report.Cost.Base, 1e-9)
+		assert.InDelta(t, 1600, report.Cost.Contingency, 1e-9)
+		assert.InDelta(t, 1000, report.Cost.ManagementReserve, 1e-9)
+		assert.InDelta(t, 10600, report.Cost.Quoted, 1e-9)
+		assert.Equal(t, at(16, 17), *report.BaseFinish)
+		assert.Equal(t, at(20, 17), *report.ContingencyFinish)
+		assert.Equal(t, at(22, 11), *report.QuotedFinish)
+	})
+
+	t.Run("Fever chart data", func(t *testing.T) {
+		report, err := service.GetBufferReport(ctx, project.ID)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		m := report.Milestones[0].Consumption
+		assert.InDelta(t, 10, m.ConsumedHours, 1e-9)
+		assert.InDelta(t, 125, m.ConsumedPercent, 1e-9)
+		assert.Equal(t, entities.BufferZoneRed, m.Zone)
+
+		p := report.Consumption
+		assert.InDelta(t, 50, p.CompletionPercent, 1e-9)
+		assert.InDelta(t, 62.5, p.ConsumedPercent, 1e-9)
+		assert.Equal(t, entities.BufferZoneYellow, p.Zone)
+	})
+
+	t.Run("Project without start date", func(t *testing.T) {
+		unscheduled := createTestProjectForService(t, db, "Unscheduled")
+		createEffortTestTask(t, db, unscheduled.ID, "Spike", nil, 16)
+		_, err := service.CreateBuffer(ctx, &entities.Buffer{ProjectID: unscheduled.ID, Kind: entities.BufferKindContingency, Basis: entities.BufferBasisHours, Value: 4})
+		assert.NoError(t, err)
+
+		report, err := service.GetBufferReport(ctx, unscheduled.ID)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 20.0, report.Effort.Quoted)
+		assert.Nil(t, report.BaseFinish)
+		assert.Nil(t, report.QuotedFinish)
+		assert.Equal(t, entities.BufferZoneGreen, report.Consumption.Zone)
+	})
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// CalendarRepository defines the interface for calendar data operations
+type CalendarRepository interface {
+	Create(ctx context.Context, calendar *entities.Calendar) (*entities.Calendar, error)
+	GetOne(ctx context.Context, id uint) (*entities.Calendar, error)
+	GetMany(ctx context.Context, qParams *entities.CalendarQueryParams) ([]*entities.Calendar, int64, error)
+	Update(ctx context.Context, calendar *entities.Calendar) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// CalendarExceptionRepository defines the interface for calendar exception data operations
+type CalendarExceptionRepository interface {
+	Create(ctx context.Context, exception *entities.CalendarException) (*entities.CalendarException, error)
+	GetOne(ctx context.Context, id uint) (*entities.CalendarException, error)
+	GetMany(ctx context.Context, qParams *entities.CalendarExceptionQueryParams) ([]*entities.CalendarException, int64, error)
+	Update(ctx context.Context, exception *entities.CalendarException) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// CalendarService handles working calendars, their exceptions and working time arithmetic
+type CalendarService struct {
+	repo          CalendarRepository
+	exceptionRepo CalendarExceptionRepository
+	projectRepo   ProjectRepository
+}
+
+// NewCalendarService creates a new calendar service
+func NewCalendarService(repo CalendarRepository, exceptionRepo CalendarExceptionRepository, projectRepo ProjectRepository) *CalendarService {
+	return &CalendarService{
+		repo:          repo,
+		exceptionRepo: exceptionRepo,
+		projectRepo:   projectRepo,
+	}
+}
+
+// CreateCalendar creates a new calendar
+func (s *CalendarService) CreateCalendar(ctx context.Context, calendar *entities.Calendar) (*entities.Calendar, error) {
+	return s.repo.Create(ctx, calendar)
+}
+
+// GetCalendar retrieves a single calendar with its exceptions by ID
+func (s *CalendarService) GetCalendar(ctx context.Context, id uint) (*entities.Calendar, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetCalendars retrieves multiple calendars with optional query parameters
+func (s *CalendarService) GetCalendars(ctx context.Context, params *entities.CalendarQueryParams) (*entities.CalendarListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.CalendarListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateCalendar updates an existing calendar
+func (s *CalendarService) UpdateCalendar(ctx context.Context, calendar *entities.Calendar) (int64, error) {
+	return s.repo.Update(ctx, calendar)
+}
+
+// DeleteCalendar deletes a calendar by ID. Projects on it go back to their own working days.
+func (s *CalendarService) DeleteCalendar(ctx context.Context, id uint) error {
+	projects, _, err := s.projectRepo.GetMany(ctx, &entities.ProjectQueryParams{CalendarID: id})
+	if err != nil {
+		return err
+	}
+	if len(projects) > 0 {
+		ids := make([]uint, 0, len(projects))
+		for _, p := range projects {
+			ids = append(ids, p.ID)
+		}
+		if _, err := s.projectRepo.UpdateCalendar(ctx, ids, nil); err != nil {
+			return err
+		}
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// CreateCalendarException creates a new calendar exception
+func (s *CalendarService) CreateCalendarException(ctx context.Context, exception *entities.CalendarException) (*entities.CalendarException, error) {
+	return s.exceptionRepo.Create(ctx, exception)
+}
+
+// GetCalendarException retrieves a single calendar exception by ID
+func (s *CalendarService) GetCalendarException(ctx context.Context, id uint) (*entities.CalendarException, error) {
+	return s.exceptionRepo.GetOne(ctx, id)
+}
+
+// GetCalendarExceptions retrieves multiple calendar exceptions with optional query parameters
+func (s *CalendarService) GetCalendarExceptions(ctx context.Context, params *entities.CalendarExceptionQueryParams) (*entities.CalendarExceptionListResponse, error) {
+	data, total, err := s.exceptionRepo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.CalendarExceptionListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// GetCalendarExceptionsByCalendar retrieves all exceptions of a specific calendar
+func (s *CalendarService) GetCalendarExceptionsByCalendar(ctx context.Context, calendarID uint) (*entities.CalendarExceptionListResponse, error) {
+	params := &entities.CalendarExceptionQueryParams{
+		CalendarID: calendarID,
+	}
+	return s.GetCalendarExceptions(ctx, params)
+}
+
+// UpdateCalendarException updates an existing calendar exception
+func (s *CalendarService) UpdateCalendarException(ctx context.Context, exception *entities.CalendarException) (int64, error) {
+	return s.exceptionRepo.Update(ctx, exception)
+}
+
+// DeleteCalendarException deletes a calendar exception by ID
+func (s *CalendarService) DeleteCalendarException(ctx context.Context, id uint) error {
+	return s.exceptionRepo.Delete(ctx, id)
+}
+
+// GetProjectCalendar returns the calendar a project works on
+func (s *CalendarService) GetProjectCalendar(ctx context.Context, projectID uint) (*entities.Calendar, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return projectCalendar(ctx, s.repo, project)
+}
+
+// AddWorkingHours returns the instant reached after working the given hours from `from` on a calendar
+func (s *CalendarService) AddWorkingHours(ctx context.Context, calendarID uint, from time.Time, hours float64) (time.Time, error) {
+	calendar, err := s.repo.GetOne(ctx, calendarID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return calendar.AddWorkingHours(from, hours), nil
+}
+
+// GetWorkingHoursBetween returns the working hours between two instants on a calendar
+func (s *CalendarService) GetWorkingHoursBetween(ctx context.Context, calendarID uint, from, to time.Time) (float64, error) {
+	calendar, err := s.repo.GetOne(ctx, calendarID)
+	if err != nil {
+		return 0, err
+	}
+	return calendar.WorkingHoursBetween(from, to), nil
+}
+
+// AddProjectWorkingHours returns the instant reached after working the given hours from `from` on a project's calendar
+func (s *CalendarService) AddProjectWorkingHours(ctx context.Context, projectID uint, from time.Time, hours float64) (time.Time, error) {
+	calendar, err := s.GetProjectCalendar(ctx, projectID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return calendar.AddWorkingHours(from, hours), nil
+}
+
+// GetProjectWorkingHoursBetween returns the working hours between two instants on a project's calendar
+func (s *CalendarService) GetProjectWorkingHoursBetween(ctx context.Context, projectID uint, from, to time.Time) (float64, error) {
+	calendar, err := s.GetProjectCalendar(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	return calendar.WorkingHoursBetween(from, to), nil
+}
+
+// projectCalendar loads the named calendar of a project, or falls back to the
+// calendar described by the project's own working days and hours per day
+func projectCalendar(ctx context.Context, calendarRepo CalendarRepository, project *entities.Project) (*entities.Calendar, error) {
+	if project.CalendarID == nil {
+		return project.BaseCalendar(), nil
+	}
+	return calendarRepo.GetOne(ctx, *project.CalendarID)
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarService_ProjectCalendar(t *testing.T) {
+	db := setupServiceTestDB(t)
+	calendarRepo := repositories.NewCalendarRepository(db)
+	service := NewCalendarService(calendarRepo, repositories.NewCalendarExceptionRepository(db), repositories.NewProjectRepository(db))
+	ctx := context.Background()
+
+	monday := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Project without calendar uses its weekday configuration", func(t *testing.T) {
+		project := createTestProjectForService(t, db, "Weekdays")
+		assert.NoError(t, db.Model(project).Updates(map[string]any{"hours_per_day": 6, "working_days_per_week": entities.WeekdayArray{time.Monday, time.Tuesday}}).Error)
+
+		calendar, err := service.GetProjectCalendar(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 6.0, calendar.HoursPerDay)
+
+		finish, err := service.AddProjectWorkingHours(ctx, project.ID, monday, 18)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 12, 15, 0, 0, 0, time.UTC), finish)
+	})
+
+	t.Run("Project with calendar uses its exceptions", func(t *testing.T) {
+		calendar, err := service.CreateCalendar(ctx, &entities.Calendar{Name: "With holiday"})
+		assert.NoError(t, err)
+		_, err = service.CreateCalendarException(ctx, &entities.CalendarException{CalendarID: calendar.ID, Name: "Holiday", StartDate: monday})
+		assert.NoError(t, err)
+
+		project := createTestProjectForService(t, db, "Named")
+		assert.NoError(t, db.Model(project).Update("calendar_id", calendar.ID).Error)
+
+		hours, err := service.GetProjectWorkingHoursBetween(ctx, project.ID, monday, monday.AddDate(0, 0, 7))
+		assert.NoError(t, err)
+		assert.Equal(t, 32.0, hours)
+
+		finish, err := service.AddWorkingHours(ctx, calendar.ID, monday, 8)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 6, 17, 0, 0, 0, time.UTC), finish)
+	})
+
+	t.Run("Deleting a calendar removes its exceptions and detaches projects", func(t *testing.T) {
+		assert.NoError(t, db.Exec("PRAGMA foreign_keys = ON").Error)
+		calendar, err := service.CreateCalendar(ctx, &entities.Calendar{Name: "Temporary"})
+		assert.NoError(t, err)
+		_, err = service.CreateCalendarException(ctx, &entities.CalendarException{CalendarID: calendar.ID, StartDate: monday})
+		assert.NoError(t, err)
+		project := createTestProjectForService(t, db, "Detached")
+		assert.NoError(t, db.Model(project).Update("calendar_id", calendar.ID).Error)
+
+		assert.NoError(t, service.DeleteCalendar(ctx, calendar.ID))
+
+		exceptions, err := service.GetCalendarExceptionsByCalendar(ctx, calendar.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), exceptions.Total)
+
+		_, err = service.GetProjectCalendar(ctx, project.ID)
+		assert.NoError(t, err)
+
+		var detached entities.Project
+		assert.NoError(t, db.First(&detached, project.ID).Error)
+		assert.Nil(t, detached.CalendarID)
+	})
+}
@@ -0,0 +1,385 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/ducminhgd/plan-craft/internal"
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// CalibrationService learns how accurate past estimates were from the hours logged on completed
+// tasks and adjusts new estimates by it
+type CalibrationService struct {
+	projectRepo    ProjectRepository
+	taskRepo       TaskRepository
+	timeEntryRepo  TimeEntryRepository
+	assignmentRepo TaskAssignmentRepository
+	hrRepo         HumanResourceRepository
+	clientRepo     ClientRepository
+	scenarioRepo   ScenarioRepository
+}
+
+// NewCalibrationService creates a new calibration service
+func NewCalibrationService(projectRepo ProjectRepository, taskRepo TaskRepository, timeEntryRepo TimeEntryRepository, assignmentRepo TaskAssignmentRepository, hrRepo HumanResourceRepository, clientRepo ClientRepository, scenarioRepo ScenarioRepository) *CalibrationService {
+	return &CalibrationService{
+		projectRepo:    projectRepo,
+		taskRepo:       taskRepo,
+		timeEntryRepo:  timeEntryRepo,
+		assignmentRepo: assignmentRepo,
+		hrRepo:         hrRepo,
+		clientRepo:     clientRepo,
+		scenarioRepo:   scenarioRepo,
+	}
+}
+
+// GetEstimateCalibration compares the estimated effort of every completed task with the hours
+// logged on it, overall and per person, level, project type and client
+func (s *CalibrationService) GetEstimateCalibration(ctx context.Context) (*entities.EstimateCalibration, error) {
+	history, err := s.loadHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return history.calibration(), nil
+}
+
+// SuggestEstimate calibrates the estimate of a task that is being estimated
+func (s *CalibrationService) SuggestEstimate(ctx context.Context, request *entities.EstimateSuggestionRequest) (*entities.CalibratedEstimate, error) {
+	if request.Effort < 0 {
+		return nil, entities.ErrCalibrationInvalidEffort
+	}
+	project, err := s.projectRepo.GetOne(ctx, request.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.loadHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var people []*entities.HumanResource
+	if len(request.HumanResourceIDs) > 0 {
+		people, _, err = s.hrRepo.GetMany(ctx, &entities.HumanResourceQueryParams{ID_In: request.HumanResourceIDs})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return history.calibrate(project, request.Effort, people), nil
+}
+
+// ApplyCalibration calibrates the estimates of a project's open work packages, using the people
+// assigned to each, and stores them in a new draft scenario so the original estimates stay
+// untouched. An empty name uses entities.DefaultCalibrationScenarioName.
+func (s *CalibrationService) ApplyCalibration(ctx context.Context, projectID uint, name string) (*entities.CalibrationResult, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.loadHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	assignees := make(map[uint][]uint)
+	var ids []uint
+	for _, a := range assignments {
+		assignees[a.TaskID] = append(assignees[a.TaskID], a.HumanResourceID)
+		ids = append(ids, a.HumanResourceID)
+	}
+	people := make(map[uint]*entities.HumanResource)
+	if len(ids) > 0 {
+		resources, _, err := s.hrRepo.GetMany(ctx, &entities.HumanResourceQueryParams{ID_In: ids})
+		if err != nil {
+			return nil, err
+		}
+		for _, hr := range resources {
+			people[hr.ID] = hr
+		}
+	}
+
+	parents := make(map[uint]bool)
+	for _, t := range tasks {
+		if t.ParentID != nil {
+			parents[*t.ParentID] = true
+		}
+	}
+	result := &entities.CalibrationResult{ProjectID: projectID, Tasks: []*entities.CalibratedEstimate{}}
+	calibrated := make(map[uint]*entities.CalibratedEstimate)
+	for _, t := range tasks {
+		if parents[t.ID] || t.IsDone() || t.IsCancelled() || t.EstimatedEffort <= 0 {
+			continue
+		}
+		var team []*entities.HumanResource
+		for _, id := range assignees[t.ID] {
+			if hr := people[id]; hr != nil {
+				team = append(team, hr)
+			}
+		}
+		estimate := history.calibrate(project, t.EstimatedEffort, team)
+		estimate.TaskID = t.ID
+		estimate.Name = t.Name
+		calibrated[t.ID] = estimate
+		result.OriginalEffort += estimate.OriginalEffort
+		result.CalibratedEffort += estimate.SuggestedEffort
+		result.Tasks = append(result.Tasks, estimate)
+	}
+	sort.Slice(result.Tasks, func(i, j int) bool { return result.Tasks[i].TaskID < result.Tasks[j].TaskID })
+
+	if name = strings.TrimSpace(name); name == "" {
+		name = entities.DefaultCalibrationScenarioName
+	}
+	scenario, err := s.scenarioRepo.Create(ctx, &entities.Scenario{
+		ProjectID:   projectID,
+		Name:        name,
+		Description: "Open tasks re-estimated from the accuracy of completed tasks",
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.ScenarioID = scenario.ID
+	for _, row := range scenario.Tasks {
+		estimate := calibrated[row.TaskID]
+		if estimate == nil || estimate.SuggestedEffort == row.EstimatedEffort {
+			continue
+		}
+		row.EstimatedEffort = estimate.SuggestedEffort
+		if _, err := s.scenarioRepo.UpdateTask(ctx, row); err != nil {
+			// Leave no half-calibrated scenario behind
+			if err := s.scenarioRepo.Delete(ctx, scenario.ID); err != nil {
+				internal.Logger.Error("failed to delete scenario", "service", "calibration", "method", "ApplyCalibration", "error", err)
+			}
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// estimateHistory is the accuracy of past estimates, grouped the ways estimates are calibrated
+type estimateHistory struct {
+	overall *entities.CalibrationFactor
+	people  map[uint]*entities.CalibrationFactor
+	levels  map[string]*entities.CalibrationFactor
+	types   map[entities.ProjectType]*entities.CalibrationFactor
+	clients map[uint]*entities.CalibrationFactor
+}
+
+// loadHistory compares the estimates of completed work packages with the hours logged on them.
+// Summary tasks and tasks without an estimate or without logged hours are left out. A person's
+// share of an estimate is their planned hours on the task, or else the estimate split in
+// proportion to the hours each person logged.
+func (s *CalibrationService) loadHistory(ctx context.Context) (*estimateHistory, error) {
+	history := &estimateHistory{
+		overall: &entities.CalibrationFactor{Name: "Overall"},
+		people:  make(map[uint]*entities.CalibrationFactor),
+		levels:  make(map[string]*entities.CalibrationFactor),
+		types:   make(map[entities.ProjectType]*entities.CalibrationFactor),
+		clients: make(map[uint]*entities.CalibrationFactor),
+	}
+
+	done, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{Status: entities.TaskWorkStatusDone})
+	if err != nil {
+		return nil, err
+	}
+	if len(done) == 0 {
+		return history, nil
+	}
+	ids := make([]uint, 0, len(done))
+	for _, t := range done {
+		ids = append(ids, t.ID)
+	}
+	children, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ParentID_In: ids})
+	if err != nil {
+		return nil, err
+	}
+	parents := make(map[uint]bool)
+	for _, c := range children {
+		parents[*c.ParentID] = true
+	}
+
+	entries, _, err := s.timeEntryRepo.GetMany(ctx, &entities.TimeEntryQueryParams{TaskID_In: ids})
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return history, nil
+	}
+	actual := make(map[uint]float64)
+	logged := make(map[uint]map[uint]float64) // Hours per task and person
+	var personIDs []uint
+	for _, e := range entries {
+		actual[e.TaskID] += e.Hours
+		if logged[e.TaskID] == nil {
+			logged[e.TaskID] = make(map[uint]float64)
+		}
+		if _, seen := logged[e.TaskID][e.HumanResourceID]; !seen {
+			personIDs = append(personIDs, e.HumanResourceID)
+		}
+		logged[e.TaskID][e.HumanResourceID] += e.Hours
+	}
+
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{TaskID_In: ids})
+	if err != nil {
+		return nil, err
+	}
+	planned := make(map[uint]map[uint]float64) // Planned hours per task and person
+	for _, a := range assignments {
+		if planned[a.TaskID] == nil {
+			planned[a.TaskID] = make(map[uint]float64)
+		}
+		planned[a.TaskID][a.HumanResourceID] = a.PlannedHours
+	}
+
+	projectIDs := make([]uint, 0, len(done))
+	for _, t := range done {
+		projectIDs = append(projectIDs, t.ProjectID)
+	}
+	projects, _, err := s.projectRepo.GetMany(ctx, &entities.ProjectQueryParams{ID_In: projectIDs})
+	if err != nil {
+		return nil, err
+	}
+	projectByID := make(map[uint]*entities.Project, len(projects))
+	var clientIDs []uint
+	for _, p := range projects {
+		projectByID[p.ID] = p
+		clientIDs = append(clientIDs, p.ClientID)
+	}
+	clients, _, err := s.clientRepo.GetMany(ctx, &entities.ClientQueryParams{ID_In: clientIDs})
+	if err != nil {
+		return nil, err
+	}
+	clientNames := make(map[uint]string, len(clients))
+	for _, c := range clients {
+		clientNames[c.ID] = c.Name
+	}
+	resources, _, err := s.hrRepo.GetMany(ctx, &entities.HumanResourceQueryParams{ID_In: personIDs})
+	if err != nil {
+		return nil, err
+	}
+	people := make(map[uint]*entities.HumanResource, len(resources))
+	for _, hr := range resources {
+		people[hr.ID] = hr
+	}
+
+	for _, t := range done {
+		if parents[t.ID] || t.EstimatedEffort <= 0 || actual[t.ID] <= 0 {
+			continue
+		}
+		history.overall.Add(t.EstimatedEffort, actual[t.ID])
+		if project := projectByID[t.ProjectID]; project != nil {
+			if project.Type != "" {
+				factorOf(history.types, project.Type, 0, string(project.Type)).Add(t.EstimatedEffort, actual[t.ID])
+			}
+			factorOf(history.clients, project.ClientID, project.ClientID, clientNames[project.ClientID]).Add(t.EstimatedEffort, actual[t.ID])
+		}
+		for personID, hours := range logged[t.ID] {
+			estimate := planned[t.ID][personID]
+			if estimate <= 0 {
+				estimate = t.EstimatedEffort * hours / actual[t.ID]
+			}
+			hr := people[personID]
+			if hr == nil {
+				continue
+			}
+			factorOf(history.people, hr.ID, hr.ID, hr.Name).Add(estimate, hours)
+			if hr.Level != "" {
+				factorOf(history.levels, hr.Level, 0, hr.Level).Add(estimate, hours)
+			}
+		}
+	}
+	return history, nil
+}
+
+// factorOf returns the factor of a group, adding it when missing
+func factorOf[K comparable](groups map[K]*entities.CalibrationFactor, key K, id uint, name string) *entities.CalibrationFactor {
+	if groups[key] == nil {
+		groups[key] = &entities.CalibrationFactor{ID: id, Name: name}
+	}
+	return groups[key]
+}
+
+// calibration lists the factors of every group
+func (h *estimateHistory) calibration() *entities.EstimateCalibration {
+	calibration := &entities.EstimateCalibration{
+		Overall:       h.overall,
+		ByPerson:      make([]*entities.CalibrationFactor, 0, len(h.people)),
+		ByRoleLevel:   make([]*entities.CalibrationFactor, 0, len(h.levels)),
+		ByProjectType: make([]*entities.CalibrationFactor, 0, len(h.types)),
+		ByClient:      make([]*entities.CalibrationFactor, 0, len(h.clients)),
+	}
+	for _, f := range h.people {
+		calibration.ByPerson = append(calibration.ByPerson, f)
+	}
+	for _, f := range h.levels {
+		calibration.ByRoleLevel = append(calibration.ByRoleLevel, f)
+	}
+	for _, f := range h.types {
+		calibration.ByProjectType = append(calibration.ByProjectType, f)
+	}
+	for _, f := range h.clients {
+		calibration.ByClient = append(calibration.ByClient, f)
+	}
+	sort.Slice(calibration.ByPerson, func(i, j int) bool { return calibration.ByPerson[i].ID < calibration.ByPerson[j].ID })
+	sort.Slice(calibration.ByRoleLevel, func(i, j int) bool { return calibration.ByRoleLevel[i].Name < calibration.ByRoleLevel[j].Name })
+	sort.Slice(calibration.ByProjectType, func(i, j int) bool { return calibration.ByProjectType[i].Name < calibration.ByProjectType[j].Name })
+	sort.Slice(calibration.ByClient, func(i, j int) bool { return calibration.ByClient[i].ID < calibration.ByClient[j].ID })
+	return calibration
+}
+
+// calibrate adjusts an estimate by the most specific reliable history: the people doing the work,
+// their levels, the project type, the client, and finally all completed tasks. Several people or
+// levels are pooled. Without reliable history the estimate is kept.
+func (h *estimateHistory) calibrate(project *entities.Project, effort float64, people []*entities.HumanResource) *entities.CalibratedEstimate {
+	var byPerson, byLevel []*entities.CalibrationFactor
+	seenLevels := make(map[string]bool)
+	for _, hr := range people {
+		if f := h.people[hr.ID]; f != nil && f.IsReliable {
+			byPerson = append(byPerson, f)
+		}
+		if f := h.levels[hr.Level]; f != nil && f.IsReliable && !seenLevels[hr.Level] {
+			seenLevels[hr.Level] = true
+			byLevel = append(byLevel, f)
+		}
+	}
+
+	estimate := &entities.CalibratedEstimate{OriginalEffort: effort, Factor: 1, Basis: entities.CalibrationBasisNone}
+	switch {
+	case len(byPerson) > 0:
+		estimate.Basis = entities.CalibrationBasisPerson
+		pool(estimate, byPerson)
+	case len(byLevel) > 0:
+		estimate.Basis = entities.CalibrationBasisRoleLevel
+		pool(estimate, byLevel)
+	case h.types[project.Type] != nil && h.types[project.Type].IsReliable:
+		estimate.Basis = entities.CalibrationBasisProjectType
+		pool(estimate, []*entities.CalibrationFactor{h.types[project.Type]})
+	case h.clients[project.ClientID] != nil && h.clients[project.ClientID].IsReliable:
+		estimate.Basis = entities.CalibrationBasisClient
+		pool(estimate, []*entities.CalibrationFactor{h.clients[project.ClientID]})
+	case h.overall.IsReliable:
+		estimate.Basis = entities.CalibrationBasisOverall
+		pool(estimate, []*entities.CalibrationFactor{h.overall})
+	}
+	estimate.SuggestedEffort = effort * estimate.Factor
+	return estimate
+}
+
+// pool sets the factor of an estimate to the combined accuracy of the given groups
+func pool(estimate *entities.CalibratedEstimate, factors []*entities.CalibrationFactor) {
+	var estimated, actual float64
+	names := make([]string, 0, len(factors))
+	for _, f := range factors {
+		estimated += f.EstimatedHours
+		actual += f.ActualHours
+		estimate.Samples += f.Samples
+		names = append(names, f.Name)
+	}
+	estimate.Factor = actual / estimated
+	estimate.BasisName = strings.Join(names, ", ")
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingScenarioTasks is a scenario repository whose task updates fail
+type failingScenarioTasks struct {
+	ScenarioRepository
+}
+
+func (failingScenarioTasks) UpdateTask(ctx context.Context, task *entities.ScenarioTask) (int64, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestCalibrationService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewCalibrationService(
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewTimeEntryRepository(db),
+		repositories.NewTaskAssignmentRepository(db),
+		repositories.NewHRRepository(db),
+		repositories.NewClientRepository(db),
+		repositories.NewScenarioRepository(db),
+	)
+	ctx := context.Background()
+
+	alice := createTestHumanResourceForService(t, db, "Alice")
+	bob := createTestHumanResourceForService(t, db, "Bob")
+	assert.NoError(t, db.Model(bob).Update("level", "Junior").Error)
+
+	past := createTestProjectForService(t, db, "Past")
+	assert.NoError(t, db.Model(past).Update("type", entities.ProjectTypeProduct).Error)
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	logDone := func(task *entities.Task, hr *entities.HumanResource, hours float64) {
+		assert.NoError(t, db.Model(task).Update("status", entities.TaskWorkStatusDone).Error)
+		assert.NoError(t, db.Create(&entities.TimeEntry{TaskID: task.ID, HumanResourceID: hr.ID, Date: &date, Hours: hours}).Error)
+	}
+	// Alice overran her three tasks by 11 hours in all, Bob was spot on once
+	phase := createEffortTestTask(t, db, past.ID, "Phase", nil, 0)
+	logDone(phase, alice, 5) // Summary tasks are left out
+	logDone(createEffortTestTask(t, db, past.ID, "A", &phase.ID, 10), alice, 12)
+	logDone(createEffortTestTask(t, db, past.ID, "B", &phase.ID, 10), alice, 15)
+	logDone(createEffortTestTask(t, db, past.ID, "C", &phase.ID, 20), alice, 24)
+	logDone(createEffortTestTask(t, db, past.ID, "D", nil, 10), bob, 10)
+	unlogged := createEffortTestTask(t, db, past.ID, "Unlogged", nil, 10)
+	assert.NoError(t, db.Model(unlogged).Update("status", entities.TaskWorkStatusDone).Error)
+
+	t.Run("Accuracy per group", func(t *testing.T) {
+		calibration, err := service.GetEstimateCalibration(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 4, calibration.Overall.Samples)
+		assert.Equal(t, 50.0, calibration.Overall.EstimatedHours)
+		assert.Equal(t, 61.0, calibration.Overall.ActualHours)
+		assert.InDelta(t, 1.22, calibration.Overall.Factor, 1e-9)
+		assert.InDelta(t, (20+50+20+0)/4.0, calibration.Overall.MeanAbsError, 1e-9)
+		assert.True(t, calibration.Overall.IsReliable)
+
+		if assert.Len(t, calibration.ByPerson, 2) {
+			assert.Equal(t, "Alice", calibration.ByPerson[0].Name)
+			assert.InDelta(t, 1.275, calibration.ByPerson[0].Factor, 1e-9)
+			assert.True(t, calibration.ByPerson[0].IsReliable)
+			assert.Equal(t, 1.0, calibration.ByPerson[1].Factor)
+			assert.False(t, calibration.ByPerson[1].IsReliable)
+		}
+		if assert.Len(t, calibration.ByRoleLevel, 2) {
+			assert.Equal(t, "Junior", calibration.ByRoleLevel[0].Name)
+			assert.Equal(t, "Senior", calibration.ByRoleLevel[1].Name)
+		}
+		if assert.Len(t, calibration.ByProjectType, 1) {
+			assert.Equal(t, string(entities.ProjectTypeProduct), calibration.ByProjectType[0].Name)
+		}
+		if assert.Len(t, calibration.ByClient, 1) {
+			assert.Equal(t, past.ClientID, calibration.ByClient[0].ID)
+			assert.Equal(t, "Past Client", calibration.ByClient[0].Name)
+		}
+	})
+
+	current := createTestProjectForService(t, db, "Current")
+	assert.NoError(t, db.Model(current).Update("type", entities.ProjectTypeProduct).Error)
+	unrelated := createTestProjectForService(t, db, "Unrelated")
+
+	t.Run("Suggestions fall back from people to all history", func(t *testing.T) {
+		suggestion, err := service.SuggestEstimate(ctx, &entities.EstimateSuggestionRequest{ProjectID: current.ID, Effort: 20, HumanResourceIDs: []uint{alice.ID}})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CalibrationBasisPerson, suggestion.Basis)
+		assert.Equal(t, "Alice", suggestion.BasisName)
+		assert.InDelta(t, 25.5, suggestion.SuggestedEffort, 1e-9)
+
+		// Bob and his level have too little history
+		suggestion, err = service.SuggestEstimate(ctx, &entities.EstimateSuggestionRequest{ProjectID: current.ID, Effort: 20, HumanResourceIDs: []uint{bob.ID}})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CalibrationBasisProjectType, suggestion.Basis)
+		assert.InDelta(t, 24.4, suggestion.SuggestedEffort, 1e-9)
+
+		suggestion, err = service.SuggestEstimate(ctx, &entities.EstimateSuggestionRequest{ProjectID: unrelated.ID, Effort: 20})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CalibrationBasisOverall, suggestion.Basis)
+		assert.Equal(t, 4, suggestion.Samples)
+
+		_, err = service.SuggestEstimate(ctx, &entities.EstimateSuggestionRequest{ProjectID: current.ID, Effort: -1})
+		assert.Equal(t, entities.ErrCalibrationInvalidEffort, err)
+	})
+
+	t.Run("Apply calibration to a scenario", func(t *testing.T) {
+		build := createEffortTestTask(t, db, current.ID, "Build", nil, 10)
+		test := createEffortTestTask(t, db, current.ID, "Test", nil, 10)
+		shipped := createEffortTestTask(t, db, current.ID, "Shipped", nil, 10)
+		assert.NoError(t, db.Model(shipped).Update("status", entities.TaskWorkStatusDone).Error)
+		pr := &entities.ProjectResource{ProjectID: current.ID, HumanResourceID: alice.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+		assert.NoError(t, db.Create(pr).Error)
+		assert.NoError(t, db.Create(&entities.TaskAssignment{TaskID: build.ID, ProjectResourceID: pr.ID, HumanResourceID: alice.ID, PlannedHours: 10, Units: 100}).Error)
+
+		result, err := service.ApplyCalibration(ctx, current.ID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 20.0, result.OriginalEffort)
+		assert.InDelta(t, 12.75+12.2, result.CalibratedEffort, 1e-9)
+		if assert.Len(t, result.Tasks, 2) {
+			assert.Equal(t, entities.CalibrationBasisPerson, result.Tasks[0].Basis)
+			assert.Equal(t, entities.CalibrationBasisProjectType, result.Tasks[1].Basis)
+		}
+
+		var scenario entities.Scenario
+		assert.NoError(t, db.Preload("Tasks").First(&scenario, result.ScenarioID).Error)
+		assert.Equal(t, entities.DefaultCalibrationScenarioName, scenario.Name)
+		assert.InDelta(t, 12.75, findScenarioTask(&scenario, build.ID).EstimatedEffort, 1e-9)
+		assert.InDelta(t, 12.2, findScenarioTask(&scenario, test.ID).EstimatedEffort, 1e-9)
+		assert.Equal(t, 10.0, findScenarioTask(&scenario, shipped.ID).EstimatedEffort)
+
+		// The live estimates are kept
+		var live entities.Task
+		assert.NoError(t, db.First(&live, build.ID).Error)
+		assert.Equal(t, 10.0, live.EstimatedEffort)
+	})
+
+	t.Run("Failed calibration leaves no scenario behind", func(t *testing.T) {
+		failing := NewCalibrationService(
+			repositories.NewProjectRepository(db),
+			repositories.NewTaskRepository(db),
+			repositories.NewTimeEntryRepository(db),
+			repositories.NewTaskAssignmentRepository(db),
+			repositories.NewHRRepository(db),
+			repositories.NewClientRepository(db),
+			failingScenarioTasks{repositories.NewScenarioRepository(db)},
+		)
+		var before int64
+		assert.NoError(t, db.Model(&entities.Scenario{}).Count(&before).Error)
+
+		_, err := failing.ApplyCalibration(ctx, current.ID, "Doomed")
+		assert.EqualError(t, err, "disk full")
+
+		var after int64
+		assert.NoError(t, db.Model(&entities.Scenario{}).Count(&after).Error)
+		assert.Equal(t, before, after)
+		var rows int64
+		assert.NoError(t, db.Model(&entities.ScenarioTask{}).Where("scenario_id NOT IN (SELECT id FROM scenarios)").Count(&rows).Error)
+		assert.Zero(t, rows)
+	})
+}
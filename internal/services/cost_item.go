@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// CostItemRepository defines the interface for cost item data operations
+type CostItemRepository interface {
+	Create(ctx context.Context, item *entities.CostItem) (*entities.CostItem, error)
+	GetOne(ctx context.Context, id uint) (*entities.CostItem, error)
+	GetMany(ctx context.Context, qParams *entities.CostItemQueryParams) ([]*entities.CostItem, int64, error)
+	Update(ctx context.Context, item *entities.CostItem) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// CostItemService handles the non-labor cost items of projects and their total cost
+type CostItemService struct {
+	repo             CostItemRepository
+	projectRepo      ProjectRepository
+	milestoneRepo    MilestoneRepository
+	taskRepo         TaskRepository
+	laborCostService *LaborCostService
+}
+
+// NewCostItemService creates a new cost item service
+func NewCostItemService(repo CostItemRepository, projectRepo ProjectRepository, milestoneRepo MilestoneRepository, taskRepo TaskRepository, laborCostService *LaborCostService) *CostItemService {
+	return &CostItemService{
+		repo:             repo,
+		projectRepo:      projectRepo,
+		milestoneRepo:    milestoneRepo,
+		taskRepo:         taskRepo,
+		laborCostService: laborCostService,
+	}
+}
+
+// CreateCostItem creates a new cost item. Its dates are stored as calendar days in the project's time zone.
+func (s *CostItemService) CreateCostItem(ctx context.Context, item *entities.CostItem) (*entities.CostItem, error) {
+	zones := newProjectZones(s.projectRepo)
+	if err := s.prepare(ctx, zones, item); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	if err := zones.localize(ctx, created.ProjectID, &created.StartDate, &created.EndDate); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetCostItem retrieves a single cost item by ID
+func (s *CostItemService) GetCostItem(ctx context.Context, id uint) (*entities.CostItem, error) {
+	item, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := newProjectZones(s.projectRepo).localize(ctx, item.ProjectID, &item.StartDate, &item.EndDate); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// GetCostItems retrieves multiple cost items with optional query parameters
+func (s *CostItemService) GetCostItems(ctx context.Context, params *entities.CostItemQueryParams) (*entities.CostItemListResponse, error) {
+	zones := newProjectZones(s.projectRepo)
+	if params != nil {
+		if err := zones.normalizeFilters(ctx, params.ProjectID, &params.StartDate_Gte, &params.StartDate_Lte, &params.EndDate_Gte, &params.EndDate_Lte); err != nil {
+			return nil, err
+		}
+	}
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range data {
+		if err := zones.localize(ctx, item.ProjectID, &item.StartDate, &item.EndDate); err != nil {
+			return nil, err
+		}
+	}
+	return &entities.CostItemListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateCostItem updates an existing cost item. Its dates are stored as calendar days in the project's time zone.
+func (s *CostItemService) UpdateCostItem(ctx context.Context, item *entities.CostItem) (int64, error) {
+	if err := s.prepare(ctx, newProjectZones(s.projectRepo), item); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, item)
+}
+
+// DeleteCostItem deletes a cost item by ID
+func (s *CostItemService) DeleteCostItem(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// prepare moves the dates of the cost item to calendar days in the project's time zone, checks that
+// its milestone or task belongs to the same project, and that a monthly item without an end date
+// belongs to a project that has one
+func (s *CostItemService) prepare(ctx context.Context, zones *projectZones, item *entities.CostItem) error {
+	if err := zones.normalize(ctx, item.ProjectID, &item.StartDate, &item.EndDate); err != nil {
+		return err
+	}
+	if item.CostType == "" {
+		item.CostType = entities.CostTypeOther
+	}
+	if item.Frequency == "" {
+		item.Frequency = entities.CostFrequencyOneOff
+	}
+	if item.Quantity == 0 {
+		item.Quantity = 1
+	}
+	if err := item.Validate(); err != nil {
+		return err
+	}
+
+	if item.MilestoneID != nil {
+		milestone, err := s.milestoneRepo.GetOne(ctx, *item.MilestoneID)
+		if err != nil {
+			return err
+		}
+		if milestone.ProjectID != item.ProjectID {
+			return entities.ErrCostItemMilestoneMismatch
+		}
+	}
+	if item.TaskID != nil {
+		task, err := s.taskRepo.GetOne(ctx, *item.TaskID)
+		if err != nil {
+			return err
+		}
+		if task.ProjectID != item.ProjectID {
+			return entities.ErrCostItemTaskMismatch
+		}
+	}
+	if item.IsRecurring() && item.EndDate == nil {
+		project, err := zones.get(ctx, item.ProjectID)
+		if err != nil {
+			return err
+		}
+		if project.EndDate == nil {
+			return entities.ErrCostItemRecurringUnbounded
+		}
+	}
+	return nil
+}
+
+// GetProjectCost calculates the total cost of a project from the labor of its resource allocations
+// and its cost items, and breaks it down per cost type, milestone and month. A cost item counts
+// towards its own milestone or else its task's.
+func (s *CostItemService) GetProjectCost(ctx context.Context, projectID uint) (*entities.ProjectCost, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	labor, err := s.laborCostService.GetProjectLaborCost(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := s.repo.GetMany(ctx, &entities.CostItemQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	taskMilestone := make(map[uint]*uint, len(tasks))
+	for _, t := range tasks {
+		taskMilestone[t.ID] = t.MilestoneID
+	}
+
+	report := &entities.ProjectCost{ProjectID: projectID, Currency: project.Currency, LaborCost: labor}
+	types := map[entities.CostType]entities.Money{entities.CostTypeLabor: labor.Cost}
+	report.AddLabor(labor.Cost)
+
+	months := make(map[string]*entities.MonthCost)
+	month := func(key string) *entities.MonthCost {
+		if months[key] == nil {
+			months[key] = &entities.MonthCost{Month: key}
+		}
+		return months[key]
+	}
+	for _, m := range labor.Months {
+		month(m.Month).AddLabor(m.Cost)
+	}
+
+	// Milestones in the order of the labor cost, the last one holding costs not planned on a milestone
+	byMilestone := make(map[uint]*entities.MilestoneCost, len(labor.Milestones))
+	var unplanned *entities.MilestoneCost
+	for _, m := range labor.Milestones {
+		cost := &entities.MilestoneCost{MilestoneID: m.MilestoneID, Name: m.Name}
+		cost.AddLabor(m.Cost)
+		if m.MilestoneID == nil {
+			unplanned = cost
+		} else {
+			byMilestone[*m.MilestoneID] = cost
+		}
+		report.Milestones = append(report.Milestones, cost)
+	}
+	if unplanned == nil {
+		unplanned = &entities.MilestoneCost{}
+		report.Milestones = append(report.Milestones, unplanned)
+	}
+
+	projectEnd := inProjectZone(project, project.EndDate)
+	for _, item := range items {
+		item.StartDate = inProjectZone(project, item.StartDate)
+		item.EndDate = inProjectZone(project, item.EndDate)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].StartDate.Equal(*items[j].StartDate) {
+			return items[i].StartDate.Before(*items[j].StartDate)
+		}
+		return items[i].ID < items[j].ID
+	})
+	for _, item := range items {
+		payments := item.Payments(projectEnd)
+		line := &entities.CostItemCost{
+			CostItemID:  item.ID,
+			Name:        item.Name,
+			CostType:    item.CostType,
+			Frequency:   item.Frequency,
+			MilestoneID: item.MilestoneID,
+			Payments:    len(payments),
+			Cost:        item.PerPayment() * entities.Money(len(payments)),
+		}
+		if line.MilestoneID == nil && item.TaskID != nil {
+			line.MilestoneID = taskMilestone[*item.TaskID]
+		}
+		for _, paid := range payments {
+			month(paid.Format("2006-01")).AddItems(item.PerPayment())
+		}
+		milestone := unplanned
+		if line.MilestoneID != nil && byMilestone[*line.MilestoneID] != nil {
+			milestone = byMilestone[*line.MilestoneID]
+		}
+		milestone.AddItems(line.Cost)
+		types[item.CostType] += line.Cost
+		report.AddItems(line.Cost)
+		report.CostItems = append(report.CostItems, line)
+	}
+
+	for _, ct := range entities.CostTypeOrder {
+		if cost, ok := types[ct]; ok {
+			report.Types = append(report.Types, &entities.CostTypeCost{CostType: ct, Cost: cost})
+		}
+	}
+	for _, m := range months {
+		report.Months = append(report.Months, m)
+	}
+	sort.Slice(report.Months, func(i, j int) bool { return report.Months[i].Month < report.Months[j].Month })
+	return report, nil
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostItemService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	projectRepo := repositories.NewProjectRepository(db)
+	hrRepo := repositories.NewHRRepository(db)
+	projectResourceRepo := repositories.NewProjectResourceRepository(db)
+	milestoneRepo := repositories.NewMilestoneRepository(db)
+	taskRepo := repositories.NewTaskRepository(db)
+	rateCardService := NewRateCardService(repositories.NewRateCardRepository(db), projectRepo, hrRepo, projectResourceRepo, repositories.NewProjectRoleRepository(db))
+	laborCostService := NewLaborCostService(projectRepo, projectResourceRepo, hrRepo, repositories.NewCalendarRepository(db), taskRepo, milestoneRepo, repositories.NewTaskAssignmentRepository(db), rateCardService)
+	service := NewCostItemService(repositories.NewCostItemRepository(db), projectRepo, milestoneRepo, taskRepo, laborCostService)
+	ctx := context.Background()
+
+	day := func(month time.Month, d int) *time.Time {
+		date := time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	project := createScheduledTestProject(t, db, "Bid")
+	assert.NoError(t, db.Model(project).Updates(map[string]interface{}{"end_date": day(time.February, 27), "currency": "EUR"}).Error)
+	alpha := createTestMilestoneForService(t, db, project.ID, "Alpha", day(time.January, 30))
+	beta := createTestMilestoneForService(t, db, project.ID, "Beta", day(time.February, 27))
+	build := createTestTaskForService(t, db, project.ID, "Build", nil)
+	assert.NoError(t, db.Model(build).Update("milestone_id", alpha.ID).Error)
+
+	t.Run("CRUD", func(t *testing.T) {
+		item, err := service.CreateCostItem(ctx, &entities.CostItem{Name: " Spare ", ProjectID: project.ID, Amount: entities.NewMoney(10), StartDate: day(time.January, 12)})
+		assert.NoError(t, err)
+		assert.Equal(t, "Spare", item.Name)
+		assert.Equal(t, entities.CostTypeOther, item.CostType)
+		assert.Equal(t, entities.CostFrequencyOneOff, item.Frequency)
+		assert.Equal(t, 1.0, item.Quantity)
+
+		got, err := service.GetCostItem(ctx, item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-01-12", got.StartDate.Format("2006-01-02"))
+
+		got.Frequency = entities.CostFrequencyMonthly
+		got.EndDate = day(time.January, 31)
+		_, err = service.UpdateCostItem(ctx, got)
+		assert.NoError(t, err)
+
+		list, err := service.GetCostItems(ctx, &entities.CostItemQueryParams{ProjectID: project.ID, Frequency: entities.CostFrequencyMonthly})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), list.Total)
+
+		assert.NoError(t, service.DeleteCostItem(ctx, item.ID))
+		_, err = service.GetCostItem(ctx, item.ID)
+		assert.ErrorIs(t, err, entities.ErrRecordNotFound)
+	})
+
+	t.Run("Links must belong to the project", func(t *testing.T) {
+		other := createTestProjectForService(t, db, "Other")
+		otherMilestone := createTestMilestoneForService(t, db, other.ID, "Gamma", nil)
+		otherTask := createTestTaskForService(t, db, other.ID, "Elsewhere", nil)
+
+		_, err := service.CreateCostItem(ctx, &entities.CostItem{Name: "Hosting", ProjectID: project.ID, MilestoneID: &otherMilestone.ID, StartDate: day(time.January, 5)})
+		assert.ErrorIs(t, err, entities.ErrCostItemMilestoneMismatch)
+		_, err = service.CreateCostItem(ctx, &entities.CostItem{Name: "Hosting", ProjectID: project.ID, TaskID: &otherTask.ID, StartDate: day(time.January, 5)})
+		assert.ErrorIs(t, err, entities.ErrCostItemTaskMismatch)
+
+		// A monthly item needs an end date when its project has none
+		_, err = service.CreateCostItem(ctx, &entities.CostItem{Name: "Hosting", ProjectID: other.ID, Frequency: entities.CostFrequencyMonthly, StartDate: day(time.January, 5)})
+		assert.ErrorIs(t, err, entities.ErrCostItemRecurringUnbounded)
+	})
+
+	t.Run("Project cost", func(t *testing.T) {
+		// A contractor at a fixed fee in January
+		hr := createTestHumanResourceForService(t, db, "Carol")
+		assert.NoError(t, db.Create(&entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Allocation: 100, StartDate: day(time.January, 5), EndDate: day(time.January, 16), Cost: entities.NewMoney(1000), CostOverride: true}).Error)
+
+		for _, item := range []*entities.CostItem{
+			// Two servers every month until the project ends
+			{Name: "Hosting", ProjectID: project.ID, MilestoneID: &beta.ID, CostType: entities.CostTypeInfrastructure, Amount: entities.NewMoney(200), Quantity: 2, Frequency: entities.CostFrequencyMonthly, StartDate: day(time.January, 10)},
+			// Licences bought for a task of Alpha
+			{Name: "Licences", ProjectID: project.ID, TaskID: &build.ID, CostType: entities.CostTypeService, Amount: entities.NewMoney(300), Quantity: 5, StartDate: day(time.February, 3)},
+			// A laptop not planned on a milestone
+			{Name: "Laptop", ProjectID: project.ID, CostType: entities.CostTypeEquipment, Amount: entities.NewMoney(1200), StartDate: day(time.January, 20)},
+		} {
+			_, err := service.CreateCostItem(ctx, item)
+			assert.NoError(t, err)
+		}
+
+		report, err := service.GetProjectCost(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", report.Currency)
+		assert.Equal(t, entities.NewMoney(1000), report.LaborCost.Cost)
+		assert.Equal(t, entities.NewMoney(1000), report.Labor)
+		assert.Equal(t, entities.NewMoney(3500), report.Items)
+		assert.Equal(t, entities.NewMoney(4500), report.Total)
+
+		if assert.Len(t, report.CostItems, 3) {
+			assert.Equal(t, "Hosting", report.CostItems[0].Name)
+			assert.Equal(t, 2, report.CostItems[0].Payments)
+			assert.Equal(t, entities.NewMoney(800), report.CostItems[0].Cost)
+			assert.Equal(t, "Laptop", report.CostItems[1].Name)
+			assert.Nil(t, report.CostItems[1].MilestoneID)
+			assert.Equal(t, "Licences", report.CostItems[2].Name)
+			if assert.NotNil(t, report.CostItems[2].MilestoneID) {
+				assert.Equal(t, alpha.ID, *report.CostItems[2].MilestoneID)
+			}
+		}
+
+		types := make(map[entities.CostType]entities.Money)
+		var order []entities.CostType
+		for _, ct := range report.Types {
+			types[ct.CostType] = ct.Cost
+			order = append(order, ct.CostType)
+		}
+		assert.Equal(t, []entities.CostType{entities.CostTypeLabor, entities.CostTypeEquipment, entities.CostTypeInfrastructure, entities.CostTypeService}, order)
+		assert.Equal(t, entities.NewMoney(1000), types[entities.CostTypeLabor])
+		assert.Equal(t, entities.NewMoney(1200), types[entities.CostTypeEquipment])
+		assert.Equal(t, entities.NewMoney(800), types[entities.CostTypeInfrastructure])
+		assert.Equal(t, entities.NewMoney(1500), types[entities.CostTypeService])
+
+		if assert.Len(t, report.Months, 2) {
+			assert.Equal(t, "2026-01", report.Months[0].Month)
+			assert.Equal(t, entities.NewMoney(1000), report.Months[0].Labor)
+			assert.Equal(t, entities.NewMoney(1600), report.Months[0].Items)
+			assert.Equal(t, "2026-02", report.Months[1].Month)
+			assert.Zero(t, report.Months[1].Labor)
+			assert.Equal(t, entities.NewMoney(1900), report.Months[1].Total)
+		}
+
+		if assert.Len(t, report.Milestones, 3) {
+			assert.Equal(t, "Alpha", report.Milestones[0].Name)
+			assert.Equal(t, entities.NewMoney(1500), report.Milestones[0].Items)
+			assert.Equal(t, "Beta", report.Milestones[1].Name)
+			assert.Equal(t, entities.NewMoney(800), report.Milestones[1].Items)
+			assert.Nil(t, report.Milestones[2].MilestoneID)
+			assert.Equal(t, entities.NewMoney(1000), report.Milestones[2].Labor)
+			assert.Equal(t, entities.NewMoney(1200), report.Milestones[2].Items)
+		}
+	})
+}
@@ -103,6 +103,26 @@ func (s *DatabaseFileService) HasUnsavedChanges() bool {
 		return true
 	}
 
+	// Check calendars
+	if err := db.Model(&entities.Calendar{}).Count(&count).Error; err == nil && count > 0 {
+		return true
+	}
+
+	// Check WBS templates
+	if err := db.Model(&entities.WBSTemplate{}).Count(&count).Error; err == nil && count > 0 {
+		return true
+	}
+
+	// Check rate cards
+	if err := db.Model(&entities.RateCard{}).Count(&count).Error; err == nil && count > 0 {
+		return true
+	}
+
+	// Check exchange rates
+	if err := db.Model(&entities.ExchangeRate{}).Count(&count).Error; err == nil && count > 0 {
+		return true
+	}
+
 	return false
 }
 
@@ -467,12 +487,43 @@ func (s *DatabaseFileService) openDatabaseFile(path string) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	// Scale money saved before it was stored in ten-thousandths
+	if err := entities.ScaleMoneyColumns(db); err != nil {
+		return nil, fmt.Errorf("failed to scale money columns: %w", err)
+	}
+
+	// Keep allocation costs entered before they were calculated
+	if err := entities.KeepLegacyCostOverrides(db); err != nil {
+		return nil, fmt.Errorf("failed to keep cost overrides: %w", err)
+	}
+
 	// Auto-migrate entities to ensure schema is up to date
 	err = db.AutoMigrate(
 		&entities.Client{},
+		&entities.Calendar{},
+		&entities.CalendarException{},
 		&entities.HumanResource{},
 		&entities.Project{},
 		&entities.ProjectResource{},
+		&entities.ProjectRole{},
+		&entities.Milestone{},
+		&entities.Task{},
+		&entities.TaskDependency{},
+		&entities.TaskAssignment{},
+		&entities.Scenario{},
+		&entities.ScenarioTask{},
+		&entities.ScenarioResource{},
+		&entities.ScenarioAssignment{},
+		&entities.ScenarioMilestone{},
+		&entities.TaskRoleEstimate{},
+		&entities.TimeEntry{},
+		&entities.WBSTemplate{},
+		&entities.Buffer{},
+		&entities.Sprint{},
+		&entities.SizeMapping{},
+		&entities.RateCard{},
+		&entities.CostItem{},
+		&entities.ExchangeRate{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to auto-migrate: %w", err)
@@ -601,6 +652,63 @@ func (s *DatabaseFileService) CloseDatabase() error {
 func (s *DatabaseFileService) clearMemoryDatabase(db *gorm.DB) error {
 	// Delete all records from each entity table
 	// Order matters due to foreign key constraints - delete child tables first
+	if err := db.Exec("DELETE FROM exchange_rates").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM cost_items").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM rate_cards").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM size_mappings").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM buffers").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM wbs_templates").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM time_entries").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM task_role_estimates").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM scenario_milestones").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM scenario_assignments").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM scenario_resources").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM scenario_tasks").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM scenarios").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM task_assignments").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM task_dependencies").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM tasks").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM sprints").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM milestones").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM project_roles").Error; err != nil {
+		return err
+	}
 	if err := db.Exec("DELETE FROM project_resources").Error; err != nil {
 		return err
 	}
@@ -610,6 +718,12 @@ func (s *DatabaseFileService) clearMemoryDatabase(db *gorm.DB) error {
 	if err := db.Exec("DELETE FROM human_resources").Error; err != nil {
 		return err
 	}
+	if err := db.Exec("DELETE FROM calendar_exceptions").Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM calendars").Error; err != nil {
+		return err
+	}
 	if err := db.Exec("DELETE FROM clients").Error; err != nil {
 		return err
 	}
@@ -638,9 +752,30 @@ func (s *DatabaseFileService) openMemoryDatabase() (*gorm.DB, error) {
 	// Auto-migrate entities
 	err = db.AutoMigrate(
 		&entities.Client{},
+		&entities.Calendar{},
+		&entities.CalendarException{},
 		&entities.HumanResource{},
 		&entities.Project{},
 		&entities.ProjectResource{},
+		&entities.ProjectRole{},
+		&entities.Milestone{},
+		&entities.Task{},
+		&entities.TaskDependency{},
+		&entities.TaskAssignment{},
+		&entities.Scenario{},
+		&entities.ScenarioTask{},
+		&entities.ScenarioResource{},
+		&entities.ScenarioAssignment{},
+		&entities.ScenarioMilestone{},
+		&entities.TaskRoleEstimate{},
+		&entities.TimeEntry{},
+		&entities.WBSTemplate{},
+		&entities.Buffer{},
+		&entities.Sprint{},
+		&entities.SizeMapping{},
+		&entities.RateCard{},
+		&entities.CostItem{},
+		&entities.ExchangeRate{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to auto-migrate: %w", err)
@@ -648,4 +783,3 @@ func (s *DatabaseFileService) openMemoryDatabase() (*gorm.DB, error) {
 
 	return db, nil
 }
-
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseFileService_HasUnsavedChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		record any
+	}{
+		{name: "WBS template", record: &entities.WBSTemplate{Name: "Website", Tasks: entities.WBSTemplateTasks{{Name: "Design", Effort: 8}}}},
+		{name: "Standard rate card", record: &entities.RateCard{RoleName: "Developer", RoleLevel: 3, RateType: entities.RateTypeHourly, CostRate: entities.NewMoney(50), EffectiveFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{name: "Exchange rate", record: &entities.ExchangeRate{FromCurrency: "EUR", ToCurrency: "USD", Rate: 1.1, EffectiveFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupServiceTestDB(t)
+			service := NewDatabaseFileService()
+			SetupDatabaseFileService(service, context.Background(), db, ":memory:", true)
+			assert.False(t, service.HasUnsavedChanges())
+
+			assert.NoError(t, db.Create(tt.record).Error)
+			assert.True(t, service.HasUnsavedChanges())
+		})
+	}
+}
@@ -0,0 +1,417 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// EarnedValueService handles project baselines and earned value management
+type EarnedValueService struct {
+	projectRepo     ProjectRepository
+	milestoneRepo   MilestoneRepository
+	taskRepo        TaskRepository
+	assignmentRepo  TaskAssignmentRepository
+	timeEntryRepo   TimeEntryRepository
+	calendarRepo    CalendarRepository
+	rateCardService *RateCardService
+}
+
+// NewEarnedValueService creates a new earned value service
+func NewEarnedValueService(projectRepo ProjectRepository, milestoneRepo MilestoneRepository, taskRepo TaskRepository, assignmentRepo TaskAssignmentRepository, timeEntryRepo TimeEntryRepository, calendarRepo CalendarRepository, rateCardService *RateCardService) *EarnedValueService {
+	return &EarnedValueService{
+		projectRepo:     projectRepo,
+		milestoneRepo:   milestoneRepo,
+		taskRepo:        taskRepo,
+		assignmentRepo:  assignmentRepo,
+		timeEntryRepo:   timeEntryRepo,
+		calendarRepo:    calendarRepo,
+		rateCardService: rateCardService,
+	}
+}
+
+// SetBaseline saves the current plan of every task of a project as its baseline: the planned start
+// and finish, the estimated effort, and the cost of the planned hours of its assignments at the rate
+// cards that apply on the task's planned start. Setting it again replaces the previous baseline.
+// It returns the number of tasks baselined.
+func (s *EarnedValueService) SetBaseline(ctx context.Context, projectID uint) (int64, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return 0, err
+	}
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID: projectID})
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	baselines := make(map[uint]*entities.TaskBaseline, len(tasks))
+	for _, t := range tasks {
+		baselines[t.ID] = &entities.TaskBaseline{Start: t.PlannedStart, Finish: t.PlannedFinish, Effort: t.EstimatedEffort, SetAt: now}
+	}
+
+	rates := newRateBook(s.rateCardService, project)
+	for _, a := range assignments {
+		baseline := baselines[a.TaskID]
+		if baseline == nil || a.PlannedHours <= 0 {
+			continue
+		}
+		day := project.DateOf(now)
+		if start := firstDate(baseline.Start, project.StartDate); start != nil {
+			day = project.DateOf(*start)
+		}
+		cost, _, err := rates.cost(ctx, a.HumanResourceID, day, a.PlannedHours)
+		if err != nil {
+			return 0, err
+		}
+		baseline.Cost += cost
+	}
+
+	if err := s.taskRepo.UpdateBaselines(ctx, baselines); err != nil {
+		return 0, err
+	}
+	return int64(len(baselines)), nil
+}
+
+// GetEarnedValue measures a project and each of its milestones against the baseline on the status
+// date; a zero status date is today. Only baselined work packages count: summary tasks take their
+// value from their subtasks, and tasks added after the baseline was set have no planned value.
+// Actual cost counts all the time logged on the project's tasks up to the status date.
+func (s *EarnedValueService) GetEarnedValue(ctx context.Context, projectID uint, statusDate time.Time) (*entities.ProjectEarnedValue, error) {
+	m, err := s.measure(ctx, projectID, statusDate)
+	if err != nil {
+		return nil, err
+	}
+	milestones, _, err := s.milestoneRepo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	sortMilestonesByEndDate(milestones)
+
+	project := m.scope(m.project.Budget, func(*entities.Task) bool { return true })
+	report := &entities.ProjectEarnedValue{
+		EarnedValue:   project.at(m, m.status),
+		ProjectID:     projectID,
+		Currency:      m.project.Currency,
+		StatusDate:    m.status,
+		Budget:        m.project.Budget,
+		BaselineCost:  project.baselineCost,
+		UnpricedHours: m.unpricedHours,
+	}
+	for _, milestone := range milestones {
+		id := milestone.ID
+		scope := m.scope(milestone.Budget, func(t *entities.Task) bool { return t.MilestoneID != nil && *t.MilestoneID == id })
+		report.Milestones = append(report.Milestones, &entities.MilestoneEarnedValue{
+			EarnedValue: scope.at(m, m.status),
+			MilestoneID: &id,
+			Name:        milestone.Name,
+			Budget:      milestone.Budget,
+		})
+	}
+	unplanned := m.scope(0, func(t *entities.Task) bool { return t.MilestoneID == nil })
+	report.Milestones = append(report.Milestones, &entities.MilestoneEarnedValue{EarnedValue: unplanned.at(m, m.status)})
+	return report, nil
+}
+
+// GetEarnedValueSeries returns the planned value, earned value and actual cost of a project at the
+// end of each month of its baseline and on the status date, for charts; a zero status date is today
+func (s *EarnedValueService) GetEarnedValueSeries(ctx context.Context, projectID uint, statusDate time.Time) (*entities.EarnedValueSeries, error) {
+	m, err := s.measure(ctx, projectID, statusDate)
+	if err != nil {
+		return nil, err
+	}
+	project := m.scope(m.project.Budget, func(*entities.Task) bool { return true })
+
+	// The months from the earliest baseline start to the later of the latest baseline finish and the status date
+	first, last := m.status, m.status
+	for _, t := range project.tasks {
+		if start := firstDate(t.BaselineStart, t.BaselineFinish); start != nil && m.project.DateOf(*start).Before(first) {
+			first = m.project.DateOf(*start)
+		}
+		if finish := firstDate(t.BaselineFinish, t.BaselineStart); finish != nil && m.project.DateOf(*finish).After(last) {
+			last = m.project.DateOf(*finish)
+		}
+	}
+	dates := []time.Time{m.status}
+	for month := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, first.Location()); !month.After(last); month = month.AddDate(0, 1, 0) {
+		if end := month.AddDate(0, 1, -1); !end.Equal(m.status) {
+			dates = append(dates, end)
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	series := &entities.EarnedValueSeries{
+		ProjectID:  projectID,
+		Currency:   m.project.Currency,
+		StatusDate: m.status,
+		BAC:        project.bac,
+		Points:     make([]*entities.EarnedValuePoint, 0, len(dates)),
+	}
+	for _, day := range dates {
+		point := &entities.EarnedValuePoint{Date: day, PV: project.plannedValue(m, day)}
+		if !day.After(m.status) {
+			ev, ac := project.earnedValue(m, day), project.actualCost(m, day)
+			point.EV, point.AC = &ev, &ac
+		}
+		series.Points = append(series.Points, point)
+	}
+	return series, nil
+}
+
+// evmMeasurement holds what measuring a project against its baseline needs
+type evmMeasurement struct {
+	project       *entities.Project
+	calendar      *entities.Calendar
+	status        time.Time // Calendar day in the project's time zone
+	packages      []*entities.Task
+	tasks         []*entities.Task
+	actuals       []*actualCost // Up to the status date, ordered by date
+	loggedHours   map[uint]float64
+	unpricedHours float64
+}
+
+// actualCost is the cost of one time entry
+type actualCost struct {
+	taskID uint
+	day    time.Time
+	hours  float64
+	cost   entities.Money
+}
+
+// measure loads the baselined work packages of a project and the actual cost of the time logged on
+// its tasks up to the status date
+func (s *EarnedValueService) measure(ctx context.Context, projectID uint, statusDate time.Time) (*evmMeasurement, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := projectCalendar(ctx, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	if statusDate.IsZero() {
+		statusDate = time.Now()
+	}
+	m := &evmMeasurement{project: project, calendar: calendar, status: project.DateOf(statusDate), tasks: tasks, loggedHours: make(map[uint]float64)}
+
+	hasChildren := make(map[uint]bool)
+	for _, t := range tasks {
+		if t.ParentID != nil && *t.ParentID != t.ID {
+			hasChildren[*t.ParentID] = true
+		}
+	}
+	for _, t := range tasks {
+		if t.IsBaselined() && !hasChildren[t.ID] {
+			m.packages = append(m.packages, t)
+		}
+	}
+	if len(m.packages) == 0 {
+		return nil, entities.ErrEarnedValueNoBaseline
+	}
+
+	entries, _, err := s.timeEntryRepo.GetMany(ctx, &entities.TimeEntryQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(*entries[j].Date) {
+			return entries[i].Date.Before(*entries[j].Date)
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	rates := newRateBook(s.rateCardService, project)
+	for _, e := range entries {
+		day := project.DateOf(*e.Date)
+		if day.After(m.status) {
+			break
+		}
+		cost, priced, err := rates.cost(ctx, e.HumanResourceID, day, e.Hours)
+		if err != nil {
+			return nil, err
+		}
+		if !priced {
+			m.unpricedHours += e.Hours
+		}
+		m.actuals = append(m.actuals, &actualCost{taskID: e.TaskID, day: day, hours: e.Hours, cost: cost})
+		m.loggedHours[e.TaskID] += e.Hours
+	}
+	return m, nil
+}
+
+// evmScope is a set of baselined work packages measured together, such as those of a milestone,
+// with the value each of them adds to the scope's budget
+type evmScope struct {
+	tasks        []*entities.Task
+	inScope      map[uint]bool // Tasks whose logged time counts as actual cost, summary tasks included
+	value        map[uint]float64
+	bac          entities.Money
+	baselineCost entities.Money
+}
+
+// scope weighs the work packages matching the filter by baseline cost, or by baseline effort when
+// none of them has a cost, and shares the budget, or else their baseline cost, among them
+func (m *evmMeasurement) scope(budget entities.Money, matches func(*entities.Task) bool) *evmScope {
+	scope := &evmScope{inScope: make(map[uint]bool), value: make(map[uint]float64)}
+	for _, t := range m.tasks {
+		if matches(t) {
+			scope.inScope[t.ID] = true
+		}
+	}
+	var effort float64
+	for _, t := range m.packages {
+		if !matches(t) {
+			continue
+		}
+		scope.tasks = append(scope.tasks, t)
+		scope.baselineCost += t.BaselineCost
+		effort += t.BaselineEffort
+	}
+	scope.bac = scope.baselineCost
+	if budget > 0 {
+		scope.bac = budget
+	}
+	for _, t := range scope.tasks {
+		switch {
+		case scope.baselineCost > 0:
+			scope.value[t.ID] = scope.bac.Float64() * t.BaselineCost.Float64() / scope.baselineCost.Float64()
+		case effort > 0:
+			scope.value[t.ID] = scope.bac.Float64() * t.BaselineEffort / effort
+		}
+	}
+	return scope
+}
+
+// at returns the earned value metrics of the scope on the day
+func (s *evmScope) at(m *evmMeasurement, day time.Time) entities.EarnedValue {
+	value := entities.EarnedValue{
+		BAC: s.bac,
+		PV:  s.plannedValue(m, day),
+		EV:  s.earnedValue(m, day),
+		AC:  s.actualCost(m, day),
+	}
+	value.Calculate()
+	return value
+}
+
+// plannedValue is the value of the work the baseline scheduled up to and including the day
+func (s *evmScope) plannedValue(m *evmMeasurement, day time.Time) entities.Money {
+	var pv float64
+	for _, t := range s.tasks {
+		pv += s.value[t.ID] * m.plannedShare(t, day)
+	}
+	return entities.NewMoney(pv)
+}
+
+// earnedValue is the value of the work performed by the day. On the status date each work package
+// has earned its value times its percentage complete; before it, the share of its hours logged by then.
+func (s *evmScope) earnedValue(m *evmMeasurement, day time.Time) entities.Money {
+	var ev float64
+	for _, t := range s.tasks {
+		earned := s.value[t.ID] * t.Progress() / 100
+		if earned == 0 || !day.Before(m.status) {
+			ev += earned
+			continue
+		}
+		if total := m.loggedHours[t.ID]; total > 0 {
+			var logged float64
+			for _, a := range m.actuals {
+				if a.taskID == t.ID && !a.day.After(day) {
+					logged += a.hours
+				}
+			}
+			ev += earned * logged / total
+		}
+	}
+	return entities.NewMoney(ev)
+}
+
+// actualCost is the cost of the time logged on the scope's tasks up to and including the day
+func (s *evmScope) actualCost(m *evmMeasurement, day time.Time) entities.Money {
+	var ac entities.Money
+	for _, a := range m.actuals {
+		if s.inScope[a.taskID] && !a.day.After(day) {
+			ac += a.cost
+		}
+	}
+	return ac
+}
+
+// plannedShare returns the share of a task's baseline working hours that fall on or before the day
+func (m *evmMeasurement) plannedShare(t *entities.Task, day time.Time) float64 {
+	start, finish := firstDate(t.BaselineStart, t.BaselineFinish), firstDate(t.BaselineFinish, t.BaselineStart)
+	if start == nil {
+		return 0
+	}
+	from, to := m.project.DateOf(*start), m.project.DateOf(*finish)
+	if day.Before(from) {
+		return 0
+	}
+	if !day.Before(to) {
+		return 1
+	}
+	var done, total float64
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		hours := m.calendar.HoursOn(d)
+		total += hours
+		if !d.After(day) {
+			done += hours
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return done / total
+}
+
+// rateBook prices hours of work at the rate cards of the people doing it, loading each person's
+// rate cards once. A fixed rate is paid once, with the first hours it applies to.
+type rateBook struct {
+	service *RateCardService
+	project *entities.Project
+	people  map[uint]*personRates
+	paid    map[uint]bool
+}
+
+// newRateBook creates a rate book for the people of a project
+func newRateBook(service *RateCardService, project *entities.Project) *rateBook {
+	return &rateBook{service: service, project: project, people: make(map[uint]*personRates), paid: make(map[uint]bool)}
+}
+
+// cost returns the cost of a person's hours on a day in the project currency, and whether a rate
+// card in that currency applies to them
+func (b *rateBook) cost(ctx context.Context, humanResourceID uint, day time.Time, hours float64) (entities.Money, bool, error) {
+	rates := b.people[humanResourceID]
+	if rates == nil {
+		var err error
+		if rates, err = b.service.loadPersonRates(ctx, humanResourceID, b.project.ID); err != nil {
+			return 0, false, err
+		}
+		b.people[humanResourceID] = rates
+	}
+	card, _ := rates.on(day)
+	if card == nil || !sameCurrency(card.Currency, b.project.Currency) {
+		return 0, false, nil
+	}
+	if card.RateType == entities.RateTypeFixed {
+		if b.paid[card.ID] {
+			return 0, true, nil
+		}
+		b.paid[card.ID] = true
+		return card.CostRate, true, nil
+	}
+	rate, _ := card.HourlyRates(float64(b.project.GetHoursPerDay()), b.project.GetDaysPerMonth())
+	return entities.NewMoney(hours * rate), true, nil
+}
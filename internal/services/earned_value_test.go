@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEarnedValueService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	projectRepo := repositories.NewProjectRepository(db)
+	hrRepo := repositories.NewHRRepository(db)
+	projectResourceRepo := repositories.NewProjectResourceRepository(db)
+	taskRepo := repositories.NewTaskRepository(db)
+	rateCardService := NewRateCardService(repositories.NewRateCardRepository(db), projectRepo, hrRepo, projectResourceRepo, repositories.NewProjectRoleRepository(db))
+	service := NewEarnedValueService(
+		projectRepo,
+		repositories.NewMilestoneRepository(db),
+		taskRepo,
+		repositories.NewTaskAssignmentRepository(db),
+		repositories.NewTimeEntryRepository(db),
+		repositories.NewCalendarRepository(db),
+		rateCardService,
+	)
+	ctx := context.Background()
+
+	day := func(month time.Month, d int) *time.Time {
+		date := time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	project := createScheduledTestProject(t, db, "EVM")
+	assert.NoError(t, db.Model(project).Update("currency", "EUR").Error)
+	hr := createTestHumanResourceForService(t, db, "Alice")
+	pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Allocation: 100}
+	assert.NoError(t, db.Create(pr).Error)
+	assert.NoError(t, db.Create(&entities.RateCard{HumanResourceID: &hr.ID, CostRate: entities.NewMoney(50), Currency: "EUR", EffectiveFrom: *day(time.January, 1)}).Error)
+
+	alpha := createTestMilestoneForService(t, db, project.ID, "Alpha", day(time.January, 9))
+	beta := createTestMilestoneForService(t, db, project.ID, "Beta", day(time.February, 6))
+	plan := func(name string, milestoneID uint, start, finish *time.Time, hours float64) *entities.Task {
+		task := createTestTaskForService(t, db, project.ID, name, nil)
+		assert.NoError(t, db.Model(task).Updates(map[string]interface{}{"milestone_id": milestoneID, "planned_start": start, "planned_finish": finish, "estimated_effort": hours}).Error)
+		assert.NoError(t, db.Create(&entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: pr.ID, HumanResourceID: hr.ID, PlannedHours: hours}).Error)
+		return task
+	}
+	// Design takes the first week and Build two weeks across the month end
+	design := plan("Design", alpha.ID, day(time.January, 5), day(time.January, 9), 40)
+	build := plan("Build", beta.ID, day(time.January, 26), day(time.February, 6), 80)
+
+	_, err := service.GetEarnedValue(ctx, project.ID, *day(time.February, 3))
+	assert.ErrorIs(t, err, entities.ErrEarnedValueNoBaseline)
+
+	count, err := service.SetBaseline(ctx, project.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	got, err := taskRepo.GetOne(ctx, build.ID)
+	assert.NoError(t, err)
+	assert.True(t, got.IsBaselined())
+	assert.Equal(t, entities.NewMoney(4000), got.BaselineCost)
+	assert.InDelta(t, 80, got.BaselineEffort, 1e-9)
+
+	// Editing a task keeps its baseline
+	got.Name = "Build and test"
+	_, err = taskRepo.Update(ctx, got)
+	assert.NoError(t, err)
+	got, err = taskRepo.GetOne(ctx, build.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.NewMoney(4000), got.BaselineCost)
+
+	// Design is done; Build is a quarter done but has used 48 of its 80 hours
+	assert.NoError(t, db.Model(design).Update("percent_complete", 100).Error)
+	assert.NoError(t, db.Model(build).Update("percent_complete", 25).Error)
+	log := func(task *entities.Task, date *time.Time) {
+		assert.NoError(t, db.Create(&entities.TimeEntry{TaskID: task.ID, HumanResourceID: hr.ID, Date: date, Hours: 8}).Error)
+	}
+	for d := 5; d <= 9; d++ {
+		log(design, day(time.January, d))
+	}
+	for d := 26; d <= 30; d++ {
+		log(build, day(time.January, d))
+	}
+	log(build, day(time.February, 2))
+	log(build, day(time.February, 10)) // After the status date
+	// Work added after the baseline earns nothing
+	extra := createTestTaskForService(t, db, project.ID, "Extra", nil)
+	assert.NoError(t, db.Model(extra).Update("percent_complete", 50).Error)
+
+	t.Run("Earned value", func(t *testing.T) {
+		report, err := service.GetEarnedValue(ctx, project.ID, *day(time.February, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-02-03", report.StatusDate.Format("2006-01-02"))
+		assert.Equal(t, entities.NewMoney(6000), report.BaselineCost)
+		assert.Equal(t, entities.NewMoney(6000), report.BAC)
+		// Build has 7 of its 10 working days behind it
+		assert.Equal(t, entities.NewMoney(2000+2800), report.PV)
+		assert.Equal(t, entities.NewMoney(2000+1000), report.EV)
+		assert.Equal(t, entities.NewMoney(88*50), report.AC)
+		assert.Equal(t, entities.NewMoney(-1400), report.CV)
+		assert.Equal(t, entities.NewMoney(-1800), report.SV)
+		assert.InDelta(t, 3000.0/4400, report.CPI, 1e-9)
+		assert.InDelta(t, 3000.0/4800, report.SPI, 1e-9)
+		assert.Equal(t, entities.NewMoney(8800), report.EAC)
+		assert.Equal(t, entities.NewMoney(4400), report.ETC)
+		assert.Equal(t, entities.NewMoney(-2800), report.VAC)
+
+		if assert.Len(t, report.Milestones, 3) {
+			assert.Equal(t, "Alpha", report.Milestones[0].Name)
+			assert.Equal(t, entities.NewMoney(2000), report.Milestones[0].EV)
+			assert.InDelta(t, 1, report.Milestones[0].CPI, 1e-9)
+			assert.Equal(t, "Beta", report.Milestones[1].Name)
+			assert.Equal(t, entities.NewMoney(4000), report.Milestones[1].BAC)
+			assert.Equal(t, entities.NewMoney(2800), report.Milestones[1].PV)
+			assert.Equal(t, entities.NewMoney(2400), report.Milestones[1].AC)
+			assert.Nil(t, report.Milestones[2].MilestoneID)
+			assert.Zero(t, report.Milestones[2].BAC)
+		}
+	})
+
+	t.Run("Budget scales the baseline", func(t *testing.T) {
+		assert.NoError(t, db.Model(project).Update("budget", entities.NewMoney(12000)).Error)
+		assert.NoError(t, db.Model(beta).Update("budget", entities.NewMoney(5000)).Error)
+		defer func() {
+			assert.NoError(t, db.Model(project).Update("budget", 0).Error)
+			assert.NoError(t, db.Model(beta).Update("budget", 0).Error)
+		}()
+
+		report, err := service.GetEarnedValue(ctx, project.ID, *day(time.February, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, entities.NewMoney(12000), report.BAC)
+		assert.Equal(t, entities.NewMoney(9600), report.PV)
+		assert.Equal(t, entities.NewMoney(6000), report.EV)
+		assert.Equal(t, entities.NewMoney(4400), report.AC)
+		assert.Equal(t, entities.NewMoney(5000), report.Milestones[1].BAC)
+		assert.Equal(t, entities.NewMoney(1250), report.Milestones[1].EV)
+	})
+
+	t.Run("Series", func(t *testing.T) {
+		series, err := service.GetEarnedValueSeries(ctx, project.ID, *day(time.February, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, entities.NewMoney(6000), series.BAC)
+		if assert.Len(t, series.Points, 3) {
+			jan, status, feb := series.Points[0], series.Points[1], series.Points[2]
+			assert.Equal(t, "2026-01-31", jan.Date.Format("2006-01-02"))
+			assert.Equal(t, entities.NewMoney(4000), jan.PV)
+			// Build earned 40 of its 48 logged hours' share by the end of January
+			assert.Equal(t, entities.NewMoney(2000+1000*40.0/48), *jan.EV)
+			assert.Equal(t, entities.NewMoney(4000), *jan.AC)
+
+			assert.Equal(t, "2026-02-03", status.Date.Format("2006-01-02"))
+			assert.Equal(t, entities.NewMoney(3000), *status.EV)
+			assert.Equal(t, entities.NewMoney(4400), *status.AC)
+
+			assert.Equal(t, "2026-02-28", feb.Date.Format("2006-01-02"))
+			assert.Equal(t, entities.NewMoney(6000), feb.PV)
+			assert.Nil(t, feb.EV)
+			assert.Nil(t, feb.AC)
+		}
+	})
+}
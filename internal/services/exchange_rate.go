@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// ExchangeRateRepository defines the interface for exchange rate data operations
+type ExchangeRateRepository interface {
+	Create(ctx context.Context, rate *entities.ExchangeRate) (*entities.ExchangeRate, error)
+	GetOne(ctx context.Context, id uint) (*entities.ExchangeRate, error)
+	GetMany(ctx context.Context, qParams *entities.ExchangeRateQueryParams) ([]*entities.ExchangeRate, int64, error)
+	Update(ctx context.Context, rate *entities.ExchangeRate) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// ExchangeRateService handles effective-dated exchange rates and converts project costs into a
+// reporting currency
+type ExchangeRateService struct {
+	repo            ExchangeRateRepository
+	projectRepo     ProjectRepository
+	costItemService *CostItemService
+}
+
+// NewExchangeRateService creates a new exchange rate service
+func NewExchangeRateService(repo ExchangeRateRepository, projectRepo ProjectRepository, costItemService *CostItemService) *ExchangeRateService {
+	return &ExchangeRateService{
+		repo:            repo,
+		projectRepo:     projectRepo,
+		costItemService: costItemService,
+	}
+}
+
+// CreateExchangeRate creates a new exchange rate
+func (s *ExchangeRateService) CreateExchangeRate(ctx context.Context, rate *entities.ExchangeRate) (*entities.ExchangeRate, error) {
+	if err := s.prepare(ctx, rate); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, rate)
+}
+
+// GetExchangeRate retrieves a single exchange rate by ID
+func (s *ExchangeRateService) GetExchangeRate(ctx context.Context, id uint) (*entities.ExchangeRate, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetExchangeRates retrieves multiple exchange rates with optional query parameters
+func (s *ExchangeRateService) GetExchangeRates(ctx context.Context, params *entities.ExchangeRateQueryParams) (*entities.ExchangeRateListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ExchangeRateListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateExchangeRate updates an existing exchange rate
+func (s *ExchangeRateService) UpdateExchangeRate(ctx context.Context, rate *entities.ExchangeRate) (int64, error) {
+	if err := s.prepare(ctx, rate); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, rate)
+}
+
+// DeleteExchangeRate deletes an exchange rate by ID
+func (s *ExchangeRateService) DeleteExchangeRate(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// prepare checks that no other rate between the same currencies takes effect on the same day
+func (s *ExchangeRateService) prepare(ctx context.Context, rate *entities.ExchangeRate) error {
+	if err := rate.Validate(); err != nil {
+		return err
+	}
+	existing, _, err := s.repo.GetMany(ctx, &entities.ExchangeRateQueryParams{FromCurrency: rate.FromCurrency, ToCurrency: rate.ToCurrency})
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if other.ID != rate.ID && rate.Duplicates(other) {
+			return entities.ErrExchangeRateDuplicate
+		}
+	}
+	return nil
+}
+
+// ConvertAmount converts an amount from one currency into another at the rate in effect on a date;
+// a zero date is today. The converted amount is rounded to the minor unit of the to currency.
+func (s *ExchangeRateService) ConvertAmount(ctx context.Context, amount entities.Money, from, to string, date time.Time) (*entities.CurrencyConversion, error) {
+	from, to = entities.NormalizeCurrency(from), entities.NormalizeCurrency(to)
+	if !entities.IsValidCurrency(from) || !entities.IsValidCurrency(to) {
+		return nil, entities.ErrExchangeRateInvalidCurrency
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	rates, err := s.loadExchangeRates(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := rates.rate(from, to, date)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.CurrencyConversion{
+		FromCurrency: from,
+		ToCurrency:   to,
+		Date:         date,
+		Rate:         rate,
+		Amount:       amount,
+		Converted:    amount.Mul(rate).Round(to),
+	}, nil
+}
+
+// GetPortfolioCost adds up the budget and cost of the selected projects, such as those of some
+// clients, in a reporting currency at the exchange rates in effect on a date; a zero date is today
+func (s *ExchangeRateService) GetPortfolioCost(ctx context.Context, params *entities.PortfolioCostParams) (*entities.PortfolioCost, error) {
+	if params == nil {
+		params = &entities.PortfolioCostParams{}
+	}
+	reporting := entities.NormalizeCurrency(params.ReportingCurrency)
+	if !entities.IsValidCurrency(reporting) {
+		return nil, entities.ErrPortfolioInvalidCurrency
+	}
+	date := params.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	projects, _, err := s.projectRepo.GetMany(ctx, &entities.ProjectQueryParams{ID_In: params.ProjectID_In, ClientID_In: params.ClientID_In, Status_In: params.Status_In})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	rates, err := s.loadExchangeRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.PortfolioCost{ReportingCurrency: reporting, Date: date, Projects: make([]*entities.PortfolioProjectCost, 0, len(projects))}
+	for _, project := range projects {
+		currency := project.Currency
+		if currency == "" {
+			currency = reporting
+		}
+		rate, err := rates.rate(currency, reporting, date)
+		if err != nil {
+			return nil, err
+		}
+		cost, err := s.costItemService.GetProjectCost(ctx, project.ID)
+		if err != nil {
+			return nil, err
+		}
+		convert := func(amount entities.Money) entities.Money {
+			return amount.Mul(rate).Round(reporting)
+		}
+
+		line := &entities.PortfolioProjectCost{
+			ProjectID:       project.ID,
+			Name:            project.Name,
+			ClientID:        project.ClientID,
+			Currency:        project.Currency,
+			Rate:            rate,
+			Budget:          project.Budget,
+			Cost:            cost.CostBreakdown,
+			ReportingBudget: convert(project.Budget),
+		}
+		line.ReportingCost.AddLabor(convert(cost.Labor))
+		line.ReportingCost.AddItems(convert(cost.Items))
+
+		report.Budget += line.ReportingBudget
+		report.AddLabor(line.ReportingCost.Labor)
+		report.AddItems(line.ReportingCost.Items)
+		report.Projects = append(report.Projects, line)
+	}
+	return report, nil
+}
+
+// loadExchangeRates loads the rates between the currencies, or all rates when none are given
+func (s *ExchangeRateService) loadExchangeRates(ctx context.Context, currencies ...string) (exchangeRates, error) {
+	rates, _, err := s.repo.GetMany(ctx, &entities.ExchangeRateQueryParams{FromCurrency_In: currencies, ToCurrency_In: currencies})
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// exchangeRates holds exchange rates, so that many amounts are converted without going back to the database
+type exchangeRates []*entities.ExchangeRate
+
+// rate returns the rate that converts the from currency into the to currency on the day: the latest
+// rate between them in effect then, or else one over the latest rate the other way round
+func (r exchangeRates) rate(from, to string, day time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	if latest := r.latest(from, to, day); latest != nil {
+		return latest.Rate, nil
+	}
+	if latest := r.latest(to, from, day); latest != nil {
+		return 1 / latest.Rate, nil
+	}
+	return 0, entities.ErrExchangeRateNotFound
+}
+
+// latest returns the rate from one currency to another that took effect last by the day, if any
+func (r exchangeRates) latest(from, to string, day time.Time) *entities.ExchangeRate {
+	var latest *entities.ExchangeRate
+	for _, rate := range r {
+		if rate.Converts(from, to) && rate.InEffectOn(day) && (latest == nil || rate.EffectiveFrom.After(latest.EffectiveFrom)) {
+			latest = rate
+		}
+	}
+	return latest
+}
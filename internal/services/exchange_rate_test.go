@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRateService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	projectRepo := repositories.NewProjectRepository(db)
+	hrRepo := repositories.NewHRRepository(db)
+	projectResourceRepo := repositories.NewProjectResourceRepository(db)
+	milestoneRepo := repositories.NewMilestoneRepository(db)
+	taskRepo := repositories.NewTaskRepository(db)
+	rateCardService := NewRateCardService(repositories.NewRateCardRepository(db), projectRepo, hrRepo, projectResourceRepo, repositories.NewProjectRoleRepository(db))
+	laborCostService := NewLaborCostService(projectRepo, projectResourceRepo, hrRepo, repositories.NewCalendarRepository(db), taskRepo, milestoneRepo, repositories.NewTaskAssignmentRepository(db), rateCardService)
+	costItemService := NewCostItemService(repositories.NewCostItemRepository(db), projectRepo, milestoneRepo, taskRepo, laborCostService)
+	service := NewExchangeRateService(repositories.NewExchangeRateRepository(db), projectRepo, costItemService)
+	ctx := context.Background()
+
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	rate := func(from, to string, value float64, effective time.Time) *entities.ExchangeRate {
+		created, err := service.CreateExchangeRate(ctx, &entities.ExchangeRate{FromCurrency: from, ToCurrency: to, Rate: value, EffectiveFrom: effective})
+		assert.NoError(t, err)
+		return created
+	}
+	// Client projects in EUR, USD and VND, each with a budget and one cost item
+	client := func(name, currency string, budget, item float64) *entities.Project {
+		project := createTestProjectForService(t, db, name)
+		assert.NoError(t, db.Model(project).Updates(map[string]interface{}{"currency": currency, "budget": entities.NewMoney(budget)}).Error)
+		start := day(time.January, 15)
+		_, err := costItemService.CreateCostItem(ctx, &entities.CostItem{Name: "Licence", ProjectID: project.ID, Amount: entities.NewMoney(item), StartDate: &start})
+		assert.NoError(t, err)
+		return project
+	}
+	paris := client("Paris", "EUR", 9000, 900)
+	boston := client("Boston", "USD", 5000, 250.5)
+	hanoi := client("Hanoi", "VND", 250000000, 2500000)
+	portfolio := []uint{paris.ID, boston.ID, hanoi.ID}
+
+	rate("usd", "eur", 0.9, day(time.January, 1))
+	raise := rate("USD", "EUR", 0.95, day(time.March, 1))
+	rate("USD", "VND", 25000, day(time.January, 1))
+
+	t.Run("CRUD", func(t *testing.T) {
+		_, err := service.CreateExchangeRate(ctx, &entities.ExchangeRate{FromCurrency: "USD", ToCurrency: "EUR", Rate: 0.93, EffectiveFrom: day(time.March, 1).Add(9 * time.Hour)})
+		assert.Equal(t, entities.ErrExchangeRateDuplicate, err)
+		_, err = service.CreateExchangeRate(ctx, &entities.ExchangeRate{FromCurrency: "USD", ToCurrency: "ABC", Rate: 1, EffectiveFrom: day(time.March, 1)})
+		assert.Equal(t, entities.ErrExchangeRateInvalidCurrency, err)
+
+		raise.Notes = " Central bank "
+		_, err = service.UpdateExchangeRate(ctx, raise)
+		assert.NoError(t, err)
+		got, err := service.GetExchangeRate(ctx, raise.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Central bank", got.Notes)
+
+		list, err := service.GetExchangeRates(ctx, &entities.ExchangeRateQueryParams{FromCurrency: "USD", ToCurrency: "EUR"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), list.Total)
+	})
+
+	t.Run("Convert an amount", func(t *testing.T) {
+		conversion, err := service.ConvertAmount(ctx, entities.NewMoney(12.34), "usd", "VND", day(time.February, 1))
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", conversion.FromCurrency)
+		assert.Equal(t, entities.NewMoney(308500), conversion.Converted)
+
+		// The inverse rate converts the other way round, rounded to cents
+		conversion, err = service.ConvertAmount(ctx, entities.NewMoney(100), "EUR", "USD", day(time.February, 1))
+		assert.NoError(t, err)
+		assert.InDelta(t, 1/0.9, conversion.Rate, 1e-12)
+		assert.Equal(t, entities.NewMoney(111.11), conversion.Converted)
+
+		// The later rate applies from its effective date
+		conversion, err = service.ConvertAmount(ctx, entities.NewMoney(100), "USD", "EUR", day(time.March, 1))
+		assert.NoError(t, err)
+		assert.Equal(t, entities.NewMoney(95), conversion.Converted)
+
+		_, err = service.ConvertAmount(ctx, entities.NewMoney(100), "USD", "EUR", day(time.January, 1).AddDate(0, 0, -1))
+		assert.Equal(t, entities.ErrExchangeRateNotFound, err)
+	})
+
+	t.Run("Portfolio cost", func(t *testing.T) {
+		report, err := service.GetPortfolioCost(ctx, &entities.PortfolioCostParams{ProjectID_In: portfolio, ReportingCurrency: "usd", Date: day(time.February, 1)})
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", report.ReportingCurrency)
+		if assert.Len(t, report.Projects, 3) {
+			assert.Equal(t, "Paris", report.Projects[0].Name)
+			assert.Equal(t, entities.NewMoney(900), report.Projects[0].Cost.Items)
+			assert.Equal(t, entities.NewMoney(10000), report.Projects[0].ReportingBudget)
+			assert.Equal(t, entities.NewMoney(1000), report.Projects[0].ReportingCost.Total)
+			assert.Equal(t, 1.0, report.Projects[1].Rate)
+			assert.Equal(t, entities.NewMoney(10000), report.Projects[2].ReportingBudget)
+			assert.Equal(t, entities.NewMoney(100), report.Projects[2].ReportingCost.Items)
+		}
+		assert.Equal(t, entities.NewMoney(25000), report.Budget)
+		assert.Zero(t, report.Labor)
+		assert.Equal(t, entities.NewMoney(1350.5), report.Items)
+		assert.Equal(t, entities.NewMoney(1350.5), report.Total)
+
+		// The same date gives the same totals; a later date takes the later rates
+		again, err := service.GetPortfolioCost(ctx, &entities.PortfolioCostParams{ProjectID_In: portfolio, ReportingCurrency: "USD", Date: day(time.February, 1)})
+		assert.NoError(t, err)
+		assert.Equal(t, report, again)
+		march, err := service.GetPortfolioCost(ctx, &entities.PortfolioCostParams{ProjectID_In: portfolio, ReportingCurrency: "USD", Date: day(time.March, 15)})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.NewMoney(9473.68), march.Projects[0].ReportingBudget)
+		assert.Equal(t, entities.NewMoney(947.37), march.Projects[0].ReportingCost.Items)
+
+		// VND has no minor unit
+		dong, err := service.GetPortfolioCost(ctx, &entities.PortfolioCostParams{ProjectID_In: []uint{boston.ID}, ReportingCurrency: "VND", Date: day(time.February, 1)})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.NewMoney(6262500), dong.Items)
+	})
+
+	t.Run("Portfolio cost errors", func(t *testing.T) {
+		_, err := service.GetPortfolioCost(ctx, &entities.PortfolioCostParams{ProjectID_In: portfolio, ReportingCurrency: "dollars"})
+		assert.Equal(t, entities.ErrPortfolioInvalidCurrency, err)
+		_, err = service.GetPortfolioCost(ctx, &entities.PortfolioCostParams{ProjectID_In: portfolio, ReportingCurrency: "JPY", Date: day(time.February, 1)})
+		assert.Equal(t, entities.ErrExchangeRateNotFound, err)
+	})
+}
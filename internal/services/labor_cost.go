@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// LaborCostService calculates the labor cost of projects from their resource allocations and rate cards
+type LaborCostService struct {
+	projectRepo         ProjectRepository
+	projectResourceRepo ProjectResourceRepository
+	humanResourceRepo   HumanResourceRepository
+	calendarRepo        CalendarRepository
+	taskRepo            TaskRepository
+	milestoneRepo       MilestoneRepository
+	assignmentRepo      TaskAssignmentRepository
+	rateCardService     *RateCardService
+}
+
+// NewLaborCostService creates a new labor cost service
+func NewLaborCostService(projectRepo ProjectRepository, projectResourceRepo ProjectResourceRepository, humanResourceRepo HumanResourceRepository, calendarRepo CalendarRepository, taskRepo TaskRepository, milestoneRepo MilestoneRepository, assignmentRepo TaskAssignmentRepository, rateCardService *RateCardService) *LaborCostService {
+	return &LaborCostService{
+		projectRepo:         projectRepo,
+		projectResourceRepo: projectResourceRepo,
+		humanResourceRepo:   humanResourceRepo,
+		calendarRepo:        calendarRepo,
+		taskRepo:            taskRepo,
+		milestoneRepo:       milestoneRepo,
+		assignmentRepo:      assignmentRepo,
+		rateCardService:     rateCardService,
+	}
+}
+
+// GetProjectLaborCost calculates the labor cost of a project's active resource allocations and breaks
+// it down per resource, role, milestone and month. Each working day of an allocation, from its own
+// dates or else the project's, costs the calendar's hours that day times the allocation percentage at
+// the hourly rate of the rate card that applies that day; a fixed rate is paid once, on the first day
+// it applies. Nothing is saved; RecalculateProjectCosts keeps the cost of the allocations up to date.
+func (s *LaborCostService) GetProjectLaborCost(ctx context.Context, projectID uint) (*entities.ProjectLaborCost, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := projectCalendar(ctx, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+	allocations, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{ProjectID: projectID, Status: entities.ProjectResourceStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	people := make(map[uint]*entities.HumanResource)
+	if len(allocations) > 0 {
+		ids := make([]uint, 0, len(allocations))
+		for _, pr := range allocations {
+			ids = append(ids, pr.HumanResourceID)
+		}
+		list, _, err := s.humanResourceRepo.GetMany(ctx, &entities.HumanResourceQueryParams{ID_In: ids})
+		if err != nil {
+			return nil, err
+		}
+		for _, hr := range list {
+			people[hr.ID] = hr
+		}
+	}
+
+	report := &entities.ProjectLaborCost{ProjectID: projectID, Currency: project.Currency}
+	months := make(map[string]*entities.MonthLaborCost)
+	type roleKey struct {
+		name  string
+		level uint
+	}
+	roles := make(map[roleKey]*entities.RoleLaborCost)
+	hoursPerDay, daysPerMonth := float64(project.GetHoursPerDay()), project.GetDaysPerMonth()
+
+	for _, pr := range allocations {
+		rates, err := s.rateCardService.loadPersonRates(ctx, pr.HumanResourceID, projectID)
+		if err != nil {
+			return nil, err
+		}
+		line := &entities.ResourceLaborCost{
+			ProjectResourceID: pr.ID,
+			HumanResourceID:   pr.HumanResourceID,
+			RoleName:          rates.roleName,
+			RoleLevel:         rates.roleLevel,
+			Allocation:        pr.Allocation,
+			StartDate:         inProjectZone(project, firstDate(pr.StartDate, project.StartDate)),
+			EndDate:           inProjectZone(project, firstDate(pr.EndDate, project.EndDate)),
+			CostOverride:      pr.CostOverride,
+		}
+		if hr := people[pr.HumanResourceID]; hr != nil {
+			line.Name = hr.Name
+		}
+
+		// Hours and calculated cost per month
+		byMonth := make(map[string]*entities.LaborCost)
+		var order []string
+		spend := func(day time.Time, hours float64, cost entities.Money) {
+			key := day.Format("2006-01")
+			if byMonth[key] == nil {
+				byMonth[key] = &entities.LaborCost{}
+				order = append(order, key)
+			}
+			byMonth[key].Add(hours, cost)
+			line.Add(hours, cost)
+		}
+		if line.StartDate != nil && line.EndDate != nil {
+			paid := make(map[uint]bool)
+			for day := project.DateOf(*line.StartDate); !day.After(*line.EndDate); day = day.AddDate(0, 0, 1) {
+				hours := calendar.HoursOn(day) * pr.Allocation / 100
+				if pr.CostOverride {
+					spend(day, hours, 0)
+					continue
+				}
+				card, _ := rates.on(day)
+				if card == nil || !sameCurrency(card.Currency, project.Currency) {
+					line.UnpricedHours += hours
+					spend(day, hours, 0)
+					continue
+				}
+				if card.RateType == entities.RateTypeFixed {
+					var cost entities.Money
+					if !paid[card.ID] {
+						cost, paid[card.ID] = card.CostRate, true
+					}
+					spend(day, hours, cost)
+					continue
+				}
+				rate, _ := card.HourlyRates(hoursPerDay, daysPerMonth)
+				spend(day, hours, entities.NewMoney(hours*rate))
+			}
+		}
+
+		if pr.CostOverride {
+			// Spread the cost entered by hand over the months by hours
+			hours := line.Hours
+			for _, m := range byMonth {
+				m.Cost = 0
+				if hours > 0 {
+					m.Cost = pr.Cost.Mul(m.Hours / hours)
+				}
+			}
+			line.Cost = pr.Cost
+		}
+
+		for _, key := range order {
+			if months[key] == nil {
+				months[key] = &entities.MonthLaborCost{Month: key}
+			}
+			months[key].Add(byMonth[key].Hours, byMonth[key].Cost)
+		}
+		role := roleKey{strings.ToLower(line.RoleName), line.RoleLevel}
+		if roles[role] == nil {
+			roles[role] = &entities.RoleLaborCost{RoleName: line.RoleName, RoleLevel: line.RoleLevel}
+		}
+		roles[role].Add(line.Hours, line.Cost)
+		report.Add(line.Hours, line.Cost)
+		report.UnpricedHours += line.UnpricedHours
+		report.Resources = append(report.Resources, line)
+	}
+
+	for _, m := range months {
+		report.Months = append(report.Months, m)
+	}
+	sort.Slice(report.Months, func(i, j int) bool { return report.Months[i].Month < report.Months[j].Month })
+	for _, r := range roles {
+		report.Roles = append(report.Roles, r)
+	}
+	sort.Slice(report.Roles, func(i, j int) bool {
+		if !strings.EqualFold(report.Roles[i].RoleName, report.Roles[j].RoleName) {
+			return strings.ToLower(report.Roles[i].RoleName) < strings.ToLower(report.Roles[j].RoleName)
+		}
+		return report.Roles[i].RoleLevel < report.Roles[j].RoleLevel
+	})
+
+	if report.Milestones, err = s.milestoneCosts(ctx, projectID, report.Resources); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// RecalculateProjectCosts saves the calculated labor cost on the active allocations of a project
+// whose cost is not overridden, so that scenarios, simulations and buffers that read the cost of an
+// allocation follow its rates. It runs after a change to the allocations, rates or calendars.
+func (s *LaborCostService) RecalculateProjectCosts(ctx context.Context, projectID uint) error {
+	report, err := s.GetProjectLaborCost(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	costs := make(map[uint]entities.Money, len(report.Resources))
+	for _, line := range report.Resources {
+		costs[line.ProjectResourceID] = line.Cost
+	}
+	allocations, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{ProjectID: projectID, Status: entities.ProjectResourceStatusActive})
+	if err != nil {
+		return err
+	}
+	for _, pr := range allocations {
+		cost, ok := costs[pr.ID]
+		if !ok || pr.CostOverride || pr.Cost == cost {
+			continue
+		}
+		pr.Cost = cost
+		if _, err := s.projectResourceRepo.Update(ctx, pr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecalculateAllCosts recalculates the labor cost of the allocations of every project, after a change
+// that can reach any of them such as a standard rate or a calendar
+func (s *LaborCostService) RecalculateAllCosts(ctx context.Context) error {
+	projects, _, err := s.projectRepo.GetMany(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		if err := s.RecalculateProjectCosts(ctx, project.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// milestoneCosts shares the labor cost of each resource among the milestones of the tasks it is
+// assigned to, by planned hours. Resources without planned hours and tasks without a milestone
+// make up the labor not planned on a milestone.
+func (s *LaborCostService) milestoneCosts(ctx context.Context, projectID uint, resources []*entities.ResourceLaborCost) ([]*entities.MilestoneLaborCost, error) {
+	milestones, _, err := s.milestoneRepo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+
+	sortMilestonesByEndDate(milestones)
+	costs := make([]*entities.MilestoneLaborCost, 0, len(milestones)+1)
+	byMilestone := make(map[uint]*entities.MilestoneLaborCost, len(milestones))
+	for _, m := range milestones {
+		id := m.ID
+		byMilestone[m.ID] = &entities.MilestoneLaborCost{MilestoneID: &id, Name: m.Name}
+		costs = append(costs, byMilestone[m.ID])
+	}
+	unplanned := &entities.MilestoneLaborCost{}
+
+	taskMilestone := make(map[uint]*entities.MilestoneLaborCost, len(tasks))
+	for _, t := range tasks {
+		if t.MilestoneID != nil {
+			taskMilestone[t.ID] = byMilestone[*t.MilestoneID]
+		}
+	}
+	planned := make(map[uint]map[*entities.MilestoneLaborCost]float64)
+	plannedTotal := make(map[uint]float64)
+	for _, a := range assignments {
+		if a.PlannedHours <= 0 {
+			continue
+		}
+		m := taskMilestone[a.TaskID]
+		if m == nil {
+			m = unplanned
+		}
+		if planned[a.ProjectResourceID] == nil {
+			planned[a.ProjectResourceID] = make(map[*entities.MilestoneLaborCost]float64)
+		}
+		planned[a.ProjectResourceID][m] += a.PlannedHours
+		plannedTotal[a.ProjectResourceID] += a.PlannedHours
+	}
+
+	for _, r := range resources {
+		total := plannedTotal[r.ProjectResourceID]
+		if total == 0 {
+			unplanned.Add(r.Hours, r.Cost)
+			continue
+		}
+		for m, hours := range planned[r.ProjectResourceID] {
+			m.Add(r.Hours*hours/total, r.Cost.Mul(hours/total))
+		}
+	}
+	return append(costs, unplanned), nil
+}
+
+// sortMilestonesByEndDate orders milestones by end date, those without one last
+func sortMilestonesByEndDate(milestones []*entities.Milestone) {
+	sort.Slice(milestones, func(i, j int) bool {
+		a, b := milestones[i].EndDate, milestones[j].EndDate
+		if (a == nil) != (b == nil) {
+			return b == nil
+		}
+		if a != nil && !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return milestones[i].ID < milestones[j].ID
+	})
+}
+
+// firstDate returns the first of the dates that is set
+func firstDate(dates ...*time.Time) *time.Time {
+	for _, d := range dates {
+		if d != nil {
+			return d
+		}
+	}
+	return nil
+}
+
+// sameCurrency returns true if an amount in the rate currency can be added to one in the project
+// currency. A rate or project without a currency is taken to be in the other's.
+func sameCurrency(rate, project string) bool {
+	return rate == "" || project == "" || strings.EqualFold(rate, project)
+}
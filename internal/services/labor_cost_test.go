@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaborCostService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	projectRepo := repositories.NewProjectRepository(db)
+	hrRepo := repositories.NewHRRepository(db)
+	projectResourceRepo := repositories.NewProjectResourceRepository(db)
+	rateCardService := NewRateCardService(repositories.NewRateCardRepository(db), projectRepo, hrRepo, projectResourceRepo, repositories.NewProjectRoleRepository(db))
+	service := NewLaborCostService(
+		projectRepo,
+		projectResourceRepo,
+		hrRepo,
+		repositories.NewCalendarRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewMilestoneRepository(db),
+		repositories.NewTaskAssignmentRepository(db),
+		rateCardService,
+	)
+	ctx := context.Background()
+
+	day := func(month time.Month, d int) *time.Time {
+		date := time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	project := createScheduledTestProject(t, db, "Labor")
+	assert.NoError(t, db.Model(project).Updates(map[string]interface{}{"end_date": day(time.February, 27), "currency": "EUR"}).Error)
+
+	allocate := func(name, role string, allocation float64, start, end *time.Time, cost float64, override bool) *entities.ProjectResource {
+		hr := createTestHumanResourceForService(t, db, name)
+		pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Role: role, Allocation: allocation, StartDate: start, EndDate: end, Cost: entities.NewMoney(cost), CostOverride: override}
+		assert.NoError(t, db.Create(pr).Error)
+		return pr
+	}
+	rate := func(card *entities.RateCard) {
+		assert.NoError(t, db.Create(card).Error)
+	}
+
+	// Alice works the whole project at a person rate raised in February
+	alice := allocate("Alice", "", 100, nil, nil, 0, false)
+	rate(&entities.RateCard{HumanResourceID: &alice.HumanResourceID, CostRate: entities.NewMoney(50), Currency: "EUR", EffectiveFrom: *day(time.January, 1), EffectiveTo: day(time.January, 31)})
+	rate(&entities.RateCard{HumanResourceID: &alice.HumanResourceID, CostRate: entities.NewMoney(60), EffectiveFrom: *day(time.February, 1)})
+	// Bob works half time for two weeks at the project's daily rate of senior developers
+	bob := allocate("Bob", "Developer", 50, day(time.February, 2), day(time.February, 13), 0, false)
+	rate(&entities.RateCard{ProjectID: &project.ID, RoleName: "Developer", RoleLevel: entities.RoleLevelSenior, RateType: entities.RateTypeDaily, CostRate: entities.NewMoney(400), EffectiveFrom: *day(time.January, 1)})
+	// Carol is a fixed-fee contractor
+	carol := allocate("Carol", "", 100, day(time.January, 5), day(time.January, 16), 5000, true)
+	// Dave only has a rate in another currency
+	dave := allocate("Dave", "", 100, day(time.January, 5), day(time.January, 9), 0, false)
+	rate(&entities.RateCard{HumanResourceID: &dave.HumanResourceID, CostRate: entities.NewMoney(40), Currency: "USD", EffectiveFrom: *day(time.January, 1)})
+	// Inactive allocations cost nothing
+	inactive := allocate("Eve", "", 100, nil, nil, 0, false)
+	assert.NoError(t, db.Model(inactive).Update("status", entities.ProjectResourceStatusInactive).Error)
+
+	m1 := createTestMilestoneForService(t, db, project.ID, "Alpha", day(time.January, 30))
+	m2 := createTestMilestoneForService(t, db, project.ID, "Beta", day(time.February, 27))
+	t1 := createTestTaskForService(t, db, project.ID, "Build", nil)
+	t2 := createTestTaskForService(t, db, project.ID, "Test", nil)
+	t3 := createTestTaskForService(t, db, project.ID, "Support", nil)
+	assert.NoError(t, db.Model(t1).Update("milestone_id", m1.ID).Error)
+	assert.NoError(t, db.Model(t2).Update("milestone_id", m2.ID).Error)
+	for _, a := range []*entities.TaskAssignment{
+		{TaskID: t1.ID, ProjectResourceID: alice.ID, HumanResourceID: alice.HumanResourceID, PlannedHours: 30},
+		{TaskID: t2.ID, ProjectResourceID: alice.ID, HumanResourceID: alice.HumanResourceID, PlannedHours: 10},
+		{TaskID: t2.ID, ProjectResourceID: bob.ID, HumanResourceID: bob.HumanResourceID, PlannedHours: 20},
+		{TaskID: t3.ID, ProjectResourceID: dave.ID, HumanResourceID: dave.HumanResourceID, PlannedHours: 8},
+	} {
+		assert.NoError(t, db.Create(a).Error)
+	}
+
+	report, err := service.GetProjectLaborCost(ctx, project.ID)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	t.Run("Totals", func(t *testing.T) {
+		assert.Equal(t, "EUR", report.Currency)
+		assert.InDelta(t, 480.0, report.Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(24600), report.Cost)
+		assert.InDelta(t, 40.0, report.UnpricedHours, 1e-9)
+	})
+
+	t.Run("Per resource", func(t *testing.T) {
+		if !assert.Len(t, report.Resources, 4) {
+			return
+		}
+		costs := make(map[uint]*entities.ResourceLaborCost)
+		for _, r := range report.Resources {
+			costs[r.ProjectResourceID] = r
+		}
+		assert.InDelta(t, 320.0, costs[alice.ID].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(17600), costs[alice.ID].Cost)
+		assert.Equal(t, "Alice", costs[alice.ID].Name)
+		assert.InDelta(t, 40.0, costs[bob.ID].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(2000), costs[bob.ID].Cost)
+		assert.InDelta(t, 80.0, costs[carol.ID].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(5000), costs[carol.ID].Cost)
+		assert.True(t, costs[carol.ID].CostOverride)
+		assert.InDelta(t, 40.0, costs[dave.ID].UnpricedHours, 1e-9)
+		assert.Zero(t, costs[dave.ID].Cost)
+	})
+
+	t.Run("Calculated costs are saved unless overridden", func(t *testing.T) {
+		// Reading the report saves nothing
+		pr, err := projectResourceRepo.GetOne(ctx, alice.ID)
+		assert.NoError(t, err)
+		assert.Zero(t, pr.Cost)
+
+		assert.NoError(t, service.RecalculateProjectCosts(ctx, project.ID))
+		for id, want := range map[uint]float64{alice.ID: 17600, bob.ID: 2000, carol.ID: 5000, dave.ID: 0} {
+			pr, err := projectResourceRepo.GetOne(ctx, id)
+			assert.NoError(t, err)
+			assert.Equal(t, entities.NewMoney(want), pr.Cost)
+		}
+	})
+
+	t.Run("Per role", func(t *testing.T) {
+		if !assert.Len(t, report.Roles, 2) {
+			return
+		}
+		assert.Equal(t, "Developer", report.Roles[0].RoleName)
+		assert.Equal(t, entities.NewMoney(2000), report.Roles[0].Cost)
+		assert.Equal(t, "Engineer", report.Roles[1].RoleName)
+		assert.Equal(t, uint(entities.RoleLevelSenior), report.Roles[1].RoleLevel)
+		assert.InDelta(t, 440.0, report.Roles[1].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(22600), report.Roles[1].Cost)
+	})
+
+	t.Run("Per milestone", func(t *testing.T) {
+		if !assert.Len(t, report.Milestones, 3) {
+			return
+		}
+		assert.Equal(t, "Alpha", report.Milestones[0].Name)
+		assert.InDelta(t, 240.0, report.Milestones[0].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(13200), report.Milestones[0].Cost)
+		assert.Equal(t, "Beta", report.Milestones[1].Name)
+		assert.InDelta(t, 120.0, report.Milestones[1].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(6400), report.Milestones[1].Cost)
+		assert.Nil(t, report.Milestones[2].MilestoneID)
+		assert.InDelta(t, 120.0, report.Milestones[2].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(5000), report.Milestones[2].Cost)
+	})
+
+	t.Run("Per month", func(t *testing.T) {
+		if !assert.Len(t, report.Months, 2) {
+			return
+		}
+		assert.Equal(t, "2026-01", report.Months[0].Month)
+		assert.InDelta(t, 280.0, report.Months[0].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(13000), report.Months[0].Cost)
+		assert.Equal(t, "2026-02", report.Months[1].Month)
+		assert.InDelta(t, 200.0, report.Months[1].Hours, 1e-9)
+		assert.Equal(t, entities.NewMoney(11600), report.Months[1].Cost)
+	})
+}
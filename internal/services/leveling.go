@@ -0,0 +1,442 @@
+package services
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// maxLevelingIterations bounds a leveling run; every iteration delays one task or gives up on one overload
+const maxLevelingIterations = 1000
+
+// TaskBookingSource provides how much of each human resource's time the tasks of the given projects take
+type TaskBookingSource interface {
+	GetTaskBookings(ctx context.Context, projectIDs []uint) ([]*entities.TaskBooking, error)
+}
+
+// LevelingService resolves human resources booked over 100% across projects by delaying tasks
+type LevelingService struct {
+	projectRepo         ProjectRepository
+	taskRepo            TaskRepository
+	dependencyRepo      TaskDependencyRepository
+	calendarRepo        CalendarRepository
+	projectResourceRepo ProjectResourceRepository
+	bookings            TaskBookingSource
+}
+
+// NewLevelingService creates a new leveling service. A nil booking source means no task is booked.
+func NewLevelingService(projectRepo ProjectRepository, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository, projectResourceRepo ProjectResourceRepository, bookings TaskBookingSource) *LevelingService {
+	return &LevelingService{
+		projectRepo:         projectRepo,
+		taskRepo:            taskRepo,
+		dependencyRepo:      dependencyRepo,
+		calendarRepo:        calendarRepo,
+		projectResourceRepo: projectResourceRepo,
+		bookings:            bookings,
+	}
+}
+
+// PreviewResourceLeveling levels all active projects and reports the moves without saving them
+func (s *LevelingService) PreviewResourceLeveling(ctx context.Context) (*entities.LevelingReport, error) {
+	return s.level(ctx, true)
+}
+
+// ApplyResourceLeveling levels all active projects and saves the leveling delays of their tasks
+func (s *LevelingService) ApplyResourceLeveling(ctx context.Context) (*entities.LevelingReport, error) {
+	return s.level(ctx, false)
+}
+
+// levelingTask is a task of a leveled project with its bookings
+type levelingTask struct {
+	plan     *projectPlan
+	task     *entities.Task
+	bookings map[uint]float64 // Units by human resource ID
+	schedule *entities.TaskSchedule
+}
+
+// resettable returns true if leveling may change the delay of the task. Started and finished
+// work stays where it is, and so does a task that must start on a given date.
+func (lt *levelingTask) resettable() bool {
+	if lt.task.ConstraintType == entities.TaskConstraintMustStartOn {
+		return false
+	}
+	return lt.task.Status == entities.TaskWorkStatusUnknown || lt.task.Status == entities.TaskWorkStatusToDo
+}
+
+// movable returns true if leveling may delay the task: it can be reset and is not critical
+func (lt *levelingTask) movable() bool {
+	return lt.resettable() && !lt.critical()
+}
+
+// critical returns true if the task has no float left in the current schedule
+func (lt *levelingTask) critical() bool {
+	return lt.schedule != nil && lt.schedule.TotalFloat <= scheduleEpsilon
+}
+
+// resourceDay identifies a calendar day of a human resource
+type resourceDay struct {
+	humanResourceID uint
+	day             int
+}
+
+// resourceDayLoad collects what a human resource is booked for on a calendar day
+type resourceDayLoad struct {
+	date   time.Time                   // Start of the day in the zone of the first project seen
+	booked map[uint]float64            // Booked units by project ID
+	tasks  []*levelingTask             // Booked tasks running on the day
+	starts map[*levelingTask]time.Time // Start of the day in each task's project zone
+}
+
+// leveling is the state of one leveling run
+type leveling struct {
+	plans       []*projectPlan
+	tasks       []*levelingTask
+	allocations map[uint][]*entities.ProjectResource // Active project resources by human resource ID
+	delayedFor  map[uint]uint                        // Overloaded human resource ID by delayed task ID
+}
+
+// level runs the leveling engine. Starting from no delays on tasks that have not started,
+// it repeatedly takes the earliest overloaded day and delays the least important booked
+// task running on it to the next working day, then reschedules so successors follow.
+// Only non-critical tasks are delayed, and never past their total float, so no critical
+// task moves and no project finishes later. Tasks are kept in place by, in order: having
+// started, a higher priority, an earlier start and a lower ID. The most important task is
+// never delayed. Overloads that only critical or started tasks could resolve are reported
+// as unresolved.
+func (s *LevelingService) level(ctx context.Context, preview bool) (*entities.LevelingReport, error) {
+	l, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remember the schedule with the saved delays, then start over from no delays. A delay that
+	// used up the float of its task makes it critical, so criticality is judged without delays.
+	stored := make(map[uint]float64, len(l.tasks))
+	before := make(map[uint]*entities.TaskSchedule, len(l.tasks))
+	l.schedule()
+	for _, lt := range l.tasks {
+		stored[lt.task.ID] = lt.task.LevelingDelay
+		before[lt.task.ID] = lt.schedule
+		if lt.resettable() {
+			lt.task.LevelingDelay = 0
+		}
+	}
+	l.schedule()
+	wasCritical := make(map[uint]bool, len(l.tasks))
+	for _, lt := range l.tasks {
+		wasCritical[lt.task.ID] = lt.schedule.IsCritical
+	}
+
+	report := &entities.LevelingReport{
+		Preview:    preview,
+		ProjectIDs: []uint{},
+		Moves:      []*entities.LevelingMove{},
+		Unresolved: []*entities.ResourceOverload{},
+	}
+	for _, plan := range l.plans {
+		report.ProjectIDs = append(report.ProjectIDs, plan.project.ID)
+	}
+
+	skipped := make(map[resourceDay]bool)
+	for report.Iterations < maxLevelingIterations {
+		l.schedule()
+		key, load, ok := l.firstOverload(skipped)
+		if !ok {
+			break
+		}
+		report.Iterations++
+		if !l.delayOne(key, load) {
+			skipped[key] = true
+		}
+	}
+
+	l.schedule()
+	for _, key := range l.overloadedDays(nil) {
+		report.Unresolved = append(report.Unresolved, l.overload(key))
+	}
+
+	delays := make(map[uint]float64)
+	for _, lt := range l.tasks {
+		if math.Abs(lt.task.LevelingDelay-stored[lt.task.ID]) > scheduleEpsilon {
+			delays[lt.task.ID] = lt.task.LevelingDelay
+		}
+		old, now := before[lt.task.ID], lt.schedule
+		if old.IsSummary || (old.EarlyStart.Equal(now.EarlyStart) && old.EarlyFinish.Equal(now.EarlyFinish)) {
+			continue
+		}
+		report.Moves = append(report.Moves, &entities.LevelingMove{
+			TaskID:          lt.task.ID,
+			TaskName:        lt.task.Name,
+			ProjectID:       lt.task.ProjectID,
+			HumanResourceID: l.delayedFor[lt.task.ID],
+			OldStart:        old.EarlyStart,
+			NewStart:        now.EarlyStart,
+			OldFinish:       old.EarlyFinish,
+			NewFinish:       now.EarlyFinish,
+			DelayHours:      lt.task.LevelingDelay,
+			WasCritical:     wasCritical[lt.task.ID],
+		})
+	}
+	sort.SliceStable(report.Moves, func(i, j int) bool {
+		if !report.Moves[i].NewStart.Equal(report.Moves[j].NewStart) {
+			return report.Moves[i].NewStart.Before(report.Moves[j].NewStart)
+		}
+		return report.Moves[i].TaskID < report.Moves[j].TaskID
+	})
+
+	if !preview && len(delays) > 0 {
+		if err := s.taskRepo.UpdateLevelingDelays(ctx, delays); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// load reads the active projects that have a start date, their tasks, bookings and allocations
+func (s *LevelingService) load(ctx context.Context) (*leveling, error) {
+	projects, _, err := s.projectRepo.GetMany(ctx, &entities.ProjectQueryParams{Status: entities.ProjectStatusActive})
+	if err != nil {
+		return nil, err
+	}
+
+	l := &leveling{
+		allocations: make(map[uint][]*entities.ProjectResource),
+		delayedFor:  make(map[uint]uint),
+	}
+	byID := make(map[uint]*levelingTask)
+	projectIDs := make([]uint, 0, len(projects))
+	for _, project := range projects {
+		if project.StartDate == nil {
+			continue
+		}
+		plan, err := loadProjectPlan(ctx, s.taskRepo, s.dependencyRepo, s.calendarRepo, project)
+		if err != nil {
+			return nil, err
+		}
+		l.plans = append(l.plans, plan)
+		projectIDs = append(projectIDs, project.ID)
+		for _, task := range plan.network.tasks {
+			lt := &levelingTask{plan: plan, task: task, bookings: make(map[uint]float64)}
+			l.tasks = append(l.tasks, lt)
+			byID[task.ID] = lt
+		}
+	}
+	if len(projectIDs) == 0 {
+		return l, nil
+	}
+
+	if s.bookings != nil {
+		bookings, err := s.bookings.GetTaskBookings(ctx, projectIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bookings {
+			if lt, ok := byID[b.TaskID]; ok && b.Units > 0 {
+				lt.bookings[b.HumanResourceID] += b.Units
+			}
+		}
+	}
+
+	resources, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{
+		ProjectID_In: projectIDs,
+		Status:       entities.ProjectResourceStatusActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pr := range resources {
+		l.allocations[pr.HumanResourceID] = append(l.allocations[pr.HumanResourceID], pr)
+	}
+	return l, nil
+}
+
+// schedule recomputes the schedule of every project with the current delays
+func (l *leveling) schedule() {
+	for _, plan := range l.plans {
+		schedule := plan.schedule()
+		for _, lt := range l.tasks {
+			if lt.plan == plan {
+				lt.schedule = schedule.GetTask(lt.task.ID)
+			}
+		}
+	}
+}
+
+// loads returns what each human resource is booked for on the days booked tasks run
+func (l *leveling) loads(exclude *levelingTask) map[resourceDay]*resourceDayLoad {
+	loads := make(map[resourceDay]*resourceDayLoad)
+	for _, lt := range l.tasks {
+		if lt == exclude || len(lt.bookings) == 0 || lt.schedule.IsSummary || lt.schedule.DurationHours <= scheduleEpsilon {
+			continue
+		}
+		for _, dayStart := range lt.workingDays() {
+			for hrID, units := range lt.bookings {
+				key := resourceDay{humanResourceID: hrID, day: entities.DateKey(dayStart)}
+				load, ok := loads[key]
+				if !ok {
+					load = &resourceDayLoad{date: dayStart, booked: make(map[uint]float64), starts: make(map[*levelingTask]time.Time)}
+					loads[key] = load
+				}
+				load.booked[lt.task.ProjectID] += units
+				load.tasks = append(load.tasks, lt)
+				load.starts[lt] = dayStart
+			}
+		}
+	}
+	return loads
+}
+
+// percent returns the total load of a human resource on a day. Within a project the
+// resource counts for the larger of its allocation and its task bookings; projects add up.
+func (l *leveling) percent(key resourceDay, load *resourceDayLoad) float64 {
+	byProject := make(map[uint]float64, len(load.booked))
+	for projectID, units := range load.booked {
+		byProject[projectID] = units
+	}
+	for _, pr := range l.allocations[key.humanResourceID] {
+		if l.allocationCovers(pr, key.day) {
+			byProject[pr.ProjectID] = math.Max(byProject[pr.ProjectID], pr.Allocation)
+		}
+	}
+	total := 0.0
+	for _, units := range byProject {
+		total += units
+	}
+	return total
+}
+
+// allocationCovers returns true if the project resource allocation runs on the calendar day
+func (l *leveling) allocationCovers(pr *entities.ProjectResource, day int) bool {
+	for _, plan := range l.plans {
+		if plan.project.ID != pr.ProjectID {
+			continue
+		}
+		if pr.StartDate != nil && entities.DateKey(plan.project.DateOf(*pr.StartDate)) > day {
+			return false
+		}
+		if pr.EndDate != nil && entities.DateKey(plan.project.DateOf(*pr.EndDate)) < day {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// overloadedDays returns the overloaded resource days ordered by day, then human resource
+func (l *leveling) overloadedDays(skipped map[resourceDay]bool) []resourceDay {
+	var keys []resourceDay
+	for key, load := range l.loads(nil) {
+		if !skipped[key] && l.percent(key, load) > entities.FullTimeUnits+scheduleEpsilon {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].day != keys[j].day {
+			return keys[i].day < keys[j].day
+		}
+		return keys[i].humanResourceID < keys[j].humanResourceID
+	})
+	return keys
+}
+
+// firstOverload returns the earliest overloaded resource day that was not given up on
+func (l *leveling) firstOverload(skipped map[resourceDay]bool) (resourceDay, *resourceDayLoad, bool) {
+	keys := l.overloadedDays(skipped)
+	if len(keys) == 0 {
+		return resourceDay{}, nil, false
+	}
+	return keys[0], l.loads(nil)[keys[0]], true
+}
+
+// overload describes an overloaded resource day for the report
+func (l *leveling) overload(key resourceDay) *entities.ResourceOverload {
+	load := l.loads(nil)[key]
+	o := &entities.ResourceOverload{
+		HumanResourceID: key.humanResourceID,
+		Date:            load.date,
+		LoadPercent:     l.percent(key, load),
+		TaskIDs:         make([]uint, 0, len(load.tasks)),
+	}
+	for _, lt := range load.tasks {
+		o.TaskIDs = append(o.TaskIDs, lt.task.ID)
+	}
+	slices.Sort(o.TaskIDs)
+	return o
+}
+
+// delayOne delays the least important task that lowers the load of the overloaded day.
+// It returns false if no task can be delayed.
+func (l *leveling) delayOne(key resourceDay, load *resourceDayLoad) bool {
+	tasks := slices.Clone(load.tasks)
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return levelingKeeps(tasks[i], tasks[j])
+	})
+
+	current := l.percent(key, load)
+	for i := len(tasks) - 1; i > 0; i-- {
+		lt := tasks[i]
+		if !lt.movable() {
+			continue
+		}
+		// A task that overloads the resource on its own, next to the allocations
+		// to other projects, would be overloaded on any later day as well
+		alone := &resourceDayLoad{booked: map[uint]float64{lt.task.ProjectID: lt.bookings[key.humanResourceID]}}
+		if l.percent(key, alone) > entities.FullTimeUnits+scheduleEpsilon {
+			continue
+		}
+		without := l.loads(lt)[key]
+		if without != nil && l.percent(key, without) >= current-scheduleEpsilon {
+			continue
+		}
+
+		plan := lt.plan
+		next := plan.calendar.NextWorkingTime(load.starts[lt].AddDate(0, 0, 1))
+		delta := plan.calendar.WorkingHoursBetween(plan.startDate(), next) - plan.network.startNode[lt.task.ID].es
+		// Delaying past the float would move critical successors or the project finish
+		if delta <= scheduleEpsilon || delta > lt.schedule.TotalFloat+scheduleEpsilon {
+			continue
+		}
+		lt.task.LevelingDelay += delta
+		l.delayedFor[lt.task.ID] = key.humanResourceID
+		return true
+	}
+	return false
+}
+
+// levelingKeeps returns true if task a has a stronger claim than task b to stay in place
+func levelingKeeps(a, b *levelingTask) bool {
+	if a.movable() != b.movable() {
+		return !a.movable()
+	}
+	if a.task.Priority != b.task.Priority {
+		return a.task.Priority > b.task.Priority
+	}
+	if !a.schedule.EarlyStart.Equal(b.schedule.EarlyStart) {
+		return a.schedule.EarlyStart.Before(b.schedule.EarlyStart)
+	}
+	return a.task.ID < b.task.ID
+}
+
+// workingDays returns the start of each calendar day, in the project zone, on which the task does work
+func (lt *levelingTask) workingDays() []time.Time {
+	calendar := lt.plan.calendar
+	start, finish := lt.schedule.EarlyStart, lt.schedule.EarlyFinish
+	var days []time.Time
+	for day := lt.plan.project.DateOf(start); day.Before(finish); day = day.AddDate(0, 0, 1) {
+		from, to := day, day.AddDate(0, 0, 1)
+		if start.After(from) {
+			from = start
+		}
+		if finish.Before(to) {
+			to = finish
+		}
+		if calendar.WorkingHoursBetween(from, to) > scheduleEpsilon {
+			days = append(days, day)
+		}
+	}
+	return days
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// fakeTaskBookings is a fixed set of task bookings
+type fakeTaskBookings []*entities.TaskBooking
+
+func (f fakeTaskBookings) GetTaskBookings(ctx context.Context, projectIDs []uint) ([]*entities.TaskBooking, error) {
+	return f, nil
+}
+
+func newTestLevelingService(db *gorm.DB, bookings fakeTaskBookings) *LevelingService {
+	return NewLevelingService(
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewTaskDependencyRepository(db),
+		repositories.NewCalendarRepository(db),
+		repositories.NewProjectResourceRepository(db),
+		bookings,
+	)
+}
+
+func setTestTaskFields(t *testing.T, db *gorm.DB, task *entities.Task, fields map[string]any) {
+	assert.NoError(t, db.Model(task).Updates(fields).Error)
+}
+
+func TestLevelingService_ResourceLeveling(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Overload across projects delays the lower priority task", func(t *testing.T) {
+		db := setupServiceTestDB(t)
+		hr := createTestHumanResourceForService(t, db, "Alice")
+		p1 := createScheduledTestProject(t, db, "Website")
+		p2 := createScheduledTestProject(t, db, "Mobile")
+		a := createEffortTestTask(t, db, p1.ID, "A", nil, 16)
+		b := createEffortTestTask(t, db, p2.ID, "B", nil, 8)
+		createEffortTestTask(t, db, p2.ID, "Long", nil, 40)
+		setTestTaskFields(t, db, a, map[string]any{"priority": entities.TaskPriorityHigh})
+		setTestTaskFields(t, db, b, map[string]any{"priority": entities.TaskPriorityLow})
+		service := newTestLevelingService(db, fakeTaskBookings{
+			{TaskID: a.ID, HumanResourceID: hr.ID, Units: 100},
+			{TaskID: b.ID, HumanResourceID: hr.ID, Units: 100},
+		})
+
+		report, err := service.PreviewResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.True(t, report.Preview)
+		assert.True(t, report.IsLeveled())
+		assert.ElementsMatch(t, []uint{p1.ID, p2.ID}, report.ProjectIDs)
+		if assert.Len(t, report.Moves, 1) {
+			move := report.Moves[0]
+			assert.Equal(t, b.ID, move.TaskID)
+			assert.Equal(t, p2.ID, move.ProjectID)
+			assert.Equal(t, hr.ID, move.HumanResourceID)
+			assert.Equal(t, at(5, 9), move.OldStart)
+			assert.Equal(t, at(7, 9), move.NewStart)
+			assert.Equal(t, at(7, 17), move.NewFinish)
+			assert.Equal(t, 16.0, move.DelayHours)
+			assert.False(t, move.WasCritical)
+		}
+
+		// Preview saves nothing
+		var stored entities.Task
+		assert.NoError(t, db.First(&stored, b.ID).Error)
+		assert.Equal(t, 0.0, stored.LevelingDelay)
+
+		report, err = service.ApplyResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.False(t, report.Preview)
+		assert.Len(t, report.Moves, 1)
+		assert.NoError(t, db.First(&stored, b.ID).Error)
+		assert.Equal(t, 16.0, stored.LevelingDelay)
+
+		schedule, err := newTestSchedulingService(db).GetProjectSchedule(ctx, p2.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(7, 9), schedule.GetTask(b.ID).EarlyStart)
+		assert.Equal(t, 16.0, schedule.GetTask(b.ID).LevelingDelay)
+
+		// Leveling again finds the saved schedule already leveled
+		report, err = service.ApplyResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Moves)
+	})
+
+	t.Run("Delays are removed once the overload is gone", func(t *testing.T) {
+		db := setupServiceTestDB(t)
+		hr := createTestHumanResourceForService(t, db, "Dan")
+		p1 := createScheduledTestProject(t, db, "Shop")
+		p2 := createScheduledTestProject(t, db, "Blog")
+		a := createEffortTestTask(t, db, p1.ID, "A", nil, 16)
+		b := createEffortTestTask(t, db, p2.ID, "B", nil, 8)
+		createEffortTestTask(t, db, p2.ID, "Long", nil, 24)
+		setTestTaskFields(t, db, b, map[string]any{"priority": entities.TaskPriorityLow})
+
+		// The delay uses up all the float of B, which makes it critical
+		_, err := newTestLevelingService(db, fakeTaskBookings{
+			{TaskID: a.ID, HumanResourceID: hr.ID, Units: 100},
+			{TaskID: b.ID, HumanResourceID: hr.ID, Units: 100},
+		}).ApplyResourceLeveling(ctx)
+		assert.NoError(t, err)
+		var stored entities.Task
+		assert.NoError(t, db.First(&stored, b.ID).Error)
+		assert.Equal(t, 16.0, stored.LevelingDelay)
+
+		// A is no longer assigned to the same person
+		report, err := newTestLevelingService(db, fakeTaskBookings{
+			{TaskID: b.ID, HumanResourceID: hr.ID, Units: 100},
+		}).ApplyResourceLeveling(ctx)
+		assert.NoError(t, err)
+		if assert.Len(t, report.Moves, 1) {
+			assert.Equal(t, b.ID, report.Moves[0].TaskID)
+			assert.Equal(t, at(5, 9), report.Moves[0].NewStart)
+			assert.False(t, report.Moves[0].WasCritical)
+		}
+		assert.NoError(t, db.First(&stored, b.ID).Error)
+		assert.Equal(t, 0.0, stored.LevelingDelay)
+	})
+
+	t.Run("Non-critical task is delayed before a critical one and successors follow", func(t *testing.T) {
+		db := setupServiceTestDB(t)
+		hr := createTestHumanResourceForService(t, db, "Bob")
+		project := createScheduledTestProject(t, db, "Platform")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+		c := createEffortTestTask(t, db, project.ID, "C", nil, 24)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+		d := createEffortTestTask(t, db, project.ID, "D", nil, 4)
+		createTestDependency(t, db, a.ID, c.ID, entities.DependencyFinishToStart, 0)
+		createTestDependency(t, db, b.ID, d.ID, entities.DependencyFinishToStart, 0)
+		setTestTaskFields(t, db, a, map[string]any{"priority": entities.TaskPriorityLow})
+		setTestTaskFields(t, db, b, map[string]any{"priority": entities.TaskPriorityCritical})
+		service := newTestLevelingService(db, fakeTaskBookings{
+			{TaskID: a.ID, HumanResourceID: hr.ID, Units: 100},
+			{TaskID: b.ID, HumanResourceID: hr.ID, Units: 100},
+		})
+
+		report, err := service.PreviewResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.True(t, report.IsLeveled())
+		if assert.Len(t, report.Moves, 2) {
+			assert.Equal(t, b.ID, report.Moves[0].TaskID)
+			assert.Equal(t, hr.ID, report.Moves[0].HumanResourceID)
+			assert.False(t, report.Moves[0].WasCritical)
+			assert.Equal(t, at(7, 9), report.Moves[0].NewStart)
+
+			assert.Equal(t, d.ID, report.Moves[1].TaskID)
+			assert.Equal(t, uint(0), report.Moves[1].HumanResourceID)
+			assert.Equal(t, 0.0, report.Moves[1].DelayHours)
+			assert.Equal(t, at(6, 9), report.Moves[1].OldStart)
+			assert.Equal(t, at(8, 9), report.Moves[1].NewStart)
+		}
+	})
+
+	t.Run("Conflicting critical tasks stay in place and unresolved", func(t *testing.T) {
+		db := setupServiceTestDB(t)
+		hr := createTestHumanResourceForService(t, db, "Carol")
+		p1 := createScheduledTestProject(t, db, "Billing")
+		p2 := createScheduledTestProject(t, db, "Reporting")
+		a := createEffortTestTask(t, db, p1.ID, "A", nil, 16)
+		b := createEffortTestTask(t, db, p2.ID, "B", nil, 8)
+		setTestTaskFields(t, db, b, map[string]any{"priority": entities.TaskPriorityLow})
+		service := newTestLevelingService(db, fakeTaskBookings{
+			{TaskID: a.ID, HumanResourceID: hr.ID, Units: 100},
+			{TaskID: b.ID, HumanResourceID: hr.ID, Units: 100},
+		})
+
+		report, err := service.ApplyResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Moves)
+		assert.False(t, report.IsLeveled())
+		if assert.Len(t, report.Unresolved, 1) {
+			assert.Equal(t, hr.ID, report.Unresolved[0].HumanResourceID)
+			assert.Equal(t, 200.0, report.Unresolved[0].LoadPercent)
+			assert.Equal(t, []uint{a.ID, b.ID}, report.Unresolved[0].TaskIDs)
+		}
+
+		var stored entities.Task
+		assert.NoError(t, db.First(&stored, b.ID).Error)
+		assert.Equal(t, 0.0, stored.LevelingDelay)
+	})
+
+	t.Run("Started tasks and allocations that leave no room stay unresolved", func(t *testing.T) {
+		db := setupServiceTestDB(t)
+		alice := createTestHumanResourceForService(t, db, "Alice")
+		bob := createTestHumanResourceForService(t, db, "Bob")
+		p1 := createScheduledTestProject(t, db, "Support")
+		p2 := createScheduledTestProject(t, db, "Research")
+		a := createEffortTestTask(t, db, p1.ID, "A", nil, 8)
+		b := createEffortTestTask(t, db, p1.ID, "B", nil, 8)
+		c := createEffortTestTask(t, db, p1.ID, "C", nil, 8)
+		setTestTaskFields(t, db, a, map[string]any{"status": entities.TaskWorkStatusInProgress})
+		setTestTaskFields(t, db, b, map[string]any{"status": entities.TaskWorkStatusInProgress})
+		allocation := &entities.ProjectResource{ProjectID: p2.ID, HumanResourceID: bob.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+		assert.NoError(t, db.Create(allocation).Error)
+		service := newTestLevelingService(db, fakeTaskBookings{
+			{TaskID: a.ID, HumanResourceID: alice.ID, Units: 100},
+			{TaskID: b.ID, HumanResourceID: alice.ID, Units: 50},
+			{TaskID: c.ID, HumanResourceID: bob.ID, Units: 60},
+		})
+
+		report, err := service.ApplyResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Moves)
+		assert.False(t, report.IsLeveled())
+		if assert.Len(t, report.Unresolved, 2) {
+			assert.Equal(t, alice.ID, report.Unresolved[0].HumanResourceID)
+			assert.Equal(t, at(5, 0), report.Unresolved[0].Date)
+			assert.Equal(t, 150.0, report.Unresolved[0].LoadPercent)
+			assert.Equal(t, []uint{a.ID, b.ID}, report.Unresolved[0].TaskIDs)
+
+			assert.Equal(t, bob.ID, report.Unresolved[1].HumanResourceID)
+			assert.Equal(t, 160.0, report.Unresolved[1].LoadPercent)
+			assert.Equal(t, []uint{c.ID}, report.Unresolved[1].TaskIDs)
+		}
+	})
+
+	t.Run("Without bookings nothing moves", func(t *testing.T) {
+		db := setupServiceTestDB(t)
+		project := createScheduledTestProject(t, db, "Quiet")
+		createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		createEffortTestTask(t, db, project.ID, "B", nil, 8)
+
+		report, err := newTestLevelingService(db, nil).PreviewResourceLeveling(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Moves)
+		assert.Empty(t, report.Unresolved)
+		assert.Equal(t, 0, report.Iterations)
+	})
+}
@@ -2,6 +2,10 @@ package services
 
 import (
 	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/ducminhgd/plan-craft/internal/entities"
 )
@@ -17,38 +21,88 @@ type MilestoneRepository interface {
 
 // MilestoneService handles milestone business logic
 type MilestoneService struct {
-	repo MilestoneRepository
+	repo                MilestoneRepository
+	projectRepo         ProjectRepository
+	taskRepo            TaskRepository
+	projectResourceRepo ProjectResourceRepository
+	assignmentRepo      TaskAssignmentRepository
+	calendarRepo        CalendarRepository
+	now                 func() time.Time
+
+	mu        sync.Mutex
+	forecasts map[uint]entities.MilestoneForecast // Last forecast computed per milestone
 }
 
 // NewMilestoneService creates a new milestone service
-func NewMilestoneService(repo MilestoneRepository) *MilestoneService {
-	return &MilestoneService{repo: repo}
+func NewMilestoneService(repo MilestoneRepository, projectRepo ProjectRepository, taskRepo TaskRepository, projectResourceRepo ProjectResourceRepository, assignmentRepo TaskAssignmentRepository, calendarRepo CalendarRepository) *MilestoneService {
+	return &MilestoneService{
+		repo:                repo,
+		projectRepo:         projectRepo,
+		taskRepo:            taskRepo,
+		projectResourceRepo: projectResourceRepo,
+		assignmentRepo:      assignmentRepo,
+		calendarRepo:        calendarRepo,
+		now:                 time.Now,
+		forecasts:           make(map[uint]entities.MilestoneForecast),
+	}
 }
 
-// CreateMilestone creates a new milestone
+// CreateMilestone creates a new milestone. Its dates are stored as calendar days in the project's time zone.
 func (s *MilestoneService) CreateMilestone(ctx context.Context, milestone *entities.Milestone) (*entities.Milestone, error) {
-	return s.repo.Create(ctx, milestone)
+	zones := newProjectZones(s.projectRepo)
+	if err := zones.normalize(ctx, milestone.ProjectID, &milestone.StartDate, &milestone.EndDate); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, milestone)
+	if err != nil {
+		return nil, err
+	}
+	if err := zones.localize(ctx, created.ProjectID, &created.StartDate, &created.EndDate); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // GetMilestone retrieves a single milestone by ID
 func (s *MilestoneService) GetMilestone(ctx context.Context, id uint) (*entities.Milestone, error) {
-	return s.repo.GetOne(ctx, id)
+	milestone, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := newProjectZones(s.projectRepo).localize(ctx, milestone.ProjectID, &milestone.StartDate, &milestone.EndDate); err != nil {
+		return nil, err
+	}
+	return milestone, nil
 }
 
 // GetMilestones retrieves multiple milestones with optional query parameters
 func (s *MilestoneService) GetMilestones(ctx context.Context, params *entities.MilestoneQueryParams) (*entities.MilestoneListResponse, error) {
+	zones := newProjectZones(s.projectRepo)
+	if params != nil {
+		if err := zones.normalizeFilters(ctx, params.ProjectID, &params.StartDate_Gte, &params.StartDate_Lte, &params.EndDate_Gte, &params.EndDate_Lte); err != nil {
+			return nil, err
+		}
+	}
 	data, total, err := s.repo.GetMany(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	for _, milestone := range data {
+		if err := zones.localize(ctx, milestone.ProjectID, &milestone.StartDate, &milestone.EndDate); err != nil {
+			return nil, err
+		}
+	}
 	return &entities.MilestoneListResponse{
 		Data:  data,
 		Total: total,
 	}, nil
 }
 
-// UpdateMilestone updates an existing milestone
+// UpdateMilestone updates an existing milestone. Its dates are stored as calendar days in the project's time zone.
 func (s *MilestoneService) UpdateMilestone(ctx context.Context, milestone *entities.Milestone) (int64, error) {
+	if err := newProjectZones(s.projectRepo).normalize(ctx, milestone.ProjectID, &milestone.StartDate, &milestone.EndDate); err != nil {
+		return 0, err
+	}
 	return s.repo.Update(ctx, milestone)
 }
 
@@ -56,3 +110,283 @@ func (s *MilestoneService) UpdateMilestone(ctx context.Context, milestone *entit
 func (s *MilestoneService) DeleteMilestone(ctx context.Context, id uint) error {
 	return s.repo.Delete(ctx, id)
 }
+
+// GetMilestoneForecast forecasts the completion of a single milestone
+func (s *MilestoneService) GetMilestoneForecast(ctx context.Context, id uint) (*entities.MilestoneForecast, error) {
+	milestone, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	forecasts, err := s.GetMilestoneForecasts(ctx, milestone.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range forecasts {
+		if f.MilestoneID == id {
+			return f, nil
+		}
+	}
+	return nil, entities.ErrRecordNotFound
+}
+
+// GetMilestoneForecasts forecasts the completion of the active milestones of a project.
+// The people assigned to the milestones' tasks work through their remaining effort on the
+// project calendar from today, milestones due first being finished first.
+// A forecast past the milestone end date is a slip.
+func (s *MilestoneService) GetMilestoneForecasts(ctx context.Context, projectID uint) ([]*entities.MilestoneForecast, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := projectCalendar(ctx, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+	milestones, _, err := s.repo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: projectID, Status: entities.MilestoneStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	resources, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{ProjectID: projectID, Status: entities.ProjectResourceStatusActive})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	forecasts := forecastMilestones(project, calendar, milestones, tasks, assignments, allocatedCapacity(project, resources, now), now)
+	s.trackForecasts(forecasts)
+	return forecasts, nil
+}
+
+// RefreshForecasts recalculates the milestone forecasts of a project after its plan changed
+// and returns the forecasts that differ from the previous ones
+func (s *MilestoneService) RefreshForecasts(ctx context.Context, projectID uint) ([]*entities.MilestoneForecast, error) {
+	forecasts, err := s.GetMilestoneForecasts(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	changed := make([]*entities.MilestoneForecast, 0, len(forecasts))
+	for _, f := range forecasts {
+		if f.Changed {
+			changed = append(changed, f)
+		}
+	}
+	return changed, nil
+}
+
+// RefreshTaskForecasts recalculates the milestone forecasts of the projects of the given tasks
+// and returns the forecasts that differ from the previous ones
+func (s *MilestoneService) RefreshTaskForecasts(ctx context.Context, taskIDs ...uint) ([]*entities.MilestoneForecast, error) {
+	var changed []*entities.MilestoneForecast
+	refreshed := make(map[uint]bool)
+	for _, id := range taskIDs {
+		task, err := s.taskRepo.GetOne(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if refreshed[task.ProjectID] {
+			continue
+		}
+		refreshed[task.ProjectID] = true
+		forecasts, err := s.RefreshForecasts(ctx, task.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, forecasts...)
+	}
+	return changed, nil
+}
+
+// forecastMilestones lays the remaining effort of each milestone's tasks over the capacity of the
+// allocations assigned to them, milestones due first being finished first: the people on a
+// milestone also work off the earlier milestones they are on. capacity holds the full-time
+// equivalents of each allocation.
+func forecastMilestones(project *entities.Project, calendar *entities.Calendar, milestones []*entities.Milestone, tasks []*entities.Task, assignments []*entities.TaskAssignment, capacity map[uint]float64, now time.Time) []*entities.MilestoneForecast {
+	today := project.Today(now)
+	remaining, _ := remainingEffort(tasks)
+	teams := milestoneTeams(tasks, assignments)
+
+	sorted := append([]*entities.Milestone(nil), milestones...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].EndDate, sorted[j].EndDate
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	forecasts := make([]*entities.MilestoneForecast, 0, len(sorted))
+	for i, m := range sorted {
+		team := teams[m.ID]
+		f := &entities.MilestoneForecast{
+			MilestoneID:     m.ID,
+			ProjectID:       project.ID,
+			Name:            m.Name,
+			DueDate:         inProjectZone(project, m.EndDate),
+			RemainingEffort: remaining[m.ID],
+			IsComplete:      remaining[m.ID] <= scheduleEpsilon,
+		}
+		for id := range team {
+			f.Capacity += capacity[id]
+		}
+		queued := f.RemainingEffort
+		for _, earlier := range sorted[:i] {
+			if sharesAllocation(team, teams[earlier.ID]) {
+				queued += remaining[earlier.ID]
+			}
+		}
+		if f.IsComplete {
+			f.ForecastDate = &today
+		} else {
+			f.ForecastDate = forecastFinish(project, calendar, queued, f.Capacity, now)
+		}
+		if f.DueDate != nil && f.ForecastDate != nil {
+			f.SlipDays = max(0, daysBetween(project.DateOf(*f.DueDate), *f.ForecastDate))
+			f.IsSlipping = f.SlipDays > 0
+		}
+		forecasts = append(forecasts, f)
+	}
+	return forecasts
+}
+
+// milestoneTeams returns the allocations assigned to the unfinished tasks of each milestone
+func milestoneTeams(tasks []*entities.Task, assignments []*entities.TaskAssignment) map[uint]map[uint]bool {
+	milestoneOf := make(map[uint]uint)
+	for _, t := range tasks {
+		if t.MilestoneID != nil && !t.IsCancelled() && t.Progress() < 100 {
+			milestoneOf[t.ID] = *t.MilestoneID
+		}
+	}
+	teams := make(map[uint]map[uint]bool)
+	for _, a := range assignments {
+		milestoneID, ok := milestoneOf[a.TaskID]
+		if !ok {
+			continue
+		}
+		if teams[milestoneID] == nil {
+			teams[milestoneID] = make(map[uint]bool)
+		}
+		teams[milestoneID][a.ProjectResourceID] = true
+	}
+	return teams
+}
+
+// sharesAllocation returns true if the teams have an allocation in common
+func sharesAllocation(a, b map[uint]bool) bool {
+	for id := range a {
+		if b[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// allocatedCapacity returns the full-time equivalents of each allocation that is still allocated today
+func allocatedCapacity(project *entities.Project, resources []*entities.ProjectResource, now time.Time) map[uint]float64 {
+	capacity := make(map[uint]float64, len(resources))
+	for _, pr := range resources {
+		if allocatedOn(project, pr, now) {
+			capacity[pr.ID] = pr.Allocation / 100
+		}
+	}
+	return capacity
+}
+
+// totalCapacity returns the full-time equivalents of all the allocations
+func totalCapacity(capacity map[uint]float64) float64 {
+	total := 0.0
+	for _, fte := range capacity {
+		total += fte
+	}
+	return total
+}
+
+// allocatedOn returns true if an active allocation has not ended by today
+func allocatedOn(project *entities.Project, pr *entities.ProjectResource, now time.Time) bool {
+	return pr.IsActive() && (pr.EndDate == nil || !project.DateOf(*pr.EndDate).Before(project.Today(now)))
+}
+
+// remainingEffort returns the hours of work left on the tasks of each milestone and in total.
+// Only leaf tasks carry work; summaries would count their subtasks twice.
+func remainingEffort(tasks []*entities.Task) (byMilestone map[uint]float64, total float64) {
+	isSummary := make(map[uint]bool)
+	for _, t := range tasks {
+		if t.ParentID != nil {
+			isSummary[*t.ParentID] = true
+		}
+	}
+	byMilestone = make(map[uint]float64)
+	for _, t := range tasks {
+		if isSummary[t.ID] || t.IsCancelled() {
+			continue
+		}
+		left := t.EstimatedEffort * (100 - t.Progress()) / 100
+		total += left
+		if t.MilestoneID != nil {
+			byMilestone[*t.MilestoneID] += left
+		}
+	}
+	return byMilestone, total
+}
+
+// forecastFinish returns the day the allocated capacity works off the given effort on the project
+// calendar, starting today or at the project start if later. It is nil when work is left but no
+// one is allocated.
+func forecastFinish(project *entities.Project, calendar *entities.Calendar, effort, capacity float64, now time.Time) *time.Time {
+	today := project.Today(now)
+	if effort <= scheduleEpsilon {
+		return &today
+	}
+	if capacity <= 0 {
+		return nil
+	}
+	start := today
+	if project.StartDate != nil && project.DateOf(*project.StartDate).After(start) {
+		start = project.DateOf(*project.StartDate)
+	}
+	finish := project.DateOf(calendar.AddWorkingHours(calendar.NextWorkingTime(start), effort/capacity))
+	return &finish
+}
+
+// ResetForecasts forgets the previous forecasts, so that the next forecast of every milestone
+// counts as a change. Milestone IDs of one database mean nothing in another.
+func (s *MilestoneService) ResetForecasts() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forecasts = make(map[uint]entities.MilestoneForecast)
+}
+
+// trackForecasts marks the forecasts that differ from the previous ones for their milestones.
+// The first forecast of a milestone counts as a change.
+func (s *MilestoneService) trackForecasts(forecasts []*entities.MilestoneForecast) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range forecasts {
+		previous, ok := s.forecasts[f.MilestoneID]
+		f.Changed = !ok || (previous.SlipDays != f.SlipDays || !sameDate(previous.ForecastDate, f.ForecastDate))
+		s.forecasts[f.MilestoneID] = *f
+	}
+}
+
+// sameDate returns true if both dates are unset or the same instant
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// daysBetween returns the calendar days from the day of a to the day of b
+func daysBetween(a, b time.Time) int {
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createTestMilestoneForService(t *testing.T, db *gorm.DB, projectID uint, name string, due *time.Time) *entities.Milestone {
+	milestone := &entities.Milestone{Name: name, ProjectID: projectID, EndDate: due}
+	assert.NoError(t, db.Create(milestone).Error)
+	return milestone
+}
+
+func TestMilestoneService_GetMilestoneForecasts(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewMilestoneService(
+		repositories.NewMilestoneRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewProjectResourceRepository(db),
+		repositories.NewTaskAssignmentRepository(db),
+		repositories.NewCalendarRepository(db),
+	)
+	service.now = func() time.Time { return at(5, 10) }
+	ctx := context.Background()
+
+	t.Run("Forecast and slip", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Forecast")
+		hr := createTestHumanResourceForService(t, db, "Alice")
+		pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+		assert.NoError(t, db.Create(pr).Error)
+		// Bob is allocated but not assigned, so he adds no capacity
+		bob := createTestHumanResourceForService(t, db, "Bob")
+		assert.NoError(t, db.Create(&entities.ProjectResource{ProjectID: project.ID, HumanResourceID: bob.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}).Error)
+
+		due1, due2 := at(6, 0), at(7, 0)
+		m1 := createTestMilestoneForService(t, db, project.ID, "Alpha", &due1)
+		m2 := createTestMilestoneForService(t, db, project.ID, "Beta", &due2)
+		m3 := createTestMilestoneForService(t, db, project.ID, "Open", nil)
+
+		half := createEffortTestTask(t, db, project.ID, "Half done", nil, 16)
+		done := createEffortTestTask(t, db, project.ID, "Done", nil, 8)
+		late := createEffortTestTask(t, db, project.ID, "Late", nil, 24)
+		assert.NoError(t, db.Model(half).Updates(map[string]any{"milestone_id": m1.ID, "percent_complete": 50}).Error)
+		assert.NoError(t, db.Model(done).Updates(map[string]any{"milestone_id": m1.ID, "status": entities.TaskWorkStatusDone}).Error)
+		assert.NoError(t, db.Model(late).Update("milestone_id", m2.ID).Error)
+		for _, task := range []*entities.Task{half, late} {
+			assert.NoError(t, db.Create(&entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: pr.ID, HumanResourceID: hr.ID, Units: 100}).Error)
+		}
+
+		forecasts, err := service.GetMilestoneForecasts(ctx, project.ID)
+		assert.NoError(t, err)
+		if !assert.Len(t, forecasts, 3) {
+			return
+		}
+
+		f := forecasts[0]
+		assert.Equal(t, m1.ID, f.MilestoneID)
+		assert.Equal(t, 8.0, f.RemainingEffort)
+		assert.Equal(t, 1.0, f.Capacity)
+		assert.Equal(t, at(5, 0), *f.ForecastDate)
+		assert.False(t, f.IsSlipping)
+		assert.True(t, f.Changed)
+
+		// Beta waits for Alpha: 8 + 24 hours of work finish on Thursday
+		f = forecasts[1]
+		assert.Equal(t, m2.ID, f.MilestoneID)
+		assert.Equal(t, at(8, 0), *f.ForecastDate)
+		assert.True(t, f.IsSlipping)
+		assert.Equal(t, 1, f.SlipDays)
+
+		f = forecasts[2]
+		assert.Equal(t, m3.ID, f.MilestoneID)
+		assert.True(t, f.IsComplete)
+		assert.Equal(t, at(5, 0), *f.ForecastDate)
+
+		t.Run("Changes are flagged", func(t *testing.T) {
+			assert.NoError(t, db.Model(late).Update("estimated_effort", 32).Error)
+
+			forecast, err := service.GetMilestoneForecast(ctx, m2.ID)
+			assert.NoError(t, err)
+			assert.True(t, forecast.Changed)
+			assert.Equal(t, 2, forecast.SlipDays)
+
+			forecast, err = service.GetMilestoneForecast(ctx, m2.ID)
+			assert.NoError(t, err)
+			assert.False(t, forecast.Changed)
+
+			// Another database starts with no previous forecasts
+			service.ResetForecasts()
+			forecast, err = service.GetMilestoneForecast(ctx, m2.ID)
+			assert.NoError(t, err)
+			assert.True(t, forecast.Changed)
+		})
+
+		t.Run("Refresh returns the changed forecasts", func(t *testing.T) {
+			changed, err := service.RefreshTaskForecasts(ctx, late.ID, half.ID)
+			assert.NoError(t, err)
+			assert.Empty(t, changed)
+
+			assert.NoError(t, db.Model(late).Update("estimated_effort", 24).Error)
+			changed, err = service.RefreshTaskForecasts(ctx, late.ID, half.ID)
+			assert.NoError(t, err)
+			if assert.Len(t, changed, 1) {
+				assert.Equal(t, m2.ID, changed[0].MilestoneID)
+				assert.Equal(t, 1, changed[0].SlipDays)
+			}
+		})
+	})
+
+	t.Run("Milestones with separate teams do not wait for each other", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Teams")
+		due1, due2 := at(6, 0), at(7, 0)
+		m1 := createTestMilestoneForService(t, db, project.ID, "Frontend", &due1)
+		m2 := createTestMilestoneForService(t, db, project.ID, "Backend", &due2)
+		for _, m := range []*entities.Milestone{m1, m2} {
+			hr := createTestHumanResourceForService(t, db, m.Name+"Dev")
+			pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, Allocation: 50, Status: entities.ProjectResourceStatusActive}
+			assert.NoError(t, db.Create(pr).Error)
+			task := createEffortTestTask(t, db, project.ID, "Build "+m.Name, nil, 8)
+			assert.NoError(t, db.Model(task).Update("milestone_id", m.ID).Error)
+			assert.NoError(t, db.Create(&entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: pr.ID, HumanResourceID: hr.ID, Units: 50}).Error)
+		}
+
+		forecasts, err := service.GetMilestoneForecasts(ctx, project.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, forecasts, 2) {
+			// 8 hours at half time take two days for each team
+			for _, f := range forecasts {
+				assert.Equal(t, 0.5, f.Capacity)
+				assert.Equal(t, at(6, 0), *f.ForecastDate)
+			}
+		}
+	})
+
+	t.Run("No capacity means no forecast", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Unstaffed")
+		due := at(9, 0)
+		m := createTestMilestoneForService(t, db, project.ID, "Gamma", &due)
+		task := createEffortTestTask(t, db, project.ID, "Work", nil, 8)
+		assert.NoError(t, db.Model(task).Update("milestone_id", m.ID).Error)
+
+		forecast, err := service.GetMilestoneForecast(ctx, m.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, forecast.ForecastDate)
+		assert.False(t, forecast.IsSlipping)
+		assert.Equal(t, 8.0, forecast.RemainingEffort)
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/ducminhgd/plan-craft/internal/entities"
 )
@@ -13,46 +14,108 @@ type ProjectRepository interface {
 	GetMany(ctx context.Context, qParams *entities.ProjectQueryParams) ([]*entities.Project, int64, error)
 	Update(ctx context.Context, project *entities.Project) (int64, error)
 	Delete(ctx context.Context, id uint) error
+	UpdateCalendar(ctx context.Context, ids []uint, calendarID *uint) (int64, error)
+	UpdateRebasingDates(ctx context.Context, project *entities.Project, rebase func(time.Time) time.Time) (int64, error)
 }
 
 // ProjectService handles project business logic
 type ProjectService struct {
-	repo ProjectRepository
+	repo             ProjectRepository
+	laborCostService *LaborCostService
+	costItemService  *CostItemService
 }
 
 // NewProjectService creates a new project service
-func NewProjectService(repo ProjectRepository) *ProjectService {
-	return &ProjectService{repo: repo}
+func NewProjectService(repo ProjectRepository, laborCostService *LaborCostService, costItemService *CostItemService) *ProjectService {
+	return &ProjectService{repo: repo, laborCostService: laborCostService, costItemService: costItemService}
 }
 
-// CreateProject creates a new project
+// CreateProject creates a new project. Its dates are stored as calendar days in its time zone.
 func (s *ProjectService) CreateProject(ctx context.Context, project *entities.Project) (*entities.Project, error) {
-	return s.repo.Create(ctx, project)
+	normalizeProjectDates(project)
+	created, err := s.repo.Create(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	localizeProjectDates(created)
+	return created, nil
 }
 
 // GetProject retrieves a single project by ID
 func (s *ProjectService) GetProject(ctx context.Context, id uint) (*entities.Project, error) {
-	return s.repo.GetOne(ctx, id)
+	project, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	localizeProjectDates(project)
+	return project, nil
 }
 
 // GetProjects retrieves multiple projects with optional query parameters
 func (s *ProjectService) GetProjects(ctx context.Context, params *entities.ProjectQueryParams) (*entities.ProjectListResponse, error) {
+	if params != nil {
+		// Projects have their own time zones, so their dates are filtered as instants
+		params.StartDate_Gte, params.StartDate_Lte = inUTC(params.StartDate_Gte), inUTC(params.StartDate_Lte)
+		params.EndDate_Gte, params.EndDate_Lte = inUTC(params.EndDate_Gte), inUTC(params.EndDate_Lte)
+	}
 	data, total, err := s.repo.GetMany(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	for _, project := range data {
+		localizeProjectDates(project)
+	}
 	return &entities.ProjectListResponse{
 		Data:  data,
 		Total: total,
 	}, nil
 }
 
-// UpdateProject updates an existing project
+// UpdateProject updates an existing project. Its dates are stored as calendar days in its time zone.
+// When the time zone changes, the dates of the project and its records keep their calendar days.
 func (s *ProjectService) UpdateProject(ctx context.Context, project *entities.Project) (int64, error) {
-	return s.repo.Update(ctx, project)
+	current, err := s.repo.GetOne(ctx, project.ID)
+	if err != nil {
+		return 0, err
+	}
+	if current.Location().String() == project.Location().String() {
+		normalizeProjectDates(project)
+		return s.repo.Update(ctx, project)
+	}
+	// The dates were picked in the time zone the project had so far
+	rebase := rebaseProjectDate(current, project)
+	project.StartDate = rebaseDate(rebase, toProjectDate(current, project.StartDate))
+	project.EndDate = rebaseDate(rebase, toProjectDate(current, project.EndDate))
+	return s.repo.UpdateRebasingDates(ctx, project, rebase)
 }
 
 // DeleteProject deletes a project by ID
 func (s *ProjectService) DeleteProject(ctx context.Context, id uint) error {
 	return s.repo.Delete(ctx, id)
 }
+
+// ConvertEffort converts effort from one unit to another using the project's hours per day and
+// days per week. An empty unit is the project's display unit.
+func (s *ProjectService) ConvertEffort(ctx context.Context, projectID uint, value float64, from, to entities.EffortUnit) (float64, error) {
+	project, err := s.repo.GetOne(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	hours, err := project.EffortToHours(value, from)
+	if err != nil {
+		return 0, err
+	}
+	return project.EffortFromHours(hours, to)
+}
+
+// GetProjectLaborCost calculates the labor cost of a project and its breakdown per resource, role,
+// milestone and month. The report is read-only.
+func (s *ProjectService) GetProjectLaborCost(ctx context.Context, projectID uint) (*entities.ProjectLaborCost, error) {
+	return s.laborCostService.GetProjectLaborCost(ctx, projectID)
+}
+
+// GetProjectCost calculates the total cost of a project from its labor and cost items and its
+// breakdown per cost type, milestone and month
+func (s *ProjectService) GetProjectCost(ctx context.Context, projectID uint) (*entities.ProjectCost, error) {
+	return s.costItemService.GetProjectCost(ctx, projectID)
+}
@@ -18,38 +18,73 @@ type ProjectResourceRepository interface {
 
 // ProjectResourceService handles project resource business logic
 type ProjectResourceService struct {
-	repo ProjectResourceRepository
+	repo        ProjectResourceRepository
+	projectRepo ProjectRepository
 }
 
 // NewProjectResourceService creates a new project resource service
-func NewProjectResourceService(repo ProjectResourceRepository) *ProjectResourceService {
-	return &ProjectResourceService{repo: repo}
+func NewProjectResourceService(repo ProjectResourceRepository, projectRepo ProjectRepository) *ProjectResourceService {
+	return &ProjectResourceService{repo: repo, projectRepo: projectRepo}
 }
 
-// CreateProjectResource creates a new project resource allocation
+// CreateProjectResource creates a new project resource allocation.
+// Its dates are stored as calendar days in the project's time zone.
 func (s *ProjectResourceService) CreateProjectResource(ctx context.Context, projectResource *entities.ProjectResource) (*entities.ProjectResource, error) {
-	return s.repo.Create(ctx, projectResource)
+	zones := newProjectZones(s.projectRepo)
+	if err := zones.normalize(ctx, projectResource.ProjectID, &projectResource.StartDate, &projectResource.EndDate); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, projectResource)
+	if err != nil {
+		return nil, err
+	}
+	if err := zones.localize(ctx, created.ProjectID, &created.StartDate, &created.EndDate); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // GetProjectResource retrieves a single project resource by ID
 func (s *ProjectResourceService) GetProjectResource(ctx context.Context, id uint) (*entities.ProjectResource, error) {
-	return s.repo.GetOne(ctx, id)
+	projectResource, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := newProjectZones(s.projectRepo).localize(ctx, projectResource.ProjectID, &projectResource.StartDate, &projectResource.EndDate); err != nil {
+		return nil, err
+	}
+	return projectResource, nil
 }
 
 // GetProjectResources retrieves multiple project resources with optional query parameters
 func (s *ProjectResourceService) GetProjectResources(ctx context.Context, params *entities.ProjectResourceQueryParams) (*entities.ProjectResourceListResponse, error) {
+	zones := newProjectZones(s.projectRepo)
+	if params != nil {
+		if err := zones.normalizeFilters(ctx, params.ProjectID, &params.StartDate_Gte, &params.StartDate_Lte, &params.EndDate_Gte, &params.EndDate_Lte); err != nil {
+			return nil, err
+		}
+	}
 	data, total, err := s.repo.GetMany(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	for _, projectResource := range data {
+		if err := zones.localize(ctx, projectResource.ProjectID, &projectResource.StartDate, &projectResource.EndDate); err != nil {
+			return nil, err
+		}
+	}
 	return &entities.ProjectResourceListResponse{
 		Data:  data,
 		Total: total,
 	}, nil
 }
 
-// UpdateProjectResource updates an existing project resource allocation
+// UpdateProjectResource updates an existing project resource allocation.
+// Its dates are stored as calendar days in the project's time zone.
 func (s *ProjectResourceService) UpdateProjectResource(ctx context.Context, projectResource *entities.ProjectResource) (int64, error) {
+	if err := newProjectZones(s.projectRepo).normalize(ctx, projectResource.ProjectID, &projectResource.StartDate, &projectResource.EndDate); err != nil {
+		return 0, err
+	}
 	return s.repo.Update(ctx, projectResource)
 }
 
@@ -60,5 +95,12 @@ func (s *ProjectResourceService) DeleteProjectResource(ctx context.Context, id u
 
 // GetByProjectAndResource retrieves a project resource by project ID and human resource ID
 func (s *ProjectResourceService) GetByProjectAndResource(ctx context.Context, projectID, humanResourceID uint) (*entities.ProjectResource, error) {
-	return s.repo.GetByProjectAndResource(ctx, projectID, humanResourceID)
+	projectResource, err := s.repo.GetByProjectAndResource(ctx, projectID, humanResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := newProjectZones(s.projectRepo).localize(ctx, projectResource.ProjectID, &projectResource.StartDate, &projectResource.EndDate); err != nil {
+		return nil, err
+	}
+	return projectResource, nil
 }
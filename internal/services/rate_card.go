@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// RateCardRepository defines the interface for rate card data operations
+type RateCardRepository interface {
+	Create(ctx context.Context, rateCard *entities.RateCard) (*entities.RateCard, error)
+	GetOne(ctx context.Context, id uint) (*entities.RateCard, error)
+	GetMany(ctx context.Context, qParams *entities.RateCardQueryParams) ([]*entities.RateCard, int64, error)
+	Update(ctx context.Context, rateCard *entities.RateCard) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// RateCardService handles the effective-dated cost and bill rates of people and role levels
+type RateCardService struct {
+	repo                RateCardRepository
+	projectRepo         ProjectRepository
+	humanResourceRepo   HumanResourceRepository
+	projectResourceRepo ProjectResourceRepository
+	projectRoleRepo     ProjectRoleRepository
+}
+
+// NewRateCardService creates a new rate card service
+func NewRateCardService(repo RateCardRepository, projectRepo ProjectRepository, humanResourceRepo HumanResourceRepository, projectResourceRepo ProjectResourceRepository, projectRoleRepo ProjectRoleRepository) *RateCardService {
+	return &RateCardService{
+		repo:                repo,
+		projectRepo:         projectRepo,
+		humanResourceRepo:   humanResourceRepo,
+		projectResourceRepo: projectResourceRepo,
+		projectRoleRepo:     projectRoleRepo,
+	}
+}
+
+// CreateRateCard creates a new rate card. A project rate card without a currency takes the project's currency.
+func (s *RateCardService) CreateRateCard(ctx context.Context, rateCard *entities.RateCard) (*entities.RateCard, error) {
+	if err := s.prepare(ctx, rateCard); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, rateCard)
+}
+
+// GetRateCard retrieves a single rate card by ID
+func (s *RateCardService) GetRateCard(ctx context.Context, id uint) (*entities.RateCard, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetRateCards retrieves multiple rate cards with optional query parameters
+func (s *RateCardService) GetRateCards(ctx context.Context, params *entities.RateCardQueryParams) (*entities.RateCardListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.RateCardListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateRateCard updates an existing rate card
+func (s *RateCardService) UpdateRateCard(ctx context.Context, rateCard *entities.RateCard) (int64, error) {
+	if err := s.prepare(ctx, rateCard); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, rateCard)
+}
+
+// DeleteRateCard deletes a rate card by ID
+func (s *RateCardService) DeleteRateCard(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// prepare fills in the project currency and checks that the rate card does not overlap another
+// rate card of the same person or role and level in the same project
+func (s *RateCardService) prepare(ctx context.Context, rateCard *entities.RateCard) error {
+	if rateCard.RateType == "" {
+		rateCard.RateType = entities.RateTypeHourly
+	}
+	if err := rateCard.Validate(); err != nil {
+		return err
+	}
+	if rateCard.ProjectID != nil && rateCard.Currency == "" {
+		project, err := s.projectRepo.GetOne(ctx, *rateCard.ProjectID)
+		if err != nil {
+			return err
+		}
+		rateCard.Currency = project.Currency
+	}
+
+	params := &entities.RateCardQueryParams{HumanResourceID: rateCard.HumanResourceID}
+	if !rateCard.IsPersonRate() {
+		params.RoleName, params.RoleLevel = rateCard.RoleName, rateCard.RoleLevel
+	}
+	existing, _, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if other.ID != rateCard.ID && rateCard.SameScope(other) && rateCard.Overlaps(other) {
+			return entities.ErrRateCardOverlap
+		}
+	}
+	return nil
+}
+
+// ResolvePersonRate returns the rate card that applies to a person on a date. Within a project the
+// person's own rate there comes first, then their standard rate, then the rate of their role and level
+// there and the standard rate of that role and level. The role is the one the person is allocated to
+// the project with, or their title outside a project; the level is taken from the person, or from the
+// project role when the project has that role at one level only. Without a project only standard
+// rates are looked at.
+func (s *RateCardService) ResolvePersonRate(ctx context.Context, humanResourceID, projectID uint, date time.Time) (*entities.ResolvedRate, error) {
+	rates, err := s.loadPersonRates(ctx, humanResourceID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	card, source := rates.on(date)
+	if card == nil {
+		return nil, entities.ErrRateCardNotFound
+	}
+	return &entities.ResolvedRate{
+		RateCard:        card,
+		Source:          source,
+		HumanResourceID: humanResourceID,
+		ProjectID:       projectID,
+		RoleName:        rates.roleName,
+		RoleLevel:       rates.roleLevel,
+		Date:            date,
+	}, nil
+}
+
+// personRates holds the rate cards that can apply to a person in a project, so that the rate of
+// each day of a period is resolved without going back to the database
+type personRates struct {
+	projectID uint
+	roleName  string
+	roleLevel uint
+	person    []*entities.RateCard
+	role      []*entities.RateCard
+}
+
+// on returns the rate card that applies on the date, in the order ResolvePersonRate describes
+func (r *personRates) on(date time.Time) (*entities.RateCard, entities.RateSource) {
+	if card, source := applicableRate(r.person, r.projectID, date, entities.RateSourcePersonProject, entities.RateSourcePerson); card != nil {
+		return card, source
+	}
+	return applicableRate(r.role, r.projectID, date, entities.RateSourceRoleProject, entities.RateSourceRole)
+}
+
+// loadPersonRates loads the rate cards of a person and of their role and level in the project
+func (s *RateCardService) loadPersonRates(ctx context.Context, humanResourceID, projectID uint) (*personRates, error) {
+	person, err := s.humanResourceRepo.GetOne(ctx, humanResourceID)
+	if err != nil {
+		return nil, err
+	}
+	rates := &personRates{projectID: projectID, roleName: person.Title, roleLevel: entities.ParseRoleLevel(person.Level)}
+	if rates.person, _, err = s.repo.GetMany(ctx, &entities.RateCardQueryParams{HumanResourceID: &humanResourceID}); err != nil {
+		return nil, err
+	}
+
+	if projectID != 0 {
+		allocation, err := s.projectResourceRepo.GetByProjectAndResource(ctx, projectID, humanResourceID)
+		if err != nil && !errors.Is(err, entities.ErrRecordNotFound) {
+			return nil, err
+		}
+		if allocation != nil && allocation.Role != "" {
+			rates.roleName = allocation.Role
+		}
+		if rates.roleLevel == entities.RoleLevelUnknown {
+			roles, _, err := s.projectRoleRepo.GetMany(ctx, &entities.ProjectRoleQueryParams{ProjectID: projectID, Name: rates.roleName})
+			if err != nil {
+				return nil, err
+			}
+			if len(roles) == 1 {
+				rates.roleLevel = roles[0].Level
+			}
+		}
+	}
+	if rates.roleName == "" || rates.roleLevel == entities.RoleLevelUnknown {
+		return rates, nil
+	}
+
+	isNull := true
+	rates.role, _, err = s.repo.GetMany(ctx, &entities.RateCardQueryParams{RoleName: rates.roleName, RoleLevel: rates.roleLevel, HumanResourceID_IsNull: &isNull})
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// ResolveRoleRate returns the rate card that applies to a role and level on a date: the rate in the
+// project if there is one, or else the standard rate
+func (s *RateCardService) ResolveRoleRate(ctx context.Context, roleName string, roleLevel, projectID uint, date time.Time) (*entities.ResolvedRate, error) {
+	card, source, err := s.roleRate(ctx, roleName, roleLevel, projectID, date)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ResolvedRate{
+		RateCard:  card,
+		Source:    source,
+		ProjectID: projectID,
+		RoleName:  card.RoleName,
+		RoleLevel: roleLevel,
+		Date:      date,
+	}, nil
+}
+
+// roleRate finds the rate card of a role and level in the project, or else its standard one
+func (s *RateCardService) roleRate(ctx context.Context, roleName string, roleLevel, projectID uint, date time.Time) (*entities.RateCard, entities.RateSource, error) {
+	isNull := true
+	cards, _, err := s.repo.GetMany(ctx, &entities.RateCardQueryParams{RoleName: roleName, RoleLevel: roleLevel, HumanResourceID_IsNull: &isNull})
+	if err != nil {
+		return nil, "", err
+	}
+	card, source := applicableRate(cards, projectID, date, entities.RateSourceRoleProject, entities.RateSourceRole)
+	if card == nil {
+		return nil, "", entities.ErrRateCardNotFound
+	}
+	return card, source, nil
+}
+
+// applicableRate picks the rate card in effect on the date, preferring one of the project to a standard one
+func applicableRate(cards []*entities.RateCard, projectID uint, date time.Time, projectSource, standardSource entities.RateSource) (*entities.RateCard, entities.RateSource) {
+	var standard *entities.RateCard
+	for _, card := range cards {
+		if !card.AppliesOn(date) {
+			continue
+		}
+		if card.ProjectID == nil {
+			standard = card
+		} else if projectID != 0 && *card.ProjectID == projectID {
+			return card, projectSource
+		}
+	}
+	if standard == nil {
+		return nil, ""
+	}
+	return standard, standardSource
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateCardService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewRateCardService(
+		repositories.NewRateCardRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewHRRepository(db),
+		repositories.NewProjectResourceRepository(db),
+		repositories.NewProjectRoleRepository(db),
+	)
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Rates")
+	assert.NoError(t, db.Model(project).Update("currency", "EUR").Error)
+	alice := createTestHumanResourceForService(t, db, "Alice")
+	bob := createTestHumanResourceForService(t, db, "Bob")
+	assert.NoError(t, db.Model(bob).Update("level", "Expert").Error)
+	assert.NoError(t, db.Create(&entities.ProjectResource{ProjectID: project.ID, HumanResourceID: bob.ID, Role: "Developer", Allocation: 100}).Error)
+	assert.NoError(t, db.Create(&entities.ProjectRole{ProjectID: project.ID, Name: "Developer", Level: entities.RoleLevelLead, Headcount: 1}).Error)
+
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	create := func(card *entities.RateCard) *entities.RateCard {
+		created, err := service.CreateRateCard(ctx, card)
+		assert.NoError(t, err)
+		return created
+	}
+
+	aliceStandard := create(&entities.RateCard{HumanResourceID: &alice.ID, CostRate: entities.NewMoney(50), BillRate: entities.NewMoney(80), Currency: "usd", EffectiveFrom: day(time.January, 1), EffectiveTo: ptr(day(time.June, 30))})
+	aliceRaise := create(&entities.RateCard{HumanResourceID: &alice.ID, CostRate: entities.NewMoney(55), BillRate: entities.NewMoney(90), Currency: "USD", EffectiveFrom: day(time.July, 1)})
+	aliceProject := create(&entities.RateCard{ProjectID: &project.ID, HumanResourceID: &alice.ID, RateType: entities.RateTypeDaily, CostRate: entities.NewMoney(420), BillRate: entities.NewMoney(700), EffectiveFrom: day(time.March, 1), EffectiveTo: ptr(day(time.March, 31))})
+	engineer := create(&entities.RateCard{RoleName: "Engineer", RoleLevel: entities.RoleLevelSenior, CostRate: entities.NewMoney(45), BillRate: entities.NewMoney(75), Currency: "USD", EffectiveFrom: day(time.January, 1)})
+	leadDev := create(&entities.RateCard{ProjectID: &project.ID, RoleName: "developer", RoleLevel: entities.RoleLevelLead, CostRate: entities.NewMoney(60), BillRate: entities.NewMoney(100), EffectiveFrom: day(time.January, 1)})
+
+	t.Run("Project rates take the project currency", func(t *testing.T) {
+		assert.Equal(t, "EUR", aliceProject.Currency)
+		assert.Equal(t, "USD", aliceStandard.Currency)
+		assert.Equal(t, entities.RateTypeHourly, aliceStandard.RateType)
+	})
+
+	t.Run("Overlapping rates", func(t *testing.T) {
+		_, err := service.CreateRateCard(ctx, &entities.RateCard{HumanResourceID: &alice.ID, CostRate: entities.NewMoney(60), EffectiveFrom: day(time.June, 30)})
+		assert.Equal(t, entities.ErrRateCardOverlap, err)
+
+		_, err = service.CreateRateCard(ctx, &entities.RateCard{RoleName: "ENGINEER", RoleLevel: entities.RoleLevelSenior, EffectiveFrom: day(time.December, 1)})
+		assert.Equal(t, entities.ErrRateCardOverlap, err)
+
+		// Another level, another project or a closed period do not overlap
+		create(&entities.RateCard{RoleName: "Engineer", RoleLevel: entities.RoleLevelMid, CostRate: entities.NewMoney(35), EffectiveFrom: day(time.January, 1)})
+		aliceRaise.CostRate = entities.NewMoney(56)
+		_, err = service.UpdateRateCard(ctx, aliceRaise)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Person rates", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			projectID uint
+			date      time.Time
+			wantID    uint
+			want      entities.RateSource
+		}{
+			{"Standard rate", 0, day(time.February, 10), aliceStandard.ID, entities.RateSourcePerson},
+			{"Project rate in its period", project.ID, day(time.March, 15), aliceProject.ID, entities.RateSourcePersonProject},
+			{"Standard rate outside the project period", project.ID, day(time.April, 1), aliceStandard.ID, entities.RateSourcePerson},
+			{"New rate from its effective date", project.ID, day(time.July, 1), aliceRaise.ID, entities.RateSourcePerson},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resolved, err := service.ResolvePersonRate(ctx, alice.ID, tt.projectID, tt.date)
+				if assert.NoError(t, err) {
+					assert.Equal(t, tt.wantID, resolved.RateCard.ID)
+					assert.Equal(t, tt.want, resolved.Source)
+				}
+			})
+		}
+	})
+
+	t.Run("Role rates", func(t *testing.T) {
+		// Bob has no rate of his own and no known level, so no role rate applies outside a project
+		resolved, err := service.ResolvePersonRate(ctx, bob.ID, 0, day(time.May, 4))
+		assert.Equal(t, entities.ErrRateCardNotFound, err)
+		assert.Nil(t, resolved)
+
+		// In the project his role is Developer, at the only level the project has it
+		resolved, err = service.ResolvePersonRate(ctx, bob.ID, project.ID, day(time.May, 4))
+		if assert.NoError(t, err) {
+			assert.Equal(t, leadDev.ID, resolved.RateCard.ID)
+			assert.Equal(t, entities.RateSourceRoleProject, resolved.Source)
+			assert.Equal(t, "Developer", resolved.RoleName)
+			assert.Equal(t, uint(entities.RoleLevelLead), resolved.RoleLevel)
+		}
+
+		resolved, err = service.ResolveRoleRate(ctx, "Engineer", entities.RoleLevelSenior, project.ID, day(time.May, 4))
+		if assert.NoError(t, err) {
+			assert.Equal(t, engineer.ID, resolved.RateCard.ID)
+			assert.Equal(t, entities.RateSourceRole, resolved.Source)
+		}
+
+		_, err = service.ResolveRoleRate(ctx, "Engineer", entities.RoleLevelSenior, 0, day(time.January, 1).AddDate(-1, 0, 0))
+		assert.Equal(t, entities.ErrRateCardNotFound, err)
+	})
+
+	t.Run("Unknown person", func(t *testing.T) {
+		_, err := service.ResolvePersonRate(ctx, 9999, project.ID, day(time.May, 4))
+		assert.Equal(t, entities.ErrRecordNotFound, err)
+	})
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// RollupService aggregates effort, estimates, actuals, progress and status up the work breakdown structure
+type RollupService struct {
+	projectRepo   ProjectRepository
+	taskRepo      TaskRepository
+	milestoneRepo MilestoneRepository
+	timeEntryRepo TimeEntryRepository
+}
+
+// NewRollupService creates a new rollup service
+func NewRollupService(projectRepo ProjectRepository, taskRepo TaskRepository, milestoneRepo MilestoneRepository, timeEntryRepo TimeEntryRepository) *RollupService {
+	return &RollupService{projectRepo: projectRepo, taskRepo: taskRepo, milestoneRepo: milestoneRepo, timeEntryRepo: timeEntryRepo}
+}
+
+// GetProjectRollup computes the rollup of every task of a project and of the project itself.
+// Effort adds up over subtasks that are not cancelled. Percent complete is weighted by that
+// effort, or a plain average when none of the subtasks has effort. A summary is cancelled when
+// all its subtasks are, done when the rest are done, to do when none has started, and in
+// progress otherwise. Three-point estimates combine by adding expected values and variances,
+// for summaries and for the tasks of each milestone alike. Hours logged in time entries add up
+// over all subtasks, cancelled ones included, and are compared with the estimated effort.
+func (s *RollupService) GetProjectRollup(ctx context.Context, projectID uint) (*entities.ProjectRollup, error) {
+	if _, err := s.projectRepo.GetOne(ctx, projectID); err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	milestones, _, err := s.milestoneRepo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	actuals, err := s.timeEntryRepo.SumHoursByTask(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	inProject := make(map[uint]bool, len(tasks))
+	for _, t := range tasks {
+		inProject[t.ID] = true
+	}
+	children := make(map[uint][]*entities.Task)
+	var roots []*entities.Task
+	for _, t := range tasks {
+		if t.ParentID != nil && inProject[*t.ParentID] && *t.ParentID != t.ID {
+			children[*t.ParentID] = append(children[*t.ParentID], t)
+		} else {
+			roots = append(roots, t)
+		}
+	}
+
+	r := &rollup{children: children, actuals: actuals, results: make(map[uint]*entities.TaskRollup, len(tasks))}
+	project := &entities.ProjectRollup{ProjectID: projectID, Tasks: make([]*entities.TaskRollup, 0, len(tasks))}
+	parts := make([]*entities.TaskRollup, 0, len(roots))
+	for _, t := range roots {
+		parts = append(parts, r.task(t))
+	}
+	project.EstimatedEffort, project.CompletedEffort, project.PercentComplete, project.Status = aggregateRollups(parts)
+	project.Estimate = aggregateEstimates(parts)
+	actual := 0.0
+	for _, p := range parts {
+		actual += p.ActualHours
+	}
+	project.SetActualHours(actual)
+
+	byMilestone := make(map[uint][]*entities.TaskRollup)
+	for _, t := range tasks {
+		tr := r.task(t)
+		project.Tasks = append(project.Tasks, tr)
+		if t.MilestoneID != nil && !tr.IsSummary {
+			byMilestone[*t.MilestoneID] = append(byMilestone[*t.MilestoneID], tr)
+		}
+	}
+
+	project.Milestones = make([]*entities.MilestoneRollup, 0, len(milestones))
+	for _, m := range milestones {
+		mr := &entities.MilestoneRollup{MilestoneID: m.ID, Name: m.Name, Estimate: aggregateEstimates(byMilestone[m.ID])}
+		mr.EstimatedEffort, mr.CompletedEffort, mr.PercentComplete, _ = aggregateRollups(byMilestone[m.ID])
+		for _, tr := range byMilestone[m.ID] {
+			mr.ActualHours += tr.ActualHours
+		}
+		project.Milestones = append(project.Milestones, mr)
+	}
+	return project, nil
+}
+
+// rollup computes task rollups bottom up, each task once
+type rollup struct {
+	children map[uint][]*entities.Task
+	actuals  map[uint]float64 // Hours logged per task
+	results  map[uint]*entities.TaskRollup
+}
+
+// task returns the rollup of a task
+func (r *rollup) task(t *entities.Task) *entities.TaskRollup {
+	if tr, ok := r.results[t.ID]; ok {
+		return tr
+	}
+	tr := &entities.TaskRollup{
+		TaskID:    t.ID,
+		Name:      t.Name,
+		ParentID:  t.ParentID,
+		Level:     t.Level,
+		IsSummary: len(r.children[t.ID]) > 0,
+	}
+	// Registered before descending so a corrupt parent cycle ends here
+	r.results[t.ID] = tr
+
+	if !tr.IsSummary {
+		tr.Status = t.Status
+		tr.PercentComplete = t.Progress()
+		if !t.IsCancelled() {
+			tr.EstimatedEffort = t.EstimatedEffort
+			tr.CompletedEffort = t.EstimatedEffort * tr.PercentComplete / 100
+			tr.Estimate = t.EffortEstimate()
+		}
+		tr.SetActualHours(r.actuals[t.ID])
+		return tr
+	}
+
+	parts := make([]*entities.TaskRollup, 0, len(r.children[t.ID]))
+	for _, child := range r.children[t.ID] {
+		parts = append(parts, r.task(child))
+	}
+	tr.EstimatedEffort, tr.CompletedEffort, tr.PercentComplete, tr.Status = aggregateRollups(parts)
+	tr.Estimate = aggregateEstimates(parts)
+	actual := r.actuals[t.ID]
+	for _, p := range parts {
+		actual += p.ActualHours
+	}
+	tr.SetActualHours(actual)
+	return tr
+}
+
+// aggregateEstimates combines the estimates of sibling tasks, treating them as independent
+func aggregateEstimates(parts []*entities.TaskRollup) entities.EffortEstimate {
+	estimate := entities.NewEffortEstimate(0, 0)
+	for _, p := range parts {
+		if p.Status != entities.TaskWorkStatusCancelled {
+			estimate = estimate.Add(p.Estimate)
+		}
+	}
+	return estimate
+}
+
+// aggregateRollups combines the rollups of sibling tasks
+func aggregateRollups(parts []*entities.TaskRollup) (effort, completed, percent float64, status uint) {
+	var active []*entities.TaskRollup
+	for _, p := range parts {
+		if p.Status != entities.TaskWorkStatusCancelled {
+			active = append(active, p)
+		}
+	}
+	if len(active) == 0 {
+		return 0, 0, 0, entities.TaskWorkStatusCancelled
+	}
+
+	allDone, allToDo, sum := true, true, 0.0
+	for _, p := range active {
+		effort += p.EstimatedEffort
+		completed += p.CompletedEffort
+		sum += p.PercentComplete
+		allDone = allDone && p.Status == entities.TaskWorkStatusDone
+		allToDo = allToDo && p.Status == entities.TaskWorkStatusToDo && p.PercentComplete == 0
+	}
+
+	if effort > 0 {
+		percent = completed / effort * 100
+	} else {
+		percent = sum / float64(len(active))
+	}
+
+	switch {
+	case allDone:
+		status = entities.TaskWorkStatusDone
+	case allToDo:
+		status = entities.TaskWorkStatusToDo
+	default:
+		status = entities.TaskWorkStatusInProgress
+	}
+	return effort, completed, percent, status
+}
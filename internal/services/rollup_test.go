@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollupService_GetProjectRollup(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewRollupService(repositories.NewProjectRepository(db), repositories.NewTaskRepository(db), repositories.NewMilestoneRepository(db), repositories.NewTimeEntryRepository(db))
+	ctx := context.Background()
+
+	t.Run("Effort, progress and status roll up the tree", func(t *testing.T) {
+		project := createTestProjectForService(t, db, "Rollup")
+		phase := createEffortTestTask(t, db, project.ID, "Phase", nil, 0)
+		design := createEffortTestTask(t, db, project.ID, "Design", &phase.ID, 30)
+		build := createEffortTestTask(t, db, project.ID, "Build", &phase.ID, 10)
+		dropped := createEffortTestTask(t, db, project.ID, "Dropped", &phase.ID, 100)
+		createEffortTestTask(t, db, project.ID, "Docs", nil, 20)
+		assert.NoError(t, db.Model(design).Update("status", entities.TaskWorkStatusDone).Error)
+		assert.NoError(t, db.Model(build).Updates(map[string]any{"status": entities.TaskWorkStatusInProgress, "percent_complete": 50}).Error)
+		assert.NoError(t, db.Model(dropped).Update("status", entities.TaskWorkStatusCancelled).Error)
+
+		rollup, err := service.GetProjectRollup(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Len(t, rollup.Tasks, 5)
+
+		tr := rollup.GetTask(phase.ID)
+		assert.True(t, tr.IsSummary)
+		assert.Equal(t, 40.0, tr.EstimatedEffort)
+		assert.Equal(t, 35.0, tr.CompletedEffort)
+		assert.Equal(t, 87.5, tr.PercentComplete)
+		assert.Equal(t, uint(entities.TaskWorkStatusInProgress), tr.Status)
+
+		tr = rollup.GetTask(dropped.ID)
+		assert.False(t, tr.IsSummary)
+		assert.Equal(t, 0.0, tr.EstimatedEffort)
+
+		assert.Equal(t, 60.0, rollup.EstimatedEffort)
+		assert.Equal(t, 35.0, rollup.CompletedEffort)
+		assert.InDelta(t, 58.33, rollup.PercentComplete, 0.01)
+		assert.Equal(t, uint(entities.TaskWorkStatusInProgress), rollup.Status)
+	})
+
+	t.Run("Three-point estimates combine variances", func(t *testing.T) {
+		project := createTestProjectForService(t, db, "Estimates")
+		release := createTestMilestoneForService(t, db, project.ID, "Release", nil)
+		phase := createEffortTestTask(t, db, project.ID, "Phase", nil, 0)
+		a := createTestTaskForService(t, db, project.ID, "A", &phase.ID)
+		b := createTestTaskForService(t, db, project.ID, "B", &phase.ID)
+		c := createEffortTestTask(t, db, project.ID, "C", nil, 10)
+		// PERT: expected 10, standard deviation 2 each
+		for _, task := range []*entities.Task{a, b} {
+			task.OptimisticEffort, task.MostLikelyEffort, task.PessimisticEffort = 4, 10, 16
+			task.MilestoneID = &release.ID
+			assert.NoError(t, db.Save(task).Error)
+			assert.Equal(t, 10.0, task.EstimatedEffort)
+		}
+		assert.NoError(t, db.Model(c).Update("milestone_id", release.ID).Error)
+
+		rollup, err := service.GetProjectRollup(ctx, project.ID)
+		assert.NoError(t, err)
+
+		estimate := rollup.GetTask(phase.ID).Estimate
+		assert.Equal(t, 20.0, estimate.Expected)
+		assert.InDelta(t, 8.0, estimate.Variance, 1e-9)
+		assert.InDelta(t, 2.828, estimate.StdDev, 1e-3)
+
+		assert.Equal(t, 30.0, rollup.Estimate.Expected)
+		assert.InDelta(t, 2.828, rollup.Estimate.StdDev, 1e-3)
+		assert.InDelta(t, 30+1.2816*2.828, rollup.Estimate.P90, 1e-2)
+
+		if assert.Len(t, rollup.Milestones, 1) {
+			assert.Equal(t, release.ID, rollup.Milestones[0].MilestoneID)
+			assert.Equal(t, 30.0, rollup.Milestones[0].EstimatedEffort)
+			assert.Equal(t, rollup.Estimate, rollup.Milestones[0].Estimate)
+		}
+	})
+
+	t.Run("Derived status", func(t *testing.T) {
+		project := createTestProjectForService(t, db, "Status")
+		done := createEffortTestTask(t, db, project.ID, "Done", nil, 0)
+		a := createEffortTestTask(t, db, project.ID, "A", &done.ID, 0)
+		b := createEffortTestTask(t, db, project.ID, "B", &done.ID, 0)
+		assert.NoError(t, db.Model(a).Update("status", entities.TaskWorkStatusDone).Error)
+		assert.NoError(t, db.Model(b).Update("status", entities.TaskWorkStatusCancelled).Error)
+		todo := createEffortTestTask(t, db, project.ID, "To do", nil, 0)
+		createEffortTestTask(t, db, project.ID, "C", &todo.ID, 0)
+		cancelled := createEffortTestTask(t, db, project.ID, "Cancelled", nil, 0)
+		d := createEffortTestTask(t, db, project.ID, "D", &cancelled.ID, 0)
+		assert.NoError(t, db.Model(d).Update("status", entities.TaskWorkStatusCancelled).Error)
+
+		rollup, err := service.GetProjectRollup(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(entities.TaskWorkStatusDone), rollup.GetTask(done.ID).Status)
+		assert.Equal(t, 100.0, rollup.GetTask(done.ID).PercentComplete)
+		assert.Equal(t, uint(entities.TaskWorkStatusToDo), rollup.GetTask(todo.ID).Status)
+		assert.Equal(t, uint(entities.TaskWorkStatusCancelled), rollup.GetTask(cancelled.ID).Status)
+		assert.Equal(t, 50.0, rollup.PercentComplete)
+	})
+
+	t.Run("Actual hours against estimates", func(t *testing.T) {
+		project := createTestProjectForService(t, db, "Actuals")
+		phase := createEffortTestTask(t, db, project.ID, "Phase", nil, 0)
+		design := createEffortTestTask(t, db, project.ID, "Design", &phase.ID, 30)
+		build := createEffortTestTask(t, db, project.ID, "Build", &phase.ID, 10)
+		hr := createTestHumanResourceForService(t, db, "Logger")
+		date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+		for _, e := range []*entities.TimeEntry{
+			{TaskID: design.ID, HumanResourceID: hr.ID, Date: &date, Hours: 8},
+			{TaskID: design.ID, HumanResourceID: hr.ID, Date: &date, Hours: 16},
+			{TaskID: build.ID, HumanResourceID: hr.ID, Date: &date, Hours: 12},
+			{TaskID: phase.ID, HumanResourceID: hr.ID, Date: &date, Hours: 2},
+		} {
+			assert.NoError(t, db.Create(e).Error)
+		}
+
+		rollup, err := service.GetProjectRollup(ctx, project.ID)
+		assert.NoError(t, err)
+
+		tr := rollup.GetTask(design.ID)
+		assert.Equal(t, 24.0, tr.ActualHours)
+		assert.Equal(t, -6.0, tr.EffortVariance)
+		assert.Equal(t, -20.0, tr.VariancePercent)
+
+		tr = rollup.GetTask(build.ID)
+		assert.Equal(t, 2.0, tr.EffortVariance)
+		assert.Equal(t, 20.0, tr.VariancePercent)
+
+		tr = rollup.GetTask(phase.ID)
+		assert.Equal(t, 38.0, tr.ActualHours)
+		assert.Equal(t, -2.0, tr.EffortVariance)
+		assert.Equal(t, -5.0, tr.VariancePercent)
+
+		assert.Equal(t, 38.0, rollup.ActualHours)
+		assert.Equal(t, -2.0, rollup.EffortVariance)
+	})
+
+	t.Run("Project not found", func(t *testing.T) {
+		_, err := service.GetProjectRollup(ctx, 9999)
+		assert.Equal(t, entities.ErrRecordNotFound, err)
+	})
+}
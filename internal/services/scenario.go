@@ -0,0 +1,397 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// ScenarioRepository defines the interface for scenario data operations
+type ScenarioRepository interface {
+	Create(ctx context.Context, scenario *entities.Scenario) (*entities.Scenario, error)
+	GetOne(ctx context.Context, id uint) (*entities.Scenario, error)
+	GetMany(ctx context.Context, qParams *entities.ScenarioQueryParams) ([]*entities.Scenario, int64, error)
+	Update(ctx context.Context, scenario *entities.Scenario) (int64, error)
+	Delete(ctx context.Context, id uint) error
+	UpdateTask(ctx context.Context, task *entities.ScenarioTask) (int64, error)
+	UpdateResource(ctx context.Context, resource *entities.ScenarioResource) (int64, error)
+	UpdateAssignment(ctx context.Context, assignment *entities.ScenarioAssignment) (int64, error)
+	UpdateMilestone(ctx context.Context, milestone *entities.ScenarioMilestone) (int64, error)
+	Promote(ctx context.Context, scenario *entities.Scenario, promotedAt time.Time) error
+}
+
+// ScenarioService handles what-if scenarios forked from the live plan of a project
+type ScenarioService struct {
+	repo                ScenarioRepository
+	projectRepo         ProjectRepository
+	taskRepo            TaskRepository
+	dependencyRepo      TaskDependencyRepository
+	calendarRepo        CalendarRepository
+	milestoneRepo       MilestoneRepository
+	projectResourceRepo ProjectResourceRepository
+	assignmentRepo      TaskAssignmentRepository
+	now                 func() time.Time
+}
+
+// NewScenarioService creates a new scenario service
+func NewScenarioService(repo ScenarioRepository, projectRepo ProjectRepository, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository, milestoneRepo MilestoneRepository, projectResourceRepo ProjectResourceRepository, assignmentRepo TaskAssignmentRepository) *ScenarioService {
+	return &ScenarioService{
+		repo:                repo,
+		projectRepo:         projectRepo,
+		taskRepo:            taskRepo,
+		dependencyRepo:      dependencyRepo,
+		calendarRepo:        calendarRepo,
+		milestoneRepo:       milestoneRepo,
+		projectResourceRepo: projectResourceRepo,
+		assignmentRepo:      assignmentRepo,
+		now:                 time.Now,
+	}
+}
+
+// CreateScenario creates a scenario by forking the live plan of its project
+func (s *ScenarioService) CreateScenario(ctx context.Context, scenario *entities.Scenario) (*entities.Scenario, error) {
+	if scenario.ProjectID == 0 {
+		return nil, entities.ErrScenarioInvalidProjectID
+	}
+	project, err := s.projectRepo.GetOne(ctx, scenario.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, scenario)
+	if err != nil {
+		return nil, err
+	}
+	localizeScenario(project, created)
+	return created, nil
+}
+
+// GetScenario retrieves a single scenario by ID together with its forked rows
+func (s *ScenarioService) GetScenario(ctx context.Context, id uint) (*entities.Scenario, error) {
+	scenario, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetOne(ctx, scenario.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	localizeScenario(project, scenario)
+	return scenario, nil
+}
+
+// GetScenarios retrieves multiple scenarios with optional query parameters
+func (s *ScenarioService) GetScenarios(ctx context.Context, params *entities.ScenarioQueryParams) (*entities.ScenarioListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ScenarioListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateScenario renames a draft scenario or changes its description
+func (s *ScenarioService) UpdateScenario(ctx context.Context, scenario *entities.Scenario) (int64, error) {
+	existing, err := s.draftScenario(ctx, scenario.ID)
+	if err != nil {
+		return 0, err
+	}
+	existing.Name = scenario.Name
+	existing.Description = scenario.Description
+	return s.repo.Update(ctx, existing)
+}
+
+// DeleteScenario deletes a scenario and its forked rows by ID
+func (s *ScenarioService) DeleteScenario(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// UpdateScenarioTask changes the effort, duration, milestone or constraint of a task in a draft scenario
+func (s *ScenarioService) UpdateScenarioTask(ctx context.Context, task *entities.ScenarioTask) (int64, error) {
+	scenario, err := s.draftScenario(ctx, task.ScenarioID)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range scenario.Tasks {
+		if row.ID != task.ID {
+			continue
+		}
+		row.MilestoneID = task.MilestoneID
+		row.EstimatedEffort = task.EstimatedEffort
+		row.Duration = task.Duration
+		row.ConstraintType = task.ConstraintType
+		row.ConstraintDate = task.ConstraintDate
+		if err := newProjectZones(s.projectRepo).normalize(ctx, scenario.ProjectID, &row.ConstraintDate); err != nil {
+			return 0, err
+		}
+		return s.repo.UpdateTask(ctx, row)
+	}
+	return 0, entities.ErrRecordNotFound
+}
+
+// UpdateScenarioResource changes the allocation, cost or headcount of a resource in a draft scenario
+func (s *ScenarioService) UpdateScenarioResource(ctx context.Context, resource *entities.ScenarioResource) (int64, error) {
+	scenario, err := s.draftScenario(ctx, resource.ScenarioID)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range scenario.Resources {
+		if row.ID != resource.ID {
+			continue
+		}
+		row.Allocation = resource.Allocation
+		row.Cost = resource.Cost
+		row.Headcount = resource.Headcount
+		return s.repo.UpdateResource(ctx, row)
+	}
+	return 0, entities.ErrRecordNotFound
+}
+
+// UpdateScenarioAssignment changes the planned hours or units of an assignment in a draft scenario
+func (s *ScenarioService) UpdateScenarioAssignment(ctx context.Context, assignment *entities.ScenarioAssignment) (int64, error) {
+	scenario, err := s.draftScenario(ctx, assignment.ScenarioID)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range scenario.Assignments {
+		if row.ID != assignment.ID {
+			continue
+		}
+		row.PlannedHours = assignment.PlannedHours
+		row.Units = assignment.Units
+		return s.repo.UpdateAssignment(ctx, row)
+	}
+	return 0, entities.ErrRecordNotFound
+}
+
+// UpdateScenarioMilestone changes the dates of a milestone in a draft scenario
+func (s *ScenarioService) UpdateScenarioMilestone(ctx context.Context, milestone *entities.ScenarioMilestone) (int64, error) {
+	scenario, err := s.draftScenario(ctx, milestone.ScenarioID)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range scenario.Milestones {
+		if row.ID != milestone.ID {
+			continue
+		}
+		row.StartDate = milestone.StartDate
+		row.EndDate = milestone.EndDate
+		if err := newProjectZones(s.projectRepo).normalize(ctx, scenario.ProjectID, &row.StartDate, &row.EndDate); err != nil {
+			return 0, err
+		}
+		return s.repo.UpdateMilestone(ctx, row)
+	}
+	return 0, entities.ErrRecordNotFound
+}
+
+// PromoteScenario makes a draft scenario the live plan of its project. Allocations with a
+// headcount above 1 cannot be promoted until the extra people are allocated to the project.
+func (s *ScenarioService) PromoteScenario(ctx context.Context, id uint) error {
+	scenario, err := s.draftScenario(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, r := range scenario.Resources {
+		if r.Headcount > 1 {
+			return entities.ErrScenarioHeadcountNotLive
+		}
+	}
+	return s.repo.Promote(ctx, scenario, s.now().UTC())
+}
+
+// CompareScenario schedules the live plan and the scenario the same way and compares their
+// finish dates, milestone forecasts and cost. Rows the scenario did not fork follow the live plan.
+func (s *ScenarioService) CompareScenario(ctx context.Context, id uint) (*entities.ScenarioComparison, error) {
+	scenario, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetOne(ctx, scenario.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.StartDate == nil {
+		return nil, entities.ErrScheduleStartDateRequired
+	}
+	calendar, err := projectCalendar(ctx, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	dependencies, _, err := s.dependencyRepo.GetMany(ctx, &entities.TaskDependencyQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	milestones, _, err := s.milestoneRepo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: project.ID, Status: entities.MilestoneStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	resources, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{ProjectID: project.ID, Status: entities.ProjectResourceStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	liveCapacity := allocatedCapacity(project, resources, now)
+	forkCapacity := make(map[uint]float64, len(resources))
+	live := &entities.ScenarioOutcome{Capacity: totalCapacity(liveCapacity)}
+	fork := &entities.ScenarioOutcome{}
+
+	// Apply the scenario to copies of the live rows
+	forkedTasks := make(map[uint]*entities.ScenarioTask, len(scenario.Tasks))
+	for _, st := range scenario.Tasks {
+		forkedTasks[st.TaskID] = st
+	}
+	scenarioTasks := make([]*entities.Task, 0, len(tasks))
+	for _, t := range tasks {
+		copied := *t
+		if st, ok := forkedTasks[t.ID]; ok {
+			st.ApplyTo(&copied)
+		}
+		scenarioTasks = append(scenarioTasks, &copied)
+	}
+
+	forkedMilestones := make(map[uint]*entities.ScenarioMilestone, len(scenario.Milestones))
+	for _, sm := range scenario.Milestones {
+		forkedMilestones[sm.MilestoneID] = sm
+	}
+	scenarioMilestones := make([]*entities.Milestone, 0, len(milestones))
+	for _, m := range milestones {
+		copied := *m
+		if sm, ok := forkedMilestones[m.ID]; ok {
+			sm.ApplyTo(&copied)
+		}
+		scenarioMilestones = append(scenarioMilestones, &copied)
+	}
+
+	forkedResources := make(map[uint]*entities.ScenarioResource, len(scenario.Resources))
+	for _, sr := range scenario.Resources {
+		forkedResources[sr.ProjectResourceID] = sr
+	}
+	for _, pr := range resources {
+		live.Cost += pr.Cost
+		sr, ok := forkedResources[pr.ID]
+		if !ok {
+			sr = entities.NewScenarioResource(scenario.ID, pr)
+		}
+		fork.Cost += sr.TotalCost()
+		if allocatedOn(project, pr, now) {
+			forkCapacity[pr.ID] = sr.FullTimeEquivalent()
+		}
+	}
+
+	forkedAssignments := make(map[uint]*entities.ScenarioAssignment, len(scenario.Assignments))
+	for _, sa := range scenario.Assignments {
+		forkedAssignments[sa.TaskAssignmentID] = sa
+	}
+	for _, a := range assignments {
+		live.PlannedHours += a.PlannedHours
+		if sa, ok := forkedAssignments[a.ID]; ok {
+			fork.PlannedHours += sa.PlannedHours
+		} else {
+			fork.PlannedHours += a.PlannedHours
+		}
+	}
+
+	fork.Capacity = totalCapacity(forkCapacity)
+
+	livePlan, err := newProjectPlan(project, calendar, tasks, dependencies)
+	if err != nil {
+		return nil, err
+	}
+	scenarioPlan, err := newProjectPlan(project, calendar, scenarioTasks, dependencies)
+	if err != nil {
+		return nil, err
+	}
+	liveSchedule, scenarioSchedule := livePlan.schedule(), scenarioPlan.schedule()
+
+	live.FinishDate = liveSchedule.FinishDate
+	_, live.RemainingEffort = remainingEffort(tasks)
+	live.ForecastDate = forecastFinish(project, calendar, live.RemainingEffort, live.Capacity, now)
+	fork.FinishDate = scenarioSchedule.FinishDate
+	_, fork.RemainingEffort = remainingEffort(scenarioTasks)
+	fork.ForecastDate = forecastFinish(project, calendar, fork.RemainingEffort, fork.Capacity, now)
+
+	comparison := &entities.ScenarioComparison{
+		ScenarioID:      scenario.ID,
+		ProjectID:       project.ID,
+		Name:            scenario.Name,
+		Live:            live,
+		Scenario:        fork,
+		FinishDeltaDays: daysBetween(live.FinishDate, fork.FinishDate),
+		CostDelta:       fork.Cost - live.Cost,
+		Tasks:           make([]*entities.ScenarioTaskComparison, 0, len(liveSchedule.Tasks)),
+		Milestones:      make([]*entities.ScenarioMilestoneComparison, 0, len(milestones)),
+	}
+
+	scheduled := make(map[uint]*entities.TaskSchedule, len(scenarioSchedule.Tasks))
+	for _, ts := range scenarioSchedule.Tasks {
+		scheduled[ts.TaskID] = ts
+	}
+	for _, ts := range liveSchedule.Tasks {
+		other := scheduled[ts.TaskID]
+		comparison.Tasks = append(comparison.Tasks, &entities.ScenarioTaskComparison{
+			TaskID:           ts.TaskID,
+			Name:             ts.Name,
+			LiveStart:        ts.EarlyStart,
+			LiveFinish:       ts.EarlyFinish,
+			ScenarioStart:    other.EarlyStart,
+			ScenarioFinish:   other.EarlyFinish,
+			FinishDeltaDays:  daysBetween(ts.EarlyFinish, other.EarlyFinish),
+			LiveCritical:     ts.IsCritical,
+			ScenarioCritical: other.IsCritical,
+		})
+	}
+
+	forecasts := make(map[uint]*entities.MilestoneForecast, len(milestones))
+	for _, f := range forecastMilestones(project, calendar, scenarioMilestones, scenarioTasks, assignments, forkCapacity, now) {
+		forecasts[f.MilestoneID] = f
+	}
+	for _, f := range forecastMilestones(project, calendar, milestones, tasks, assignments, liveCapacity, now) {
+		other := forecasts[f.MilestoneID]
+		comparison.Milestones = append(comparison.Milestones, &entities.ScenarioMilestoneComparison{
+			MilestoneID:          f.MilestoneID,
+			Name:                 f.Name,
+			LiveDueDate:          f.DueDate,
+			ScenarioDueDate:      other.DueDate,
+			LiveForecastDate:     f.ForecastDate,
+			ScenarioForecastDate: other.ForecastDate,
+			LiveSlipDays:         f.SlipDays,
+			ScenarioSlipDays:     other.SlipDays,
+		})
+	}
+	return comparison, nil
+}
+
+// draftScenario loads a scenario that can still be edited or promoted
+func (s *ScenarioService) draftScenario(ctx context.Context, id uint) (*entities.Scenario, error) {
+	scenario, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !scenario.IsDraft() {
+		return nil, entities.ErrScenarioPromoted
+	}
+	return scenario, nil
+}
+
+// localizeScenario expresses the forked dates of a scenario in the project's time zone
+func localizeScenario(project *entities.Project, scenario *entities.Scenario) {
+	for _, t := range scenario.Tasks {
+		t.ConstraintDate = inProjectZone(project, t.ConstraintDate)
+	}
+	for _, m := range scenario.Milestones {
+		m.StartDate = inProjectZone(project, m.StartDate)
+		m.EndDate = inProjectZone(project, m.EndDate)
+	}
+}
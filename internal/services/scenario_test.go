@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenarioService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewScenarioService(
+		repositories.NewScenarioRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewTaskDependencyRepository(db),
+		repositories.NewCalendarRepository(db),
+		repositories.NewMilestoneRepository(db),
+		repositories.NewProjectResourceRepository(db),
+		repositories.NewTaskAssignmentRepository(db),
+	)
+	service.now = func() time.Time { return at(5, 10) }
+	ctx := context.Background()
+
+	project := createScheduledTestProject(t, db, "WhatIf")
+	alice := createTestHumanResourceForService(t, db, "Alice")
+	pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: alice.ID, Allocation: 100, Cost: entities.NewMoney(1000), Status: entities.ProjectResourceStatusActive}
+	assert.NoError(t, db.Create(pr).Error)
+
+	due := at(7, 0)
+	milestone := createTestMilestoneForService(t, db, project.ID, "Release", &due)
+	a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+	b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+	assert.NoError(t, db.Model(b).Update("milestone_id", milestone.ID).Error)
+	createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+	assignment := &entities.TaskAssignment{TaskID: a.ID, ProjectResourceID: pr.ID, HumanResourceID: alice.ID, PlannedHours: 16, Units: 100}
+	assert.NoError(t, db.Create(assignment).Error)
+	// Alice also works on the release, without planned hours of her own
+	assert.NoError(t, db.Create(&entities.TaskAssignment{TaskID: b.ID, ProjectResourceID: pr.ID, HumanResourceID: alice.ID, Units: 100}).Error)
+
+	scenario, err := service.CreateScenario(ctx, &entities.Scenario{ProjectID: project.ID, Name: "Two people"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint(entities.ScenarioStatusDraft), scenario.Status)
+	assert.Len(t, scenario.Tasks, 2)
+	assert.Len(t, scenario.Resources, 1)
+	assert.Len(t, scenario.Assignments, 2)
+	assert.Len(t, scenario.Milestones, 1)
+
+	t.Run("Unchanged scenario matches the live plan", func(t *testing.T) {
+		comparison, err := service.CompareScenario(ctx, scenario.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, comparison.Live, comparison.Scenario)
+		assert.Equal(t, 0, comparison.FinishDeltaDays)
+		assert.Zero(t, comparison.CostDelta)
+	})
+
+	t.Run("Compare changed durations and headcount", func(t *testing.T) {
+		taskB := findScenarioTask(scenario, b.ID)
+		taskB.EstimatedEffort = 16
+		_, err := service.UpdateScenarioTask(ctx, taskB)
+		assert.NoError(t, err)
+
+		resource := *scenario.Resources[0]
+		resource.Headcount = 2
+		_, err = service.UpdateScenarioResource(ctx, &resource)
+		assert.NoError(t, err)
+
+		planned := *scenario.Assignments[0]
+		planned.PlannedHours = 20
+		_, err = service.UpdateScenarioAssignment(ctx, &planned)
+		assert.NoError(t, err)
+
+		earlier := at(2, 0)
+		release := *scenario.Milestones[0]
+		release.EndDate = &earlier
+		_, err = service.UpdateScenarioMilestone(ctx, &release)
+		assert.NoError(t, err)
+
+		comparison, err := service.CompareScenario(ctx, scenario.ID)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, at(7, 17), comparison.Live.FinishDate)
+		assert.Equal(t, at(8, 17), comparison.Scenario.FinishDate)
+		assert.Equal(t, 1, comparison.FinishDeltaDays)
+
+		// Twice the people work off 32 hours in two days instead of 24 hours in three
+		assert.Equal(t, at(7, 0), *comparison.Live.ForecastDate)
+		assert.Equal(t, at(6, 0), *comparison.Scenario.ForecastDate)
+		assert.Equal(t, 2.0, comparison.Scenario.Capacity)
+		assert.Equal(t, 32.0, comparison.Scenario.RemainingEffort)
+
+		assert.Equal(t, entities.NewMoney(1000), comparison.Live.Cost)
+		assert.Equal(t, entities.NewMoney(2000), comparison.Scenario.Cost)
+		assert.Equal(t, entities.NewMoney(1000), comparison.CostDelta)
+		assert.Equal(t, 16.0, comparison.Live.PlannedHours)
+		assert.Equal(t, 20.0, comparison.Scenario.PlannedHours)
+
+		if assert.Len(t, comparison.Tasks, 2) {
+			for _, tc := range comparison.Tasks {
+				if tc.TaskID == b.ID {
+					assert.Equal(t, 1, tc.FinishDeltaDays)
+				}
+			}
+		}
+		if assert.Len(t, comparison.Milestones, 1) {
+			mc := comparison.Milestones[0]
+			assert.Equal(t, 0, mc.LiveSlipDays)
+			assert.Equal(t, at(5, 0), *mc.ScenarioForecastDate)
+			assert.Equal(t, 3, mc.ScenarioSlipDays)
+		}
+	})
+
+	t.Run("Extra headcount cannot be promoted", func(t *testing.T) {
+		err := service.PromoteScenario(ctx, scenario.ID)
+		assert.Equal(t, entities.ErrScenarioHeadcountNotLive, err)
+	})
+
+	t.Run("Promote to the live plan", func(t *testing.T) {
+		resource := *scenario.Resources[0]
+		resource.Headcount = 1
+		resource.Allocation = 50
+		_, err := service.UpdateScenarioResource(ctx, &resource)
+		assert.NoError(t, err)
+
+		assert.NoError(t, service.PromoteScenario(ctx, scenario.ID))
+
+		var task entities.Task
+		assert.NoError(t, db.First(&task, b.ID).Error)
+		assert.Equal(t, 16.0, task.EstimatedEffort)
+		var allocation entities.ProjectResource
+		assert.NoError(t, db.First(&allocation, pr.ID).Error)
+		assert.Equal(t, 50.0, allocation.Allocation)
+		var live entities.TaskAssignment
+		assert.NoError(t, db.First(&live, assignment.ID).Error)
+		assert.Equal(t, 20.0, live.PlannedHours)
+		var m entities.Milestone
+		assert.NoError(t, db.First(&m, milestone.ID).Error)
+		assert.True(t, at(2, 0).Equal(*m.EndDate))
+
+		promoted, err := service.GetScenario(ctx, scenario.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(entities.ScenarioStatusPromoted), promoted.Status)
+		assert.NotNil(t, promoted.PromotedAt)
+
+		_, err = service.UpdateScenario(ctx, &entities.Scenario{ID: scenario.ID, Name: "Renamed"})
+		assert.Equal(t, entities.ErrScenarioPromoted, err)
+		assert.Equal(t, entities.ErrScenarioPromoted, service.PromoteScenario(ctx, scenario.ID))
+	})
+
+	t.Run("Delete removes the forked rows", func(t *testing.T) {
+		assert.NoError(t, service.DeleteScenario(ctx, scenario.ID))
+
+		var count int64
+		assert.NoError(t, db.Model(&entities.ScenarioTask{}).Where("scenario_id = ?", scenario.ID).Count(&count).Error)
+		assert.Zero(t, count)
+		_, err := service.GetScenario(ctx, scenario.ID)
+		assert.Equal(t, entities.ErrRecordNotFound, err)
+	})
+}
+
+func findScenarioTask(scenario *entities.Scenario, taskID uint) *entities.ScenarioTask {
+	for _, st := range scenario.Tasks {
+		if st.TaskID == taskID {
+			copied := *st
+			return &copied
+		}
+	}
+	return nil
+}
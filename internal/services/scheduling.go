@@ -0,0 +1,521 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// scheduleEpsilon absorbs floating point noise when comparing working hours
+const scheduleEpsilon = 1e-6
+
+// SchedulingService computes Critical Path Method schedules for projects
+type SchedulingService struct {
+	projectRepo    ProjectRepository
+	taskRepo       TaskRepository
+	dependencyRepo TaskDependencyRepository
+	calendarRepo   CalendarRepository
+}
+
+// NewSchedulingService creates a new scheduling service
+func NewSchedulingService(projectRepo ProjectRepository, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository) *SchedulingService {
+	return &SchedulingService{
+		projectRepo:    projectRepo,
+		taskRepo:       taskRepo,
+		dependencyRepo: dependencyRepo,
+		calendarRepo:   calendarRepo,
+	}
+}
+
+// GetProjectSchedule computes early/late dates, floats and the critical path of a project.
+// Task durations are taken from EstimatedEffort in working hours and laid out on the
+// project's calendar, starting from the project start date.
+func (s *SchedulingService) GetProjectSchedule(ctx context.Context, projectID uint) (*entities.ProjectSchedule, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := loadProjectPlan(ctx, s.taskRepo, s.dependencyRepo, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+	return plan.schedule(), nil
+}
+
+// projectPlan is the schedule network of a project together with the calendar it is laid out on
+type projectPlan struct {
+	project  *entities.Project
+	calendar *entities.Calendar
+	network  *scheduleNetwork
+}
+
+// loadProjectPlan loads the tasks, dependencies and calendar of a project and builds its network
+func loadProjectPlan(ctx context.Context, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository, project *entities.Project) (*projectPlan, error) {
+	if project.StartDate == nil {
+		return nil, entities.ErrScheduleStartDateRequired
+	}
+	calendar, err := projectCalendar(ctx, calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, _, err := taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	dependencies, _, err := dependencyRepo.GetMany(ctx, &entities.TaskDependencyQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	return newProjectPlan(project, calendar, tasks, dependencies)
+}
+
+// newProjectPlan builds the network of the given tasks and dependencies and applies the task constraints
+func newProjectPlan(project *entities.Project, calendar *entities.Calendar, tasks []*entities.Task, dependencies []*entities.TaskDependency) (*projectPlan, error) {
+	network, err := newScheduleNetwork(tasks, dependencies, func(task *entities.Task) float64 {
+		return taskDurationHours(task, calendar.HoursPerDay)
+	})
+	if err != nil {
+		return nil, err
+	}
+	plan := &projectPlan{project: project, calendar: calendar, network: network}
+	plan.constrain()
+	return plan, nil
+}
+
+// constrain applies the date constraints of the tasks to their nodes.
+// Constraint dates count from the start of their calendar day, or its end for a finish.
+func (p *projectPlan) constrain() {
+	start := p.startDate()
+	dayStart := func(t time.Time) float64 {
+		return p.calendar.WorkingHoursBetween(start, p.project.DateOf(t))
+	}
+	dayEnd := func(t time.Time) float64 {
+		return p.calendar.WorkingHoursBetween(start, p.project.DateOf(t).AddDate(0, 0, 1))
+	}
+
+	for _, t := range p.network.tasks {
+		switch t.ConstraintType {
+		case entities.TaskConstraintALAP:
+			if node := p.network.startNode[t.ID]; node.kind == scheduleNodeTask {
+				node.alap = true
+			}
+		case entities.TaskConstraintMustStartOn:
+			if t.ConstraintDate != nil {
+				node := p.network.startNode[t.ID]
+				node.minStart = dayStart(*t.ConstraintDate)
+				node.fixedStart = true
+			}
+		case entities.TaskConstraintStartNoEarlierThan:
+			if t.ConstraintDate != nil {
+				p.network.startNode[t.ID].minStart = dayStart(*t.ConstraintDate)
+			}
+		case entities.TaskConstraintFinishNoLaterThan:
+			if t.ConstraintDate != nil {
+				p.network.finishNode[t.ID].maxFinish = dayEnd(*t.ConstraintDate)
+			}
+		}
+	}
+}
+
+// schedule runs both passes over the network and returns the resulting schedule.
+// It can be called again after task leveling delays change.
+func (p *projectPlan) schedule() *entities.ProjectSchedule {
+	p.network.solve()
+	return p.network.projectSchedule(p.project, p.calendar)
+}
+
+// startDate returns the instant from which the project's working hours are counted
+func (p *projectPlan) startDate() time.Time {
+	return p.project.DateOf(*p.project.StartDate)
+}
+
+// taskDurationHours returns the working hours a task occupies in the schedule.
+// A duration in working days wins over the estimated effort.
+func taskDurationHours(task *entities.Task, hoursPerDay float64) float64 {
+	if task.IsCancelled() {
+		return 0
+	}
+	if task.Duration > 0 {
+		return task.Duration * hoursPerDay
+	}
+	return task.EstimatedEffort
+}
+
+// scheduleNodeKind tells what a node of the schedule network stands for
+type scheduleNodeKind int
+
+const (
+	scheduleNodeTask          scheduleNodeKind = iota // A leaf task with a duration
+	scheduleNodeSummaryStart                          // Zero-duration start event of a summary task
+	scheduleNodeSummaryFinish                         // Zero-duration finish event of a summary task
+)
+
+// scheduleLink is a precedence constraint between two nodes
+type scheduleLink struct {
+	node *scheduleNode
+	kind entities.DependencyType
+	lag  float64
+}
+
+// scheduleNode is an activity of the schedule network. Offsets are working hours from the project start.
+type scheduleNode struct {
+	task       *entities.Task
+	kind       scheduleNodeKind
+	duration   float64
+	minStart   float64 // Start no earlier than
+	maxFinish  float64 // Finish no later than
+	fixedStart bool    // Start exactly at minStart, whatever the predecessors
+	alap       bool    // Start as late as possible without delaying successors
+	preds      []scheduleLink
+	succs      []scheduleLink
+	es         float64
+	ef         float64
+	ls         float64
+	lf         float64
+	freeFloat  float64
+}
+
+// scheduleNetwork is an activity-on-node network of a project's tasks.
+// Summary tasks are split into start and finish events that wrap their
+// children, so dependencies of every type can point at them.
+type scheduleNetwork struct {
+	tasks        []*entities.Task
+	nodes        []*scheduleNode
+	order        []*scheduleNode
+	startNode    map[uint]*scheduleNode
+	finishNode   map[uint]*scheduleNode
+	children     map[uint][]*entities.Task
+	ignored      []*entities.TaskDependency
+	finishOffset float64
+}
+
+// newScheduleNetwork builds the network. Dependencies whose other end is not among
+// the given tasks are ignored and reported in the schedule.
+func newScheduleNetwork(tasks []*entities.Task, dependencies []*entities.TaskDependency, duration func(*entities.Task) float64) (*scheduleNetwork, error) {
+	n := &scheduleNetwork{
+		tasks:      tasks,
+		startNode:  make(map[uint]*scheduleNode),
+		finishNode: make(map[uint]*scheduleNode),
+		children:   make(map[uint][]*entities.Task),
+	}
+
+	inNetwork := make(map[uint]bool, len(tasks))
+	for _, t := range tasks {
+		inNetwork[t.ID] = true
+	}
+	for _, t := range tasks {
+		if t.ParentID != nil && inNetwork[*t.ParentID] {
+			n.children[*t.ParentID] = append(n.children[*t.ParentID], t)
+		}
+	}
+
+	for _, t := range tasks {
+		if len(n.children[t.ID]) > 0 {
+			start := &scheduleNode{task: t, kind: scheduleNodeSummaryStart, maxFinish: math.Inf(1)}
+			finish := &scheduleNode{task: t, kind: scheduleNodeSummaryFinish, maxFinish: math.Inf(1)}
+			n.nodes = append(n.nodes, start, finish)
+			n.startNode[t.ID] = start
+			n.finishNode[t.ID] = finish
+			link(start, finish, entities.DependencyFinishToStart, 0)
+			continue
+		}
+		node := &scheduleNode{task: t, kind: scheduleNodeTask, duration: math.Max(0, duration(t)), maxFinish: math.Inf(1)}
+		n.nodes = append(n.nodes, node)
+		n.startNode[t.ID] = node
+		n.finishNode[t.ID] = node
+	}
+
+	// A summary starts no later than its children and finishes no earlier
+	for parentID, children := range n.children {
+		for _, child := range children {
+			link(n.startNode[parentID], n.startNode[child.ID], entities.DependencyStartToStart, 0)
+			link(n.finishNode[child.ID], n.finishNode[parentID], entities.DependencyFinishToFinish, 0)
+		}
+	}
+
+	for _, d := range dependencies {
+		if !inNetwork[d.PredecessorID] || !inNetwork[d.SuccessorID] {
+			n.ignored = append(n.ignored, d)
+			continue
+		}
+		from := n.finishNode[d.PredecessorID]
+		if d.Type == entities.DependencyStartToStart || d.Type == entities.DependencyStartToFinish {
+			from = n.startNode[d.PredecessorID]
+		}
+		to := n.startNode[d.SuccessorID]
+		if d.Type == entities.DependencyFinishToFinish || d.Type == entities.DependencyStartToFinish {
+			to = n.finishNode[d.SuccessorID]
+		}
+		link(from, to, d.Type, d.LagHours)
+	}
+
+	if err := n.sortTopologically(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func link(from, to *scheduleNode, kind entities.DependencyType, lag float64) {
+	from.succs = append(from.succs, scheduleLink{node: to, kind: kind, lag: lag})
+	to.preds = append(to.preds, scheduleLink{node: from, kind: kind, lag: lag})
+}
+
+// sortTopologically orders the nodes so every predecessor comes before its successors
+func (n *scheduleNetwork) sortTopologically() error {
+	inDegree := make(map[*scheduleNode]int, len(n.nodes))
+	queue := make([]*scheduleNode, 0, len(n.nodes))
+	for _, node := range n.nodes {
+		inDegree[node] = len(node.preds)
+		if inDegree[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	n.order = make([]*scheduleNode, 0, len(n.nodes))
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		n.order = append(n.order, node)
+		for _, l := range node.succs {
+			inDegree[l.node]--
+			if inDegree[l.node] == 0 {
+				queue = append(queue, l.node)
+			}
+		}
+	}
+
+	if len(n.order) != len(n.nodes) {
+		return entities.ErrScheduleCycle
+	}
+	return nil
+}
+
+// solve computes the offsets of every node. It can be called again after node durations change.
+func (n *scheduleNetwork) solve() {
+	n.forwardPass()
+	n.backwardPass()
+	n.delayLateNodes()
+}
+
+// forwardPass computes early start and finish offsets.
+// A task with a leveling delay starts that many working hours after it otherwise could.
+func (n *scheduleNetwork) forwardPass() {
+	n.finishOffset = 0
+	for _, node := range n.order {
+		es := math.Max(0, node.minStart)
+		if node.fixedStart {
+			node.es = es
+			node.ef = es + node.duration
+			n.finishOffset = math.Max(n.finishOffset, node.ef)
+			continue
+		}
+		for _, l := range node.preds {
+			var earliest float64
+			switch l.kind {
+			case entities.DependencyStartToStart:
+				earliest = l.node.es + l.lag
+			case entities.DependencyFinishToFinish:
+				earliest = l.node.ef + l.lag - node.duration
+			case entities.DependencyStartToFinish:
+				earliest = l.node.es + l.lag - node.duration
+			default:
+				earliest = l.node.ef + l.lag
+			}
+			es = math.Max(es, earliest)
+		}
+		if node.kind == scheduleNodeTask {
+			es += math.Max(0, node.task.LevelingDelay)
+		}
+		node.es = es
+		node.ef = es + node.duration
+		n.finishOffset = math.Max(n.finishOffset, node.ef)
+	}
+}
+
+// backwardPass computes late start and finish offsets and free float.
+// It must run after forwardPass. A missed finish constraint shows as negative total float.
+func (n *scheduleNetwork) backwardPass() {
+	for i := len(n.order) - 1; i >= 0; i-- {
+		node := n.order[i]
+		lf := math.Min(n.finishOffset, node.maxFinish)
+		for _, l := range node.succs {
+			var latest float64
+			switch l.kind {
+			case entities.DependencyStartToStart:
+				latest = l.node.ls - l.lag + node.duration
+			case entities.DependencyFinishToFinish:
+				latest = l.node.lf - l.lag
+			case entities.DependencyStartToFinish:
+				latest = l.node.lf - l.lag + node.duration
+			default:
+				latest = l.node.ls - l.lag
+			}
+			lf = math.Min(lf, latest)
+		}
+		if node.fixedStart {
+			lf = math.Min(lf, node.ef)
+		}
+		node.lf = lf
+		node.ls = lf - node.duration
+		node.freeFloat = n.freeFloat(node)
+	}
+}
+
+// freeFloat returns how far a node can slip without delaying any successor or the project
+func (n *scheduleNetwork) freeFloat(node *scheduleNode) float64 {
+	freeFloat := n.finishOffset - node.ef
+	for _, l := range node.succs {
+		var slack float64
+		switch l.kind {
+		case entities.DependencyStartToStart:
+			slack = l.node.es - (node.es + l.lag)
+		case entities.DependencyFinishToFinish:
+			slack = l.node.ef - (node.ef + l.lag)
+		case entities.DependencyStartToFinish:
+			slack = l.node.ef - (node.es + l.lag)
+		default:
+			slack = l.node.es - (node.ef + l.lag)
+		}
+		freeFloat = math.Min(freeFloat, slack)
+	}
+	return math.Max(0, freeFloat)
+}
+
+// delayLateNodes moves as-late-as-possible tasks forward by their free float, last ones first
+// so earlier tasks can use the room they leave. It must run after backwardPass.
+func (n *scheduleNetwork) delayLateNodes() {
+	delayed := false
+	for i := len(n.order) - 1; i >= 0; i-- {
+		node := n.order[i]
+		if !node.alap {
+			continue
+		}
+		shift := n.freeFloat(node)
+		node.es += shift
+		node.ef += shift
+		delayed = true
+	}
+	if !delayed {
+		return
+	}
+	for _, node := range n.order {
+		node.freeFloat = n.freeFloat(node)
+	}
+}
+
+// projectSchedule converts the computed offsets into dates in the project's time zone
+func (n *scheduleNetwork) projectSchedule(project *entities.Project, calendar *entities.Calendar) *entities.ProjectSchedule {
+	start := project.DateOf(*project.StartDate)
+	startAt := func(offset float64) time.Time {
+		return calendar.NextWorkingTime(calendar.AddWorkingHours(start, offset))
+	}
+	finishAt := func(offset float64) time.Time {
+		return calendar.AddWorkingHours(start, offset)
+	}
+
+	schedule := &entities.ProjectSchedule{
+		ProjectID:           project.ID,
+		StartDate:           startAt(0),
+		FinishDate:          finishAt(n.finishOffset),
+		DurationHours:       n.finishOffset,
+		Tasks:               make([]*entities.TaskSchedule, 0, len(n.tasks)),
+		CriticalPath:        []uint{},
+		IgnoredDependencies: append([]*entities.TaskDependency{}, n.ignored...),
+	}
+
+	offsets := make(map[uint]*taskOffsets, len(n.tasks))
+	var critical []*taskOffsets
+	for _, t := range n.tasks {
+		o := n.taskOffsets(t.ID, offsets)
+		ts := &entities.TaskSchedule{
+			TaskID:        t.ID,
+			Name:          t.Name,
+			ParentID:      t.ParentID,
+			MilestoneID:   t.MilestoneID,
+			IsSummary:     len(n.children[t.ID]) > 0,
+			DurationHours: o.ef - o.es,
+			LevelingDelay: t.LevelingDelay,
+			EarlyStart:    startAt(o.es),
+			EarlyFinish:   finishAt(o.ef),
+			LateStart:     startAt(o.ls),
+			LateFinish:    finishAt(o.lf),
+			TotalFloat:    o.totalFloat,
+			FreeFloat:     o.freeFloat,
+			IsCritical:    o.totalFloat <= scheduleEpsilon,
+		}
+		schedule.Tasks = append(schedule.Tasks, ts)
+		if ts.IsCritical && !ts.IsSummary {
+			critical = append(critical, o)
+		}
+	}
+
+	sort.SliceStable(critical, func(i, j int) bool {
+		if critical[i].es != critical[j].es {
+			return critical[i].es < critical[j].es
+		}
+		return critical[i].taskID < critical[j].taskID
+	})
+	for _, o := range critical {
+		schedule.CriticalPath = append(schedule.CriticalPath, o.taskID)
+	}
+
+	return schedule
+}
+
+// taskOffsets are the schedule offsets of a task, summaries spanning their children
+type taskOffsets struct {
+	taskID     uint
+	es         float64
+	ef         float64
+	ls         float64
+	lf         float64
+	totalFloat float64
+	freeFloat  float64
+}
+
+// taskOffsets returns the offsets of a task, computing summaries from their children
+func (n *scheduleNetwork) taskOffsets(taskID uint, memo map[uint]*taskOffsets) *taskOffsets {
+	if o, ok := memo[taskID]; ok {
+		return o
+	}
+
+	children := n.children[taskID]
+	if len(children) == 0 {
+		node := n.startNode[taskID]
+		o := &taskOffsets{
+			taskID:     taskID,
+			es:         node.es,
+			ef:         node.ef,
+			ls:         node.ls,
+			lf:         node.lf,
+			totalFloat: node.ls - node.es,
+			freeFloat:  node.freeFloat,
+		}
+		memo[taskID] = o
+		return o
+	}
+
+	o := &taskOffsets{
+		taskID:     taskID,
+		es:         math.Inf(1),
+		ef:         math.Inf(-1),
+		ls:         math.Inf(1),
+		lf:         math.Inf(-1),
+		totalFloat: math.Inf(1),
+		freeFloat:  n.finishNode[taskID].freeFloat,
+	}
+	for _, child := range children {
+		c := n.taskOffsets(child.ID, memo)
+		o.es = math.Min(o.es, c.es)
+		o.ef = math.Max(o.ef, c.ef)
+		o.ls = math.Min(o.ls, c.ls)
+		o.lf = math.Max(o.lf, c.lf)
+		o.totalFloat = math.Min(o.totalFloat, c.totalFloat)
+	}
+	memo[taskID] = o
+	return o
+}
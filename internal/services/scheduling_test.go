@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestSchedulingService(db *gorm.DB) *SchedulingService {
+	return NewSchedulingService(
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewTaskDependencyRepository(db),
+		repositories.NewCalendarRepository(db),
+	)
+}
+
+// createScheduledTestProject creates a project starting on Monday 2026-01-05
+func createScheduledTestProject(t *testing.T, db *gorm.DB, name string) *entities.Project {
+	project := createTestProjectForService(t, db, name)
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, db.Model(project).Update("start_date", start).Error)
+	return project
+}
+
+func createEffortTestTask(t *testing.T, db *gorm.DB, projectID uint, name string, parentID *uint, effort float64) *entities.Task {
+	task := createTestTaskForService(t, db, projectID, name, parentID)
+	assert.NoError(t, db.Model(task).Update("estimated_effort", effort).Error)
+	return task
+}
+
+func createTestDependency(t *testing.T, db *gorm.DB, predecessorID, successorID uint, kind entities.DependencyType, lag float64) {
+	dependency := &entities.TaskDependency{PredecessorID: predecessorID, SuccessorID: successorID, Type: kind, LagHours: lag}
+	assert.NoError(t, db.Create(dependency).Error)
+}
+
+func at(day, hour int) time.Time {
+	return time.Date(2026, 1, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestSchedulingService_GetProjectSchedule(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := newTestSchedulingService(db)
+	ctx := context.Background()
+
+	t.Run("Critical path and floats", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Network")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+		c := createEffortTestTask(t, db, project.ID, "C", nil, 4)
+		d := createEffortTestTask(t, db, project.ID, "D", nil, 8)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+		createTestDependency(t, db, a.ID, c.ID, entities.DependencyFinishToStart, 0)
+		createTestDependency(t, db, b.ID, d.ID, entities.DependencyFinishToStart, 0)
+		createTestDependency(t, db, c.ID, d.ID, entities.DependencyFinishToStart, 0)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 32.0, schedule.DurationHours)
+		assert.Equal(t, at(5, 9), schedule.StartDate)
+		assert.Equal(t, at(8, 17), schedule.FinishDate)
+		assert.Equal(t, []uint{a.ID, b.ID, d.ID}, schedule.CriticalPath)
+
+		ts := schedule.GetTask(c.ID)
+		assert.False(t, ts.IsCritical)
+		assert.Equal(t, 4.0, ts.TotalFloat)
+		assert.Equal(t, 4.0, ts.FreeFloat)
+		assert.Equal(t, at(7, 9), ts.EarlyStart)
+		assert.Equal(t, at(7, 13), ts.EarlyFinish)
+		assert.Equal(t, at(7, 13), ts.LateStart)
+		assert.Equal(t, at(7, 17), ts.LateFinish)
+
+		ts = schedule.GetTask(d.ID)
+		assert.True(t, ts.IsCritical)
+		assert.Equal(t, at(8, 9), ts.EarlyStart)
+	})
+
+	t.Run("Weekends are skipped", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Weekend")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 40)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(9, 17), schedule.GetTask(a.ID).EarlyFinish)
+		assert.Equal(t, at(12, 9), schedule.GetTask(b.ID).EarlyStart)
+		assert.Equal(t, at(12, 17), schedule.FinishDate)
+	})
+
+	t.Run("Dependency types and lag", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Types")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+		ss := createEffortTestTask(t, db, project.ID, "SS", nil, 8)
+		ff := createEffortTestTask(t, db, project.ID, "FF", nil, 4)
+		fs := createEffortTestTask(t, db, project.ID, "FS", nil, 4)
+		createTestDependency(t, db, a.ID, ss.ID, entities.DependencyStartToStart, 4)
+		createTestDependency(t, db, a.ID, ff.ID, entities.DependencyFinishToFinish, 0)
+		createTestDependency(t, db, a.ID, fs.ID, entities.DependencyFinishToStart, 8)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(5, 13), schedule.GetTask(ss.ID).EarlyStart)
+		assert.Equal(t, at(6, 13), schedule.GetTask(ff.ID).EarlyStart)
+		assert.Equal(t, at(6, 17), schedule.GetTask(ff.ID).EarlyFinish)
+		assert.Equal(t, at(8, 9), schedule.GetTask(fs.ID).EarlyStart)
+		assert.Equal(t, []uint{a.ID, fs.ID}, schedule.CriticalPath)
+	})
+
+	t.Run("Start to finish", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "StartToFinish")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 4)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyStartToFinish, 12)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(6, 9), schedule.GetTask(b.ID).EarlyStart)
+		assert.Equal(t, at(6, 13), schedule.GetTask(b.ID).EarlyFinish)
+	})
+
+	t.Run("Summary tasks span their children", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Summary")
+		summary := createEffortTestTask(t, db, project.ID, "Summary", nil, 0)
+		x := createEffortTestTask(t, db, project.ID, "X", &summary.ID, 8)
+		y := createEffortTestTask(t, db, project.ID, "Y", &summary.ID, 8)
+		z := createEffortTestTask(t, db, project.ID, "Z", nil, 8)
+		createTestDependency(t, db, x.ID, y.ID, entities.DependencyFinishToStart, 0)
+		createTestDependency(t, db, summary.ID, z.ID, entities.DependencyFinishToStart, 0)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		ts := schedule.GetTask(summary.ID)
+		assert.True(t, ts.IsSummary)
+		assert.True(t, ts.IsCritical)
+		assert.Equal(t, 16.0, ts.DurationHours)
+		assert.Equal(t, at(6, 17), ts.EarlyFinish)
+		assert.Equal(t, at(7, 9), schedule.GetTask(z.ID).EarlyStart)
+		assert.Equal(t, []uint{x.ID, y.ID, z.ID}, schedule.CriticalPath)
+	})
+
+	t.Run("Cancelled tasks take no time", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Cancelled")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+		assert.NoError(t, db.Model(a).Update("status", entities.TaskWorkStatusCancelled).Error)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(5, 9), schedule.GetTask(b.ID).EarlyStart)
+	})
+
+	t.Run("Holidays of the project calendar are skipped", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Holiday")
+		calendar := &entities.Calendar{Name: "Holiday calendar"}
+		assert.NoError(t, db.Create(calendar).Error)
+		assert.NoError(t, db.Create(&entities.CalendarException{CalendarID: calendar.ID, Type: entities.CalendarExceptionHoliday, StartDate: at(6, 0)}).Error)
+		assert.NoError(t, db.Create(&entities.CalendarException{CalendarID: calendar.ID, Type: entities.CalendarExceptionCustomHours, StartDate: at(7, 0), Hours: 4}).Error)
+		assert.NoError(t, db.Model(project).Update("calendar_id", calendar.ID).Error)
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(8, 13), schedule.GetTask(a.ID).EarlyFinish)
+	})
+
+	t.Run("Duration in working days wins over effort", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Duration")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 4)
+		assert.NoError(t, db.Model(a).Update("duration", 2).Error)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 16.0, schedule.GetTask(a.ID).DurationHours)
+		assert.Equal(t, at(6, 17), schedule.GetTask(a.ID).EarlyFinish)
+	})
+
+	t.Run("Date constraints", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Constraints")
+		constrain := func(task *entities.Task, kind uint, date time.Time) {
+			assert.NoError(t, db.Model(task).Updates(map[string]any{"constraint_type": kind, "constraint_date": date}).Error)
+		}
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		snet := createEffortTestTask(t, db, project.ID, "SNET", nil, 8)
+		mso := createEffortTestTask(t, db, project.ID, "MSO", nil, 8)
+		fnlt := createEffortTestTask(t, db, project.ID, "FNLT", nil, 16)
+		alap := createEffortTestTask(t, db, project.ID, "ALAP", nil, 8)
+		createTestDependency(t, db, a.ID, mso.ID, entities.DependencyFinishToStart, 0)
+		constrain(snet, entities.TaskConstraintStartNoEarlierThan, at(7, 0))
+		constrain(mso, entities.TaskConstraintMustStartOn, at(9, 0))
+		constrain(fnlt, entities.TaskConstraintFinishNoLaterThan, at(5, 0))
+		assert.NoError(t, db.Model(alap).Update("constraint_type", entities.TaskConstraintALAP).Error)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(9, 17), schedule.FinishDate)
+		assert.Equal(t, at(7, 9), schedule.GetTask(snet.ID).EarlyStart)
+
+		ts := schedule.GetTask(mso.ID)
+		assert.Equal(t, at(9, 9), ts.EarlyStart)
+		assert.Equal(t, 0.0, ts.TotalFloat)
+		assert.True(t, ts.IsCritical)
+
+		// A deadline that cannot be met shows as negative float
+		ts = schedule.GetTask(fnlt.ID)
+		assert.Equal(t, at(6, 17), ts.EarlyFinish)
+		assert.Equal(t, -8.0, ts.TotalFloat)
+		assert.True(t, ts.IsCritical)
+
+		ts = schedule.GetTask(alap.ID)
+		assert.Equal(t, at(9, 9), ts.EarlyStart)
+		assert.Equal(t, at(9, 17), ts.EarlyFinish)
+		assert.Equal(t, 0.0, ts.FreeFloat)
+	})
+
+	t.Run("Dependencies on other projects are reported", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Downstream")
+		other := createScheduledTestProject(t, db, "Upstream")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		upstream := createEffortTestTask(t, db, other.ID, "Upstream", nil, 40)
+		dependency := &entities.TaskDependency{PredecessorID: upstream.ID, SuccessorID: a.ID, Type: entities.DependencyFinishToStart, AllowCrossProject: true}
+		assert.NoError(t, db.Create(dependency).Error)
+
+		schedule, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, at(5, 9), schedule.GetTask(a.ID).EarlyStart)
+		if assert.Len(t, schedule.IgnoredDependencies, 1) {
+			assert.Equal(t, dependency.ID, schedule.IgnoredDependencies[0].ID)
+		}
+		assert.Nil(t, schedule.GetTask(upstream.ID))
+	})
+
+	t.Run("Start date is required", func(t *testing.T) {
+		project := createTestProjectForService(t, db, "Unscheduled")
+		_, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.Equal(t, entities.ErrScheduleStartDateRequired, err)
+	})
+
+	t.Run("Cycle is reported", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Cycle")
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+		createTestDependency(t, db, b.ID, a.ID, entities.DependencyFinishToStart, 0)
+
+		_, err := service.GetProjectSchedule(ctx, project.ID)
+		assert.Equal(t, entities.ErrScheduleCycle, err)
+	})
+}
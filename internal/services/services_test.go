@@ -0,0 +1,85 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupServiceTestDB opens an isolated in-memory database with every entity migrated
+func setupServiceTestDB(t *testing.T) *gorm.DB {
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	err = db.AutoMigrate(
+		&entities.Client{},
+		&entities.Calendar{},
+		&entities.CalendarException{},
+		&entities.HumanResource{},
+		&entities.Project{},
+		&entities.ProjectResource{},
+		&entities.ProjectRole{},
+		&entities.Milestone{},
+		&entities.Task{},
+		&entities.TaskDependency{},
+		&entities.TaskAssignment{},
+		&entities.Scenario{},
+		&entities.ScenarioTask{},
+		&entities.ScenarioResource{},
+		&entities.ScenarioAssignment{},
+		&entities.ScenarioMilestone{},
+		&entities.TaskRoleEstimate{},
+		&entities.TimeEntry{},
+		&entities.WBSTemplate{},
+		&entities.Buffer{},
+		&entities.Sprint{},
+		&entities.SizeMapping{},
+		&entities.RateCard{},
+		&entities.CostItem{},
+		&entities.ExchangeRate{},
+	)
+	if err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close() //nolint:errcheck
+		}
+	})
+
+	return db
+}
+
+func createTestProjectForService(t *testing.T, db *gorm.DB, name string) *entities.Project {
+	client := &entities.Client{Name: name + " Client", Email: name + "@client.com", Status: entities.ClientStatusActive}
+	assert.NoError(t, db.Create(client).Error)
+	project := &entities.Project{Name: name, ClientID: client.ID, Status: entities.ProjectStatusActive}
+	assert.NoError(t, db.Create(project).Error)
+	return project
+}
+
+func createTestTaskForService(t *testing.T, db *gorm.DB, projectID uint, name string, parentID *uint) *entities.Task {
+	level := 1
+	if parentID != nil {
+		var parent entities.Task
+		assert.NoError(t, db.First(&parent, *parentID).Error)
+		level = parent.Level + 1
+	}
+	task := &entities.Task{Name: name, ProjectID: projectID, ParentID: parentID, Level: level}
+	assert.NoError(t, db.Create(task).Error)
+	return task
+}
+
+func createTestHumanResourceForService(t *testing.T, db *gorm.DB, name string) *entities.HumanResource {
+	hr := &entities.HumanResource{Name: name, Title: "Engineer", Level: "Senior", Status: entities.HumanResourceStatusActive}
+	assert.NoError(t, db.Create(hr).Error)
+	return hr
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// SimulationService runs Monte Carlo simulations of project schedules and costs
+type SimulationService struct {
+	projectRepo         ProjectRepository
+	taskRepo            TaskRepository
+	dependencyRepo      TaskDependencyRepository
+	calendarRepo        CalendarRepository
+	milestoneRepo       MilestoneRepository
+	projectResourceRepo ProjectResourceRepository
+	assignmentRepo      TaskAssignmentRepository
+}
+
+// NewSimulationService creates a new simulation service
+func NewSimulationService(projectRepo ProjectRepository, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository, milestoneRepo MilestoneRepository, projectResourceRepo ProjectResourceRepository, assignmentRepo TaskAssignmentRepository) *SimulationService {
+	return &SimulationService{
+		projectRepo:         projectRepo,
+		taskRepo:            taskRepo,
+		dependencyRepo:      dependencyRepo,
+		calendarRepo:        calendarRepo,
+		milestoneRepo:       milestoneRepo,
+		projectResourceRepo: projectResourceRepo,
+		assignmentRepo:      assignmentRepo,
+	}
+}
+
+// SimulateProject samples the effort of every task with a three-point estimate, lays the
+// sampled durations over the dependency network and prices them, many times over. It returns
+// the P50/P80/P90 finish dates and costs of the project and of its active milestones.
+//
+// A task costs its effort at the hourly rate of its assignments, an allocation's cost spread
+// over the hours planned on it. Tasks without a priced assignment use the project's blended
+// rate, the active allocations' cost spread over the expected effort of all tasks.
+//
+// The same seed gives the same results. The run stops with the context's error when the
+// context is cancelled.
+func (s *SimulationService) SimulateProject(ctx context.Context, projectID uint, options *entities.SimulationOptions) (*entities.ProjectSimulation, error) {
+	if options == nil {
+		options = &entities.SimulationOptions{}
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	iterations, seed := options.Iterations, options.Seed
+	if iterations == 0 {
+		iterations = entities.DefaultSimulationIterations
+	}
+	if seed == 0 {
+		seed = rand.Int64()
+	}
+
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := loadProjectPlan(ctx, s.taskRepo, s.dependencyRepo, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+	milestones, _, err := s.milestoneRepo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: projectID, Status: entities.MilestoneStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	resources, _, err := s.projectResourceRepo.GetMany(ctx, &entities.ProjectResourceQueryParams{ProjectID: projectID, Status: entities.ProjectResourceStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	assignments, _, err := s.assignmentRepo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+
+	sim := newProjectSimulation(plan, milestones, resources, assignments)
+	if err := sim.run(ctx, rand.New(rand.NewPCG(uint64(seed), 0)), iterations); err != nil {
+		return nil, err
+	}
+	result := sim.result()
+	result.Iterations = iterations
+	result.Seed = seed
+	return result, nil
+}
+
+// projectSimulation holds the network of a project being sampled and the outcome of each run
+type projectSimulation struct {
+	plan       *projectPlan
+	milestones []*entities.Milestone
+	leaves     []*entities.Task   // Tasks that are not cancelled and carry work
+	rates      map[uint]float64   // Hourly rate per leaf task
+	dueOffsets map[uint]float64   // Working hours from the project start to the end of each milestone's due day
+	finishes   []float64          // Project finish offset per run
+	costs      []float64          // Project cost per run
+	msFinishes map[uint][]float64 // Finish offset per milestone and run
+	msCosts    map[uint][]float64 // Cost per milestone and run
+	msOnTime   map[uint]int       // Runs finishing each milestone by its due date
+}
+
+// newProjectSimulation prepares the leaf tasks of a plan and their hourly rates
+func newProjectSimulation(plan *projectPlan, milestones []*entities.Milestone, resources []*entities.ProjectResource, assignments []*entities.TaskAssignment) *projectSimulation {
+	sim := &projectSimulation{
+		plan:       plan,
+		milestones: milestones,
+		rates:      make(map[uint]float64),
+		dueOffsets: make(map[uint]float64),
+		msFinishes: make(map[uint][]float64),
+		msCosts:    make(map[uint][]float64),
+		msOnTime:   make(map[uint]int),
+	}
+
+	expected := 0.0
+	for _, t := range plan.network.tasks {
+		if len(plan.network.children[t.ID]) > 0 || t.IsCancelled() {
+			continue
+		}
+		sim.leaves = append(sim.leaves, t)
+		expected += t.EffortEstimate().Expected
+	}
+
+	allocationCost := 0.0
+	for _, pr := range resources {
+		allocationCost += pr.Cost.Float64()
+	}
+	blended := 0.0
+	if expected > 0 {
+		blended = allocationCost / expected
+	}
+
+	plannedHours := make(map[uint]float64)
+	for _, a := range assignments {
+		plannedHours[a.ProjectResourceID] += a.PlannedHours
+	}
+	resourceRates := make(map[uint]float64)
+	for _, pr := range resources {
+		if plannedHours[pr.ID] > 0 {
+			resourceRates[pr.ID] = pr.Cost.Float64() / plannedHours[pr.ID]
+		}
+	}
+
+	// A task's rate is the rate of its assignments weighted by their planned hours
+	pricedHours, pricedCost := make(map[uint]float64), make(map[uint]float64)
+	for _, a := range assignments {
+		if rate, ok := resourceRates[a.ProjectResourceID]; ok && a.PlannedHours > 0 {
+			pricedHours[a.TaskID] += a.PlannedHours
+			pricedCost[a.TaskID] += a.PlannedHours * rate
+		}
+	}
+	for _, t := range sim.leaves {
+		sim.rates[t.ID] = blended
+		if pricedHours[t.ID] > 0 {
+			sim.rates[t.ID] = pricedCost[t.ID] / pricedHours[t.ID]
+		}
+	}
+
+	start := plan.startDate()
+	for _, m := range milestones {
+		sim.dueOffsets[m.ID] = math.Inf(1)
+		if m.EndDate != nil {
+			sim.dueOffsets[m.ID] = plan.calendar.WorkingHoursBetween(start, plan.project.DateOf(*m.EndDate).AddDate(0, 0, 1))
+		}
+	}
+	return sim
+}
+
+// run samples the plan the given number of times, checking for cancellation between runs
+func (sim *projectSimulation) run(ctx context.Context, rng *rand.Rand, iterations int) error {
+	network := sim.plan.network
+	hoursPerDay := sim.plan.calendar.HoursPerDay
+	taskCosts := make(map[uint]float64, len(sim.leaves))
+
+	for i := 0; i < iterations; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		cost := 0.0
+		for _, t := range sim.leaves {
+			sampled := *t
+			sampled.EstimatedEffort = t.SampleEffort(rng)
+			network.startNode[t.ID].duration = math.Max(0, taskDurationHours(&sampled, hoursPerDay))
+			taskCosts[t.ID] = sampled.EstimatedEffort * sim.rates[t.ID]
+			cost += taskCosts[t.ID]
+		}
+		network.solve()
+		sim.finishes = append(sim.finishes, network.finishOffset)
+		sim.costs = append(sim.costs, cost)
+
+		finishes := make(map[uint]float64, len(sim.milestones))
+		costs := make(map[uint]float64, len(sim.milestones))
+		for _, t := range network.tasks {
+			if t.MilestoneID == nil || t.IsCancelled() {
+				continue
+			}
+			finishes[*t.MilestoneID] = math.Max(finishes[*t.MilestoneID], network.finishNode[t.ID].ef)
+			costs[*t.MilestoneID] += taskCosts[t.ID]
+		}
+		for _, m := range sim.milestones {
+			sim.msFinishes[m.ID] = append(sim.msFinishes[m.ID], finishes[m.ID])
+			sim.msCosts[m.ID] = append(sim.msCosts[m.ID], costs[m.ID])
+			if finishes[m.ID] <= sim.dueOffsets[m.ID]+scheduleEpsilon {
+				sim.msOnTime[m.ID]++
+			}
+		}
+	}
+	return nil
+}
+
+// result converts the sampled offsets and costs into percentiles
+func (sim *projectSimulation) result() *entities.ProjectSimulation {
+	project, calendar := sim.plan.project, sim.plan.calendar
+	start := sim.plan.startDate()
+	finishAt := func(offset float64) time.Time {
+		return calendar.AddWorkingHours(start, offset)
+	}
+	datePercentiles := func(offsets []float64) entities.DatePercentiles {
+		slices.Sort(offsets)
+		return entities.DatePercentiles{
+			P50: finishAt(percentile(offsets, 0.5)),
+			P80: finishAt(percentile(offsets, 0.8)),
+			P90: finishAt(percentile(offsets, 0.9)),
+		}
+	}
+	costPercentiles := func(costs []float64) entities.CostPercentiles {
+		slices.Sort(costs)
+		return entities.CostPercentiles{
+			P50: percentile(costs, 0.5),
+			P80: percentile(costs, 0.8),
+			P90: percentile(costs, 0.9),
+		}
+	}
+
+	result := &entities.ProjectSimulation{
+		ProjectID:  project.ID,
+		FinishDate: datePercentiles(sim.finishes),
+		Cost:       costPercentiles(sim.costs),
+		Milestones: make([]*entities.MilestoneSimulation, 0, len(sim.milestones)),
+	}
+	for _, m := range sim.milestones {
+		ms := &entities.MilestoneSimulation{
+			MilestoneID:       m.ID,
+			Name:              m.Name,
+			DueDate:           inProjectZone(project, m.EndDate),
+			FinishDate:        datePercentiles(sim.msFinishes[m.ID]),
+			Cost:              costPercentiles(sim.msCosts[m.ID]),
+			OnTimeProbability: 1,
+		}
+		if runs := len(sim.msFinishes[m.ID]); runs > 0 {
+			ms.OnTimeProbability = float64(sim.msOnTime[m.ID]) / float64(runs)
+		}
+		result.Milestones = append(result.Milestones, ms)
+	}
+	return result
+}
+
+// percentile returns the nearest-rank percentile of sorted values, 0 when there are none
+func percentile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(q*float64(len(sorted)))) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
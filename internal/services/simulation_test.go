@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulationService_SimulateProject(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewSimulationService(
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewTaskDependencyRepository(db),
+		repositories.NewCalendarRepository(db),
+		repositories.NewMilestoneRepository(db),
+		repositories.NewProjectResourceRepository(db),
+		repositories.NewTaskAssignmentRepository(db),
+	)
+	ctx := context.Background()
+	alice := createTestHumanResourceForService(t, db, "Alice")
+
+	t.Run("Certain estimates give the CPM schedule", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Certain")
+		pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: alice.ID, Allocation: 100, Cost: entities.NewMoney(1200), Status: entities.ProjectResourceStatusActive}
+		assert.NoError(t, db.Create(pr).Error)
+		due := at(6, 0)
+		milestone := createTestMilestoneForService(t, db, project.ID, "Beta", &due)
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+		b := createEffortTestTask(t, db, project.ID, "B", nil, 8)
+		assert.NoError(t, db.Model(b).Update("milestone_id", milestone.ID).Error)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+
+		result, err := service.SimulateProject(ctx, project.ID, &entities.SimulationOptions{Iterations: 100, Seed: 1})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 100, result.Iterations)
+		assert.Equal(t, int64(1), result.Seed)
+		assert.Equal(t, entities.DatePercentiles{P50: at(7, 17), P80: at(7, 17), P90: at(7, 17)}, result.FinishDate)
+		assert.Equal(t, entities.CostPercentiles{P50: 1200, P80: 1200, P90: 1200}, result.Cost)
+
+		if assert.Len(t, result.Milestones, 1) {
+			ms := result.Milestones[0]
+			assert.Equal(t, milestone.ID, ms.MilestoneID)
+			assert.Equal(t, at(7, 17), ms.FinishDate.P90)
+			assert.Equal(t, 400.0, ms.Cost.P50)
+			assert.Equal(t, 0.0, ms.OnTimeProbability)
+		}
+	})
+
+	t.Run("Three-point estimates spread the outcome", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Uncertain")
+		pr := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: alice.ID, Allocation: 100, Cost: entities.NewMoney(1000), Status: entities.ProjectResourceStatusActive}
+		assert.NoError(t, db.Create(pr).Error)
+		a := createEffortTestTask(t, db, project.ID, "A", nil, 16)
+		b := createTestTaskForService(t, db, project.ID, "B", nil)
+		b.OptimisticEffort, b.MostLikelyEffort, b.PessimisticEffort = 4, 8, 20
+		assert.NoError(t, db.Save(b).Error)
+		createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+		assignment := &entities.TaskAssignment{TaskID: a.ID, ProjectResourceID: pr.ID, HumanResourceID: alice.ID, PlannedHours: 16, Units: 100}
+		assert.NoError(t, db.Create(assignment).Error)
+
+		options := &entities.SimulationOptions{Iterations: 2000, Seed: 42}
+		result, err := service.SimulateProject(ctx, project.ID, options)
+		if !assert.NoError(t, err) {
+			return
+		}
+		again, err := service.SimulateProject(ctx, project.ID, options)
+		assert.NoError(t, err)
+		assert.Equal(t, result, again)
+
+		finish := result.FinishDate
+		assert.False(t, finish.P80.Before(finish.P50))
+		assert.False(t, finish.P90.Before(finish.P80))
+		assert.True(t, finish.P50.After(at(7, 12)))
+		assert.True(t, finish.P90.Before(at(9, 14)))
+
+		// A costs its allocation; B costs 4 to 20 hours at the blended rate
+		blended := 1000 / (16 + 56.0/6)
+		assert.LessOrEqual(t, result.Cost.P50, result.Cost.P80)
+		assert.LessOrEqual(t, result.Cost.P80, result.Cost.P90)
+		assert.Greater(t, result.Cost.P50, 1000+4*blended)
+		assert.Less(t, result.Cost.P90, 1000+20*blended)
+	})
+
+	t.Run("Random seed is reported", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Seeded")
+		createEffortTestTask(t, db, project.ID, "A", nil, 8)
+
+		result, err := service.SimulateProject(ctx, project.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.DefaultSimulationIterations, result.Iterations)
+		assert.NotZero(t, result.Seed)
+	})
+
+	t.Run("Invalid iterations", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Invalid")
+		_, err := service.SimulateProject(ctx, project.ID, &entities.SimulationOptions{Iterations: -1})
+		assert.ErrorIs(t, err, entities.ErrSimulationInvalidIterations)
+	})
+
+	t.Run("Cancelled run", func(t *testing.T) {
+		project := createScheduledTestProject(t, db, "Cancelled")
+		createEffortTestTask(t, db, project.ID, "A", nil, 8)
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := service.SimulateProject(cancelled, project.ID, &entities.SimulationOptions{Seed: 1})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
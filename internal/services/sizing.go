@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// SizeMappingRepository defines the interface for size mapping data operations
+type SizeMappingRepository interface {
+	Create(ctx context.Context, mapping *entities.SizeMapping) (*entities.SizeMapping, error)
+	GetOne(ctx context.Context, id uint) (*entities.SizeMapping, error)
+	GetMany(ctx context.Context, qParams *entities.SizeMappingQueryParams) ([]*entities.SizeMapping, int64, error)
+	Update(ctx context.Context, mapping *entities.SizeMapping) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// SizingService handles T-shirt and function point sizing of tasks and the per-project tables that
+// map sizes to hours per role. The effort and role estimates of a sized task follow its size unless
+// its effort is overridden by hand or it has a three-point estimate.
+type SizingService struct {
+	repo            SizeMappingRepository
+	taskRepo        TaskRepository
+	projectRoleRepo ProjectRoleRepository
+	estimateRepo    TaskRoleEstimateRepository
+}
+
+// NewSizingService creates a new sizing service
+func NewSizingService(repo SizeMappingRepository, taskRepo TaskRepository, projectRoleRepo ProjectRoleRepository, estimateRepo TaskRoleEstimateRepository) *SizingService {
+	return &SizingService{
+		repo:            repo,
+		taskRepo:        taskRepo,
+		projectRoleRepo: projectRoleRepo,
+		estimateRepo:    estimateRepo,
+	}
+}
+
+// CreateSizeMapping adds a row to a project's sizing table and re-derives the effort of its sized tasks
+func (s *SizingService) CreateSizeMapping(ctx context.Context, mapping *entities.SizeMapping) (*entities.SizeMapping, error) {
+	if err := s.checkRole(ctx, mapping); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ResizeProject(ctx, created.ProjectID); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetSizeMapping retrieves a single size mapping by ID
+func (s *SizingService) GetSizeMapping(ctx context.Context, id uint) (*entities.SizeMapping, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetSizeMappings retrieves multiple size mappings with optional query parameters
+func (s *SizingService) GetSizeMappings(ctx context.Context, params *entities.SizeMappingQueryParams) (*entities.SizeMappingListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.SizeMappingListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateSizeMapping updates a row of a project's sizing table and re-derives the effort of its sized tasks
+func (s *SizingService) UpdateSizeMapping(ctx context.Context, mapping *entities.SizeMapping) (int64, error) {
+	if err := s.checkRole(ctx, mapping); err != nil {
+		return 0, err
+	}
+	rows, err := s.repo.Update(ctx, mapping)
+	if err != nil {
+		return rows, err
+	}
+	if _, err := s.ResizeProject(ctx, mapping.ProjectID); err != nil {
+		return rows, err
+	}
+	return rows, nil
+}
+
+// DeleteSizeMapping deletes a row of a project's sizing table and re-derives the effort of its sized tasks
+func (s *SizingService) DeleteSizeMapping(ctx context.Context, id uint) error {
+	mapping, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	_, err = s.ResizeProject(ctx, mapping.ProjectID)
+	return err
+}
+
+// checkRole checks that the role of a size mapping belongs to its project
+func (s *SizingService) checkRole(ctx context.Context, mapping *entities.SizeMapping) error {
+	if err := mapping.Validate(); err != nil {
+		return err
+	}
+	role, err := s.projectRoleRepo.GetOne(ctx, mapping.ProjectRoleID)
+	if err != nil {
+		return err
+	}
+	if role.ProjectID != mapping.ProjectID {
+		return entities.ErrSizeMappingRoleMismatch
+	}
+	return nil
+}
+
+// SizeTasks gives a batch of tasks of one project the same T-shirt size or function points and
+// derives their effort and role estimates from the project's sizing table
+func (s *SizingService) SizeTasks(ctx context.Context, sizing *entities.TaskSizing) (*entities.SizingResult, error) {
+	if err := sizing.Validate(); err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ID_In: sizing.TaskIDs})
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) != len(uniqueIDs(sizing.TaskIDs)) {
+		return nil, entities.ErrRecordNotFound
+	}
+	projectID := tasks[0].ProjectID
+	for _, t := range tasks {
+		if t.ProjectID != projectID {
+			return nil, entities.ErrTaskSizingProjectMismatch
+		}
+		t.Size, t.FunctionPoints = sizing.Size, sizing.FunctionPoints
+	}
+	return s.derive(ctx, projectID, tasks)
+}
+
+// OverrideTaskEffort sets the effort of a sized task by hand, keeping its size. A nil effort
+// removes the override and derives the effort from the size again.
+func (s *SizingService) OverrideTaskEffort(ctx context.Context, taskID uint, effort *float64) (*entities.Task, error) {
+	task, err := s.taskRepo.GetOne(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if effort != nil {
+		task.EstimatedEffort = *effort
+		task.EffortOverride = true
+		if _, err := s.taskRepo.Update(ctx, task); err != nil {
+			return nil, err
+		}
+		return task, nil
+	}
+	task.EffortOverride = false
+	if _, err := s.derive(ctx, task.ProjectID, []*entities.Task{task}); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// ResizeProject derives the effort and role estimates of all sized tasks of a project from its sizing table again
+func (s *SizingService) ResizeProject(ctx context.Context, projectID uint) (*entities.SizingResult, error) {
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	sized := make([]*entities.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if t.IsSized() {
+			sized = append(sized, t)
+		}
+	}
+	return s.derive(ctx, projectID, sized)
+}
+
+// GetSizingSummary counts the open work packages of a project by size and adds up the effort
+// their sizes map to, for a rough estimate of the backlog before it is estimated in detail
+func (s *SizingService) GetSizingSummary(ctx context.Context, projectID uint) (*entities.SizingSummary, error) {
+	table, err := s.sizingTable(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	hasChildren := make(map[uint]bool)
+	for _, t := range tasks {
+		if t.ParentID != nil && *t.ParentID != t.ID {
+			hasChildren[*t.ParentID] = true
+		}
+	}
+
+	summary := &entities.SizingSummary{ProjectID: projectID}
+	counts := make(map[entities.SizeUnit]*entities.SizeCount)
+	for _, unit := range append(entities.TShirtSizes(), entities.SizeUnitFunctionPoint) {
+		counts[unit] = &entities.SizeCount{Unit: unit}
+		summary.Sizes = append(summary.Sizes, counts[unit])
+	}
+	for _, t := range tasks {
+		if hasChildren[t.ID] || t.IsCancelled() || t.IsDone() {
+			continue
+		}
+		if !t.IsSized() {
+			summary.UnsizedTasks++
+			continue
+		}
+		effort, _ := table.effort(t)
+		c := counts[t.SizeUnit()]
+		c.Tasks++
+		c.Points += t.FunctionPoints
+		c.Effort += effort
+		summary.SizedTasks++
+		summary.SizedEffort += effort
+		summary.EstimatedEffort += t.EstimatedEffort
+		if keepsEstimate(t) {
+			summary.OverriddenTasks++
+		}
+	}
+	return summary, nil
+}
+
+// derive saves the given tasks of a project with the effort and role estimates their size maps to.
+// Tasks that keep their estimate, or whose size has no hours in the table, are saved as they are.
+func (s *SizingService) derive(ctx context.Context, projectID uint, tasks []*entities.Task) (*entities.SizingResult, error) {
+	result := &entities.SizingResult{ProjectID: projectID}
+	if len(tasks) == 0 {
+		return result, nil
+	}
+	table, err := s.sizingTable(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+	estimates, _, err := s.estimateRepo.GetMany(ctx, &entities.TaskRoleEstimateQueryParams{TaskID_In: ids})
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[uint]map[uint]*entities.TaskRoleEstimate)
+	for _, e := range estimates {
+		if existing[e.TaskID] == nil {
+			existing[e.TaskID] = make(map[uint]*entities.TaskRoleEstimate)
+		}
+		existing[e.TaskID][e.ProjectRoleID] = e
+	}
+
+	for _, t := range tasks {
+		effort, roles := table.effort(t)
+		derived := t.IsSized() && !keepsEstimate(t) && roles != nil
+		if derived {
+			t.EstimatedEffort = effort
+		}
+		if _, err := s.taskRepo.Update(ctx, t); err != nil {
+			return nil, err
+		}
+		switch {
+		case !t.IsSized():
+			continue
+		case keepsEstimate(t):
+			result.Overridden++
+			continue
+		case !derived:
+			result.Unmapped++
+			continue
+		}
+		if err := s.syncEstimates(ctx, t.ID, roles, existing[t.ID]); err != nil {
+			return nil, err
+		}
+		result.Derived++
+		result.TotalEffort += effort
+	}
+	return result, nil
+}
+
+// syncEstimates makes the role estimates of a task equal to the hours per role its size maps to
+func (s *SizingService) syncEstimates(ctx context.Context, taskID uint, roles map[uint]float64, existing map[uint]*entities.TaskRoleEstimate) error {
+	for roleID, hours := range roles {
+		if e, ok := existing[roleID]; ok {
+			if e.Effort != hours {
+				e.Effort = hours
+				if _, err := s.estimateRepo.Update(ctx, e); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if _, err := s.estimateRepo.Create(ctx, &entities.TaskRoleEstimate{TaskID: taskID, ProjectRoleID: roleID, Effort: hours}); err != nil {
+			return err
+		}
+	}
+	for roleID, e := range existing {
+		if _, ok := roles[roleID]; !ok {
+			if err := s.estimateRepo.Delete(ctx, e.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keepsEstimate returns true if the effort of a sized task does not follow its size
+func keepsEstimate(t *entities.Task) bool {
+	return t.EffortOverride || t.HasThreePointEstimate()
+}
+
+// sizingTable holds a project's hours per role for each size unit
+type sizingTable map[entities.SizeUnit]map[uint]float64
+
+// sizingTable loads the sizing table of a project
+func (s *SizingService) sizingTable(ctx context.Context, projectID uint) (sizingTable, error) {
+	mappings, _, err := s.repo.GetMany(ctx, &entities.SizeMappingQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	table := make(sizingTable)
+	for _, m := range mappings {
+		if table[m.Unit] == nil {
+			table[m.Unit] = make(map[uint]float64)
+		}
+		table[m.Unit][m.ProjectRoleID] = m.Hours
+	}
+	return table, nil
+}
+
+// effort returns the hours per role the size of a task maps to and their total.
+// Roles are nil when the task is not sized or its size has no hours in the table.
+func (t sizingTable) effort(task *entities.Task) (float64, map[uint]float64) {
+	rates := t[task.SizeUnit()]
+	if len(rates) == 0 {
+		return 0, nil
+	}
+	quantity := 1.0
+	if task.SizeUnit() == entities.SizeUnitFunctionPoint {
+		quantity = task.FunctionPoints
+	}
+	total := 0.0
+	roles := make(map[uint]float64, len(rates))
+	for roleID, hours := range rates {
+		roles[roleID] = hours * quantity
+		total += roles[roleID]
+	}
+	return total, roles
+}
+
+// uniqueIDs returns the IDs without duplicates, in their first order
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
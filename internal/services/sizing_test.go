@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizingService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	taskRepo := repositories.NewTaskRepository(db)
+	estimateRepo := repositories.NewTaskRoleEstimateRepository(db)
+	service := NewSizingService(
+		repositories.NewSizeMappingRepository(db),
+		taskRepo,
+		repositories.NewProjectRoleRepository(db),
+		estimateRepo,
+	)
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Sizing")
+	dev := &entities.ProjectRole{ProjectID: project.ID, Name: "Developer", Level: entities.RoleLevelMid, Headcount: 2}
+	qa := &entities.ProjectRole{ProjectID: project.ID, Name: "QA", Level: entities.RoleLevelMid, Headcount: 1}
+	assert.NoError(t, db.Create(dev).Error)
+	assert.NoError(t, db.Create(qa).Error)
+
+	mapping := func(roleID uint, unit entities.SizeUnit, hours float64) *entities.SizeMapping {
+		created, err := service.CreateSizeMapping(ctx, &entities.SizeMapping{ProjectID: project.ID, ProjectRoleID: roleID, Unit: unit, Hours: hours})
+		assert.NoError(t, err)
+		return created
+	}
+	mapping(dev.ID, entities.SizeUnitS, 8)
+	mapping(qa.ID, entities.SizeUnitS, 2)
+	mediumDev := mapping(dev.ID, entities.SizeUnitM, 24)
+	mapping(qa.ID, entities.SizeUnitM, 8)
+	mapping(dev.ID, entities.SizeUnitFunctionPoint, 3)
+
+	small := createTestTaskForService(t, db, project.ID, "Login", nil)
+	medium := createTestTaskForService(t, db, project.ID, "Search", nil)
+	report := createTestTaskForService(t, db, project.ID, "Report", nil)
+	huge := createTestTaskForService(t, db, project.ID, "Migration", nil)
+	createTestTaskForService(t, db, project.ID, "Docs", nil)
+
+	estimates := func(taskID uint) map[uint]float64 {
+		list, _, err := estimateRepo.GetMany(ctx, &entities.TaskRoleEstimateQueryParams{TaskID: taskID})
+		assert.NoError(t, err)
+		hours := make(map[uint]float64, len(list))
+		for _, e := range list {
+			hours[e.ProjectRoleID] = e.Effort
+		}
+		return hours
+	}
+	effort := func(taskID uint) float64 {
+		task, err := taskRepo.GetOne(ctx, taskID)
+		assert.NoError(t, err)
+		return task.EstimatedEffort
+	}
+
+	t.Run("Role of another project", func(t *testing.T) {
+		other := createTestProjectForService(t, db, "Other")
+		_, err := service.CreateSizeMapping(ctx, &entities.SizeMapping{ProjectID: other.ID, ProjectRoleID: dev.ID, Unit: entities.SizeUnitL, Hours: 40})
+		assert.Equal(t, entities.ErrSizeMappingRoleMismatch, err)
+	})
+
+	t.Run("Size a batch of tasks", func(t *testing.T) {
+		result, err := service.SizeTasks(ctx, &entities.TaskSizing{TaskIDs: []uint{small.ID, medium.ID}, Size: entities.SizeUnitS})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 2, result.Derived)
+		assert.InDelta(t, 20.0, result.TotalEffort, 1e-9)
+		assert.InDelta(t, 10.0, effort(small.ID), 1e-9)
+		assert.Equal(t, map[uint]float64{dev.ID: 8, qa.ID: 2}, estimates(small.ID))
+
+		result, err = service.SizeTasks(ctx, &entities.TaskSizing{TaskIDs: []uint{medium.ID}, Size: entities.SizeUnitM})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Derived)
+		assert.InDelta(t, 32.0, effort(medium.ID), 1e-9)
+		assert.Equal(t, map[uint]float64{dev.ID: 24, qa.ID: 8}, estimates(medium.ID))
+	})
+
+	t.Run("Function points and unmapped sizes", func(t *testing.T) {
+		result, err := service.SizeTasks(ctx, &entities.TaskSizing{TaskIDs: []uint{report.ID}, FunctionPoints: 5})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Derived)
+		assert.InDelta(t, 15.0, effort(report.ID), 1e-9)
+		assert.Equal(t, map[uint]float64{dev.ID: 15}, estimates(report.ID))
+
+		result, err = service.SizeTasks(ctx, &entities.TaskSizing{TaskIDs: []uint{huge.ID}, Size: entities.SizeUnitXXL})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Unmapped)
+		assert.Zero(t, effort(huge.ID))
+	})
+
+	t.Run("Tasks of different projects", func(t *testing.T) {
+		other := createTestProjectForService(t, db, "Foreign")
+		foreign := createTestTaskForService(t, db, other.ID, "Foreign", nil)
+		_, err := service.SizeTasks(ctx, &entities.TaskSizing{TaskIDs: []uint{small.ID, foreign.ID}, Size: entities.SizeUnitS})
+		assert.Equal(t, entities.ErrTaskSizingProjectMismatch, err)
+
+		_, err = service.SizeTasks(ctx, &entities.TaskSizing{TaskIDs: []uint{small.ID, 9999}, Size: entities.SizeUnitS})
+		assert.Equal(t, entities.ErrRecordNotFound, err)
+	})
+
+	t.Run("Manual override survives a table change", func(t *testing.T) {
+		hours := 50.0
+		task, err := service.OverrideTaskEffort(ctx, medium.ID, &hours)
+		assert.NoError(t, err)
+		assert.True(t, task.EffortOverride)
+
+		mediumDev.Hours = 30
+		_, err = service.UpdateSizeMapping(ctx, mediumDev)
+		assert.NoError(t, err)
+		assert.InDelta(t, 50.0, effort(medium.ID), 1e-9)
+
+		task, err = service.OverrideTaskEffort(ctx, medium.ID, nil)
+		assert.NoError(t, err)
+		assert.False(t, task.EffortOverride)
+		assert.InDelta(t, 38.0, effort(medium.ID), 1e-9)
+		assert.Equal(t, map[uint]float64{dev.ID: 30, qa.ID: 8}, estimates(medium.ID))
+	})
+
+	t.Run("Deleting a row re-derives effort", func(t *testing.T) {
+		rows, _, err := service.repo.GetMany(ctx, &entities.SizeMappingQueryParams{ProjectRoleID: qa.ID, Unit: entities.SizeUnitS})
+		if !assert.NoError(t, err) || !assert.Len(t, rows, 1) {
+			return
+		}
+		assert.NoError(t, service.DeleteSizeMapping(ctx, rows[0].ID))
+		assert.InDelta(t, 8.0, effort(small.ID), 1e-9)
+		assert.Equal(t, map[uint]float64{dev.ID: 8}, estimates(small.ID))
+	})
+
+	t.Run("Sizing summary", func(t *testing.T) {
+		summary, err := service.GetSizingSummary(ctx, project.ID)
+		if !assert.NoError(t, err) || !assert.Len(t, summary.Sizes, 7) {
+			return
+		}
+		assert.Equal(t, entities.SizeUnitXS, summary.Sizes[0].Unit)
+		assert.Equal(t, 1, summary.Sizes[1].Tasks)
+		assert.Equal(t, 1, summary.Sizes[2].Tasks)
+		assert.Equal(t, 1, summary.Sizes[5].Tasks)
+		assert.Equal(t, entities.SizeUnitFunctionPoint, summary.Sizes[6].Unit)
+		assert.InDelta(t, 5.0, summary.Sizes[6].Points, 1e-9)
+		assert.Equal(t, 4, summary.SizedTasks)
+		assert.Equal(t, 1, summary.UnsizedTasks)
+		assert.InDelta(t, 8.0+38.0+15.0, summary.SizedEffort, 1e-9)
+		assert.InDelta(t, 8.0+38.0+15.0, summary.EstimatedEffort, 1e-9)
+	})
+}
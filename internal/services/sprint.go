@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// SprintRepository defines the interface for sprint data operations
+type SprintRepository interface {
+	Create(ctx context.Context, sprint *entities.Sprint) (*entities.Sprint, error)
+	GetOne(ctx context.Context, id uint) (*entities.Sprint, error)
+	GetMany(ctx context.Context, qParams *entities.SprintQueryParams) ([]*entities.Sprint, int64, error)
+	Update(ctx context.Context, sprint *entities.Sprint) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// SprintService handles the sprints, sprint planning and velocity of agile and hybrid projects
+type SprintService struct {
+	repo        SprintRepository
+	projectRepo ProjectRepository
+	taskRepo    TaskRepository
+}
+
+// NewSprintService creates a new sprint service
+func NewSprintService(repo SprintRepository, projectRepo ProjectRepository, taskRepo TaskRepository) *SprintService {
+	return &SprintService{repo: repo, projectRepo: projectRepo, taskRepo: taskRepo}
+}
+
+// CreateSprint creates a new sprint in an agile or hybrid project. Its dates are stored as
+// calendar days in the project's time zone.
+func (s *SprintService) CreateSprint(ctx context.Context, sprint *entities.Sprint) (*entities.Sprint, error) {
+	project, err := s.projectRepo.GetOne(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if !project.UsesSprints() {
+		return nil, entities.ErrSprintProjectNotAgile
+	}
+	zones := newProjectZones(s.projectRepo)
+	if err := zones.normalize(ctx, sprint.ProjectID, &sprint.StartDate, &sprint.EndDate); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, sprint)
+	if err != nil {
+		return nil, err
+	}
+	if err := zones.localize(ctx, created.ProjectID, &created.StartDate, &created.EndDate); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetSprint retrieves a single sprint by ID
+func (s *SprintService) GetSprint(ctx context.Context, id uint) (*entities.Sprint, error) {
+	sprint, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := newProjectZones(s.projectRepo).localize(ctx, sprint.ProjectID, &sprint.StartDate, &sprint.EndDate); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// GetSprints retrieves multiple sprints with optional query parameters
+func (s *SprintService) GetSprints(ctx context.Context, params *entities.SprintQueryParams) (*entities.SprintListResponse, error) {
+	zones := newProjectZones(s.projectRepo)
+	if params != nil {
+		if err := zones.normalizeFilters(ctx, params.ProjectID, &params.StartDate_Gte, &params.StartDate_Lte, &params.EndDate_Gte, &params.EndDate_Lte); err != nil {
+			return nil, err
+		}
+	}
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, sprint := range data {
+		if err := zones.localize(ctx, sprint.ProjectID, &sprint.StartDate, &sprint.EndDate); err != nil {
+			return nil, err
+		}
+	}
+	return &entities.SprintListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateSprint updates an existing sprint. Its dates are stored as calendar days in the project's time zone.
+func (s *SprintService) UpdateSprint(ctx context.Context, sprint *entities.Sprint) (int64, error) {
+	if err := newProjectZones(s.projectRepo).normalize(ctx, sprint.ProjectID, &sprint.StartDate, &sprint.EndDate); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, sprint)
+}
+
+// DeleteSprint deletes a sprint by ID and moves its tasks back to the backlog
+func (s *SprintService) DeleteSprint(ctx context.Context, id uint) error {
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{SprintID: &id})
+	if err != nil {
+		return err
+	}
+	if len(tasks) > 0 {
+		ids := make([]uint, 0, len(tasks))
+		for _, t := range tasks {
+			ids = append(ids, t.ID)
+		}
+		if _, err := s.taskRepo.UpdateSprint(ctx, ids, nil); err != nil {
+			return err
+		}
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// AssignTasksToSprint plans the given tasks in a sprint, taking them out of the backlog or
+// another sprint. The tasks must belong to the sprint's project.
+func (s *SprintService) AssignTasksToSprint(ctx context.Context, sprintID uint, taskIDs []uint) (int64, error) {
+	sprint, err := s.repo.GetOne(ctx, sprintID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkTasks(ctx, sprint.ProjectID, taskIDs); err != nil {
+		return 0, err
+	}
+	return s.taskRepo.UpdateSprint(ctx, taskIDs, &sprint.ID)
+}
+
+// MoveTasksToBacklog takes the given tasks out of their sprints
+func (s *SprintService) MoveTasksToBacklog(ctx context.Context, taskIDs []uint) (int64, error) {
+	if len(taskIDs) == 0 {
+		return 0, nil
+	}
+	return s.taskRepo.UpdateSprint(ctx, taskIDs, nil)
+}
+
+// checkTasks checks that all the given tasks exist and belong to the project
+func (s *SprintService) checkTasks(ctx context.Context, projectID uint, taskIDs []uint) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ID_In: taskIDs})
+	if err != nil {
+		return err
+	}
+	found := make(map[uint]bool, len(tasks))
+	for _, t := range tasks {
+		if t.ProjectID != projectID {
+			return entities.ErrSprintTaskMismatch
+		}
+		found[t.ID] = true
+	}
+	for _, id := range taskIDs {
+		if !found[id] {
+			return entities.ErrRecordNotFound
+		}
+	}
+	return nil
+}
+
+// GetVelocityForecast returns the story points committed and completed in each sprint of a project
+// and forecasts how many more sprints its open work packages need. Velocity is the completed points
+// of the most recent completed sprints, window of them or DefaultVelocityWindow when window is 0.
+// Only work packages count: summary tasks take their size from their subtasks.
+func (s *SprintService) GetVelocityForecast(ctx context.Context, projectID uint, window int) (*entities.VelocityForecast, error) {
+	if _, err := s.projectRepo.GetOne(ctx, projectID); err != nil {
+		return nil, err
+	}
+	if window <= 0 {
+		window = entities.DefaultVelocityWindow
+	}
+	sprints, _, err := s.repo.GetMany(ctx, &entities.SprintQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	zones := newProjectZones(s.projectRepo)
+	for _, sprint := range sprints {
+		if err := zones.localize(ctx, projectID, &sprint.StartDate, &sprint.EndDate); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(sprints, func(i, j int) bool {
+		if !sprints[i].StartDate.Equal(*sprints[j].StartDate) {
+			return sprints[i].StartDate.Before(*sprints[j].StartDate)
+		}
+		return sprints[i].ID < sprints[j].ID
+	})
+
+	hasChildren := make(map[uint]bool)
+	for _, t := range tasks {
+		if t.ParentID != nil && *t.ParentID != t.ID {
+			hasChildren[*t.ParentID] = true
+		}
+	}
+
+	forecast := &entities.VelocityForecast{ProjectID: projectID, Sprints: make([]*entities.SprintVelocity, 0, len(sprints))}
+	bySprint := make(map[uint]*entities.SprintVelocity, len(sprints))
+	for _, sprint := range sprints {
+		sv := &entities.SprintVelocity{
+			SprintID:  sprint.ID,
+			Name:      sprint.Name,
+			StartDate: sprint.StartDate,
+			EndDate:   sprint.EndDate,
+			Status:    sprint.Status,
+			Capacity:  sprint.Capacity,
+		}
+		bySprint[sprint.ID] = sv
+		forecast.Sprints = append(forecast.Sprints, sv)
+	}
+	for _, t := range tasks {
+		if hasChildren[t.ID] || t.IsCancelled() {
+			continue
+		}
+		if t.SprintID != nil && bySprint[*t.SprintID] != nil {
+			sv := bySprint[*t.SprintID]
+			sv.CommittedPoints += t.StoryPoints
+			if t.IsDone() {
+				sv.CompletedPoints += t.StoryPoints
+			}
+		}
+		if t.IsDone() {
+			continue
+		}
+		if t.StoryPoints == 0 {
+			forecast.UnpointedTasks++
+		}
+		forecast.RemainingPoints += t.StoryPoints
+	}
+
+	var completed []*entities.Sprint
+	for _, sprint := range sprints {
+		if sprint.IsCompleted() {
+			completed = append(completed, sprint)
+		}
+	}
+	if len(completed) > window {
+		completed = completed[len(completed)-window:]
+	}
+	if len(completed) == 0 {
+		return forecast, nil
+	}
+
+	forecast.SampleSize = len(completed)
+	forecast.MinVelocity = math.Inf(1)
+	for _, sprint := range completed {
+		velocity := bySprint[sprint.ID].CompletedPoints
+		forecast.AverageVelocity += velocity / float64(len(completed))
+		forecast.MinVelocity = min(forecast.MinVelocity, velocity)
+		forecast.MaxVelocity = max(forecast.MaxVelocity, velocity)
+	}
+
+	last := completed[len(completed)-1]
+	forecast.SprintLengthDays = last.LengthDays()
+	forecast.ForecastSprints, forecast.ForecastFinish = sprintsNeeded(forecast.RemainingPoints, forecast.AverageVelocity, last, forecast.SprintLengthDays)
+	forecast.BestCaseSprints, forecast.BestCaseFinish = sprintsNeeded(forecast.RemainingPoints, forecast.MaxVelocity, last, forecast.SprintLengthDays)
+	forecast.WorstCaseSprints, forecast.WorstCaseFinish = sprintsNeeded(forecast.RemainingPoints, forecast.MinVelocity, last, forecast.SprintLengthDays)
+	return forecast, nil
+}
+
+// sprintsNeeded returns the number of sprints the remaining points need at a velocity and the last day
+// of the final one, counting sprints of the given length from the end of the last completed sprint.
+// Without velocity there is no forecast.
+func sprintsNeeded(remaining, velocity float64, last *entities.Sprint, lengthDays int) (int, *time.Time) {
+	if velocity <= 0 {
+		return 0, nil
+	}
+	n := int(math.Ceil(remaining/velocity - scheduleEpsilon))
+	finish := last.EndDate.AddDate(0, 0, n*lengthDays)
+	return n, &finish
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSprintService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewSprintService(
+		repositories.NewSprintRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+	)
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Agile")
+	assert.NoError(t, db.Model(project).Update("methodology", entities.ProjectMethodologyAgile).Error)
+
+	day := func(month time.Month, d int) *time.Time {
+		date := time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	sprint := func(name string, start, end *time.Time, status uint) *entities.Sprint {
+		created, err := service.CreateSprint(ctx, &entities.Sprint{Name: name, ProjectID: project.ID, StartDate: start, EndDate: end, Capacity: 10, Status: status})
+		assert.NoError(t, err)
+		return created
+	}
+	story := func(name string, points float64, status uint, parentID *uint) *entities.Task {
+		task := createTestTaskForService(t, db, project.ID, name, parentID)
+		assert.NoError(t, db.Model(task).Updates(map[string]interface{}{"story_points": points, "status": status}).Error)
+		return task
+	}
+
+	s1 := sprint("Sprint 1", day(time.January, 5), day(time.January, 18), entities.SprintStatusCompleted)
+	s2 := sprint("Sprint 2", day(time.January, 19), day(time.February, 1), entities.SprintStatusCompleted)
+	s3 := sprint("Sprint 3", day(time.February, 2), day(time.February, 15), entities.SprintStatusActive)
+
+	a := story("Login", 5, entities.TaskWorkStatusDone, nil)
+	b := story("Logout", 3, entities.TaskWorkStatusDone, nil)
+	c := story("Password reset", 2, entities.TaskWorkStatusInProgress, nil)
+	d := story("Profile", 8, entities.TaskWorkStatusDone, nil)
+	e := story("Avatar", 4, entities.TaskWorkStatusDone, nil)
+	f := story("Search", 5, entities.TaskWorkStatusInProgress, nil)
+	epic := story("Reporting", 20, entities.TaskWorkStatusToDo, nil)
+	story("Export", 13, entities.TaskWorkStatusToDo, &epic.ID)
+	story("Charts", 0, entities.TaskWorkStatusToDo, &epic.ID)
+	dropped := story("Dark mode", 8, entities.TaskWorkStatusCancelled, nil)
+
+	for sprintID, tasks := range map[uint][]uint{s1.ID: {a.ID, b.ID, c.ID}, s2.ID: {d.ID, e.ID, dropped.ID}, s3.ID: {f.ID}} {
+		_, err := service.AssignTasksToSprint(ctx, sprintID, tasks)
+		assert.NoError(t, err)
+	}
+
+	t.Run("Sprints require an agile or hybrid project", func(t *testing.T) {
+		waterfall := createTestProjectForService(t, db, "Waterfall")
+		_, err := service.CreateSprint(ctx, &entities.Sprint{Name: "Sprint 1", ProjectID: waterfall.ID, StartDate: day(time.January, 5), EndDate: day(time.January, 18)})
+		assert.Equal(t, entities.ErrSprintProjectNotAgile, err)
+	})
+
+	t.Run("Tasks of another project", func(t *testing.T) {
+		other := createTestProjectForService(t, db, "Other")
+		foreign := createTestTaskForService(t, db, other.ID, "Foreign", nil)
+		_, err := service.AssignTasksToSprint(ctx, s3.ID, []uint{f.ID, foreign.ID})
+		assert.Equal(t, entities.ErrSprintTaskMismatch, err)
+
+		_, err = service.AssignTasksToSprint(ctx, s3.ID, []uint{f.ID, 9999})
+		assert.Equal(t, entities.ErrRecordNotFound, err)
+	})
+
+	t.Run("Velocity and forecast", func(t *testing.T) {
+		forecast, err := service.GetVelocityForecast(ctx, project.ID, 0)
+		if !assert.NoError(t, err) || !assert.Len(t, forecast.Sprints, 3) {
+			return
+		}
+
+		assert.Equal(t, s1.ID, forecast.Sprints[0].SprintID)
+		assert.Equal(t, 10.0, forecast.Sprints[0].CommittedPoints)
+		assert.Equal(t, 8.0, forecast.Sprints[0].CompletedPoints)
+		assert.Equal(t, 12.0, forecast.Sprints[1].CommittedPoints, "cancelled tasks are not committed")
+		assert.Equal(t, 12.0, forecast.Sprints[1].CompletedPoints)
+		assert.Equal(t, 5.0, forecast.Sprints[2].CommittedPoints)
+		assert.Equal(t, 0.0, forecast.Sprints[2].CompletedPoints)
+
+		assert.Equal(t, 2, forecast.SampleSize)
+		assert.Equal(t, 10.0, forecast.AverageVelocity)
+		assert.Equal(t, 8.0, forecast.MinVelocity)
+		assert.Equal(t, 12.0, forecast.MaxVelocity)
+		assert.Equal(t, 20.0, forecast.RemainingPoints, "summary tasks take their size from their subtasks")
+		assert.Equal(t, 1, forecast.UnpointedTasks)
+		assert.Equal(t, 14, forecast.SprintLengthDays)
+
+		assert.Equal(t, 2, forecast.ForecastSprints)
+		assert.Equal(t, 2, forecast.BestCaseSprints)
+		assert.Equal(t, 3, forecast.WorstCaseSprints)
+		assert.Equal(t, *day(time.March, 1), *forecast.ForecastFinish)
+		assert.Equal(t, *day(time.March, 1), *forecast.BestCaseFinish)
+		assert.Equal(t, *day(time.March, 15), *forecast.WorstCaseFinish)
+	})
+
+	t.Run("Velocity window", func(t *testing.T) {
+		forecast, err := service.GetVelocityForecast(ctx, project.ID, 1)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, forecast.SampleSize)
+		assert.Equal(t, 12.0, forecast.AverageVelocity)
+		assert.Equal(t, 2, forecast.ForecastSprints)
+	})
+
+	t.Run("No completed sprint", func(t *testing.T) {
+		fresh := createTestProjectForService(t, db, "Fresh")
+		assert.NoError(t, db.Model(fresh).Update("methodology", entities.ProjectMethodologyHybrid).Error)
+		forecast, err := service.GetVelocityForecast(ctx, fresh.ID, 0)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 0, forecast.SampleSize)
+		assert.Equal(t, 0, forecast.ForecastSprints)
+		assert.Nil(t, forecast.ForecastFinish)
+	})
+
+	t.Run("Deleting a sprint returns its tasks to the backlog", func(t *testing.T) {
+		assert.NoError(t, service.DeleteSprint(ctx, s3.ID))
+		var task entities.Task
+		assert.NoError(t, db.First(&task, f.ID).Error)
+		assert.Nil(t, task.SprintID)
+
+		moved, err := service.MoveTasksToBacklog(ctx, []uint{a.ID, b.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), moved)
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/ducminhgd/plan-craft/internal/entities"
 )
@@ -13,43 +14,212 @@ type TaskRepository interface {
 	GetMany(ctx context.Context, qParams *entities.TaskQueryParams) ([]*entities.Task, int64, error)
 	Update(ctx context.Context, task *entities.Task) (int64, error)
 	Delete(ctx context.Context, id uint) error
+	UpdateLevelingDelays(ctx context.Context, delays map[uint]float64) error
+	UpdateLevels(ctx context.Context, levels map[uint]int) error
+	UpdateSprint(ctx context.Context, ids []uint, sprintID *uint) (int64, error)
+	UpdateBaselines(ctx context.Context, baselines map[uint]*entities.TaskBaseline) error
 }
 
 // TaskService handles task business logic
 type TaskService struct {
-	repo TaskRepository
+	repo           TaskRepository
+	projectRepo    ProjectRepository
+	dependencyRepo TaskDependencyRepository
 }
 
 // NewTaskService creates a new task service
-func NewTaskService(repo TaskRepository) *TaskService {
-	return &TaskService{repo: repo}
+func NewTaskService(repo TaskRepository, projectRepo ProjectRepository, dependencyRepo TaskDependencyRepository) *TaskService {
+	return &TaskService{repo: repo, projectRepo: projectRepo, dependencyRepo: dependencyRepo}
 }
 
-// CreateTask creates a new task
+// taskDates returns the dates of a task that are calendar days in the project's time zone
+func taskDates(task *entities.Task) []**time.Time {
+	return []**time.Time{&task.PlannedStart, &task.PlannedFinish, &task.ConstraintDate}
+}
+
+// CreateTask creates a new task. Its level follows from its parent and its dates are
+// stored as calendar days in the project's time zone.
 func (s *TaskService) CreateTask(ctx context.Context, task *entities.Task) (*entities.Task, error) {
-	return s.repo.Create(ctx, task)
+	if err := s.placeInHierarchy(ctx, task); err != nil {
+		return nil, err
+	}
+	zones := newProjectZones(s.projectRepo)
+	if err := zones.normalize(ctx, task.ProjectID, taskDates(task)...); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if err := zones.localize(ctx, created.ProjectID, taskDates(created)...); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // GetTask retrieves a single task by ID
 func (s *TaskService) GetTask(ctx context.Context, id uint) (*entities.Task, error) {
-	return s.repo.GetOne(ctx, id)
+	task, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := newProjectZones(s.projectRepo).localize(ctx, task.ProjectID, taskDates(task)...); err != nil {
+		return nil, err
+	}
+	return task, nil
 }
 
 // GetTasks retrieves multiple tasks with optional query parameters
 func (s *TaskService) GetTasks(ctx context.Context, params *entities.TaskQueryParams) (*entities.TaskListResponse, error) {
+	zones := newProjectZones(s.projectRepo)
+	if params != nil {
+		if err := zones.normalizeFilters(ctx, params.ProjectID, &params.PlannedStart_Gte, &params.PlannedStart_Lte, &params.PlannedFinish_Gte, &params.PlannedFinish_Lte, &params.ConstraintDate_Gte, &params.ConstraintDate_Lte); err != nil {
+			return nil, err
+		}
+	}
 	data, total, err := s.repo.GetMany(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	for _, task := range data {
+		if err := zones.localize(ctx, task.ProjectID, taskDates(task)...); err != nil {
+			return nil, err
+		}
+	}
 	return &entities.TaskListResponse{
 		Data:  data,
 		Total: total,
 	}, nil
 }
 
-// UpdateTask updates an existing task
+// UpdateTask updates an existing task. Its level follows from its parent and the levels of
+// its subtasks follow it when it moves. Its dates are stored as calendar days in the project's time zone.
 func (s *TaskService) UpdateTask(ctx context.Context, task *entities.Task) (int64, error) {
-	return s.repo.Update(ctx, task)
+	if err := s.placeInHierarchy(ctx, task); err != nil {
+		return 0, err
+	}
+	if err := newProjectZones(s.projectRepo).normalize(ctx, task.ProjectID, taskDates(task)...); err != nil {
+		return 0, err
+	}
+	rows, err := s.repo.Update(ctx, task)
+	if err != nil {
+		return rows, err
+	}
+	if err := s.relevelSubtasks(ctx, task); err != nil {
+		return rows, err
+	}
+	return rows, nil
+}
+
+// placeInHierarchy sets the level of a task to one below its parent, or 1 without a parent.
+// The parent must belong to the same project and must not be the task or one of its subtasks,
+// and wrapping the task in its parent must not close a cycle with the task dependencies.
+func (s *TaskService) placeInHierarchy(ctx context.Context, task *entities.Task) error {
+	if task.ParentID == nil {
+		task.Level = 1
+		return nil
+	}
+	if task.ID != 0 && *task.ParentID == task.ID {
+		return entities.ErrTaskCircularDependency
+	}
+
+	parent, err := s.repo.GetOne(ctx, *task.ParentID)
+	if err != nil {
+		return err
+	}
+	if parent.ProjectID != task.ProjectID {
+		return entities.ErrTaskParentProjectMismatch
+	}
+
+	if task.ID != 0 {
+		seen := map[uint]bool{parent.ID: true}
+		for ancestor := parent; ancestor.ParentID != nil && !seen[*ancestor.ParentID]; {
+			if *ancestor.ParentID == task.ID {
+				return entities.ErrTaskParentCycle
+			}
+			seen[*ancestor.ParentID] = true
+			if ancestor, err = s.repo.GetOne(ctx, *ancestor.ParentID); err != nil {
+				return err
+			}
+		}
+		if err := s.checkParentDependencies(ctx, task); err != nil {
+			return err
+		}
+	}
+
+	task.Level = parent.Level + 1
+	return nil
+}
+
+// checkParentDependencies checks that the summary edges of the task's new parent do not close
+// a cycle with the task dependencies, which would leave the plan impossible to schedule.
+// Cycles may span projects, so the graph is followed wherever the task and its parent lead.
+func (s *TaskService) checkParentDependencies(ctx context.Context, task *entities.Task) error {
+	tasks, dependencies, err := loadReachableTasks(ctx, s.repo, s.dependencyRepo, task.ID, *task.ParentID)
+	if err != nil {
+		return err
+	}
+
+	others := make([]*entities.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if t.ID != task.ID {
+			others = append(others, t)
+		}
+	}
+	graph := newTaskEventGraph(others)
+	start, finish := taskEvent{task.ID, false}, taskEvent{task.ID, true}
+	graph.addEdge(start, finish)
+	for _, d := range dependencies {
+		graph.addDependency(d)
+	}
+
+	parentStart, parentFinish := taskEvent{*task.ParentID, false}, taskEvent{*task.ParentID, true}
+	if graph.reaches(start, parentStart) {
+		return entities.ErrTaskParentDependencyCycle
+	}
+	graph.addEdge(parentStart, start)
+	if graph.reaches(parentFinish, finish) {
+		return entities.ErrTaskParentDependencyCycle
+	}
+	return nil
+}
+
+// relevelSubtasks brings the levels of all subtasks of a task in line with its own level
+func (s *TaskService) relevelSubtasks(ctx context.Context, task *entities.Task) error {
+	tasks, _, err := s.repo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: task.ProjectID})
+	if err != nil {
+		return err
+	}
+	children := make(map[uint][]*entities.Task)
+	for _, t := range tasks {
+		if t.ParentID != nil {
+			children[*t.ParentID] = append(children[*t.ParentID], t)
+		}
+	}
+
+	levels := make(map[uint]int)
+	seen := map[uint]bool{task.ID: true}
+	queue := []*entities.Task{task}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for _, child := range children[parent.ID] {
+			if seen[child.ID] {
+				continue
+			}
+			seen[child.ID] = true
+			if child.Level != parent.Level+1 {
+				child.Level = parent.Level + 1
+				levels[child.ID] = child.Level
+			}
+			queue = append(queue, child)
+		}
+	}
+
+	if len(levels) == 0 {
+		return nil
+	}
+	return s.repo.UpdateLevels(ctx, levels)
 }
 
 // DeleteTask deletes a task by ID
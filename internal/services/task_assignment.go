@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// TaskAssignmentRepository defines the interface for task assignment data operations
+type TaskAssignmentRepository interface {
+	Create(ctx context.Context, assignment *entities.TaskAssignment) (*entities.TaskAssignment, error)
+	GetOne(ctx context.Context, id uint) (*entities.TaskAssignment, error)
+	GetMany(ctx context.Context, qParams *entities.TaskAssignmentQueryParams) ([]*entities.TaskAssignment, int64, error)
+	Update(ctx context.Context, assignment *entities.TaskAssignment) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// TaskAssignmentService handles task assignment business logic
+type TaskAssignmentService struct {
+	repo                TaskAssignmentRepository
+	taskRepo            TaskRepository
+	projectResourceRepo ProjectResourceRepository
+}
+
+// NewTaskAssignmentService creates a new task assignment service
+func NewTaskAssignmentService(repo TaskAssignmentRepository, taskRepo TaskRepository, projectResourceRepo ProjectResourceRepository) *TaskAssignmentService {
+	return &TaskAssignmentService{
+		repo:                repo,
+		taskRepo:            taskRepo,
+		projectResourceRepo: projectResourceRepo,
+	}
+}
+
+// CreateTaskAssignment creates a new task assignment
+func (s *TaskAssignmentService) CreateTaskAssignment(ctx context.Context, assignment *entities.TaskAssignment) (*entities.TaskAssignment, error) {
+	if err := s.resolveResource(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, assignment)
+}
+
+// GetTaskAssignment retrieves a single task assignment by ID
+func (s *TaskAssignmentService) GetTaskAssignment(ctx context.Context, id uint) (*entities.TaskAssignment, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetTaskAssignments retrieves multiple task assignments with optional query parameters
+func (s *TaskAssignmentService) GetTaskAssignments(ctx context.Context, params *entities.TaskAssignmentQueryParams) (*entities.TaskAssignmentListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.TaskAssignmentListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// GetTaskAssignmentsByTask retrieves all assignments of a task
+func (s *TaskAssignmentService) GetTaskAssignmentsByTask(ctx context.Context, taskID uint) (*entities.TaskAssignmentListResponse, error) {
+	return s.GetTaskAssignments(ctx, &entities.TaskAssignmentQueryParams{TaskID: taskID})
+}
+
+// UpdateTaskAssignment updates an existing task assignment
+func (s *TaskAssignmentService) UpdateTaskAssignment(ctx context.Context, assignment *entities.TaskAssignment) (int64, error) {
+	if err := s.resolveResource(ctx, assignment); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, assignment)
+}
+
+// DeleteTaskAssignment deletes a task assignment by ID
+func (s *TaskAssignmentService) DeleteTaskAssignment(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// GetTaskBookings returns the units each assigned human resource spends on the tasks of the given projects
+func (s *TaskAssignmentService) GetTaskBookings(ctx context.Context, projectIDs []uint) ([]*entities.TaskBooking, error) {
+	if len(projectIDs) == 0 {
+		return []*entities.TaskBooking{}, nil
+	}
+	assignments, _, err := s.repo.GetMany(ctx, &entities.TaskAssignmentQueryParams{ProjectID_In: projectIDs})
+	if err != nil {
+		return nil, err
+	}
+	bookings := make([]*entities.TaskBooking, 0, len(assignments))
+	for _, a := range assignments {
+		bookings = append(bookings, &entities.TaskBooking{TaskID: a.TaskID, HumanResourceID: a.HumanResourceID, Units: a.Units})
+	}
+	return bookings, nil
+}
+
+// resolveResource links the assignment to the active allocation of its human resource to the
+// task's project. The assignment may name either the project resource or the human resource.
+func (s *TaskAssignmentService) resolveResource(ctx context.Context, assignment *entities.TaskAssignment) error {
+	if assignment.TaskID == 0 {
+		return entities.ErrTaskAssignmentInvalidTaskID
+	}
+	task, err := s.taskRepo.GetOne(ctx, assignment.TaskID)
+	if err != nil {
+		return err
+	}
+
+	var pr *entities.ProjectResource
+	switch {
+	case assignment.ProjectResourceID != 0:
+		pr, err = s.projectResourceRepo.GetOne(ctx, assignment.ProjectResourceID)
+		if err != nil {
+			return err
+		}
+		if assignment.HumanResourceID != 0 && assignment.HumanResourceID != pr.HumanResourceID {
+			return entities.ErrTaskAssignmentResourceMismatch
+		}
+	case assignment.HumanResourceID != 0:
+		pr, err = s.projectResourceRepo.GetByProjectAndResource(ctx, task.ProjectID, assignment.HumanResourceID)
+		if errors.Is(err, entities.ErrRecordNotFound) {
+			return entities.ErrTaskAssignmentResourceNotAllocated
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return entities.ErrTaskAssignmentInvalidHumanResourceID
+	}
+
+	if pr.ProjectID != task.ProjectID || !pr.IsActive() {
+		return entities.ErrTaskAssignmentResourceNotAllocated
+	}
+	assignment.ProjectResourceID = pr.ID
+	assignment.HumanResourceID = pr.HumanResourceID
+	return nil
+}
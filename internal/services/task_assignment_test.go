@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskAssignmentService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewTaskAssignmentService(
+		repositories.NewTaskAssignmentRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewProjectResourceRepository(db),
+	)
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Assignments")
+	other := createTestProjectForService(t, db, "Other")
+	task := createTestTaskForService(t, db, project.ID, "Build", nil)
+	alice := createTestHumanResourceForService(t, db, "Alice")
+	bob := createTestHumanResourceForService(t, db, "Bob")
+	carol := createTestHumanResourceForService(t, db, "Carol")
+
+	allocated := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: alice.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+	elsewhere := &entities.ProjectResource{ProjectID: other.ID, HumanResourceID: bob.ID, Allocation: 100, Status: entities.ProjectResourceStatusActive}
+	assert.NoError(t, db.Create(allocated).Error)
+	assert.NoError(t, db.Create(elsewhere).Error)
+
+	t.Run("Assign by human resource", func(t *testing.T) {
+		created, err := service.CreateTaskAssignment(ctx, &entities.TaskAssignment{TaskID: task.ID, HumanResourceID: alice.ID, PlannedHours: 16})
+		assert.NoError(t, err)
+		assert.Equal(t, allocated.ID, created.ProjectResourceID)
+		assert.Equal(t, 100.0, created.Units)
+
+		bookings, err := service.GetTaskBookings(ctx, []uint{project.ID})
+		assert.NoError(t, err)
+		if assert.Len(t, bookings, 1) {
+			assert.Equal(t, entities.TaskBooking{TaskID: task.ID, HumanResourceID: alice.ID, Units: 100}, *bookings[0])
+		}
+
+		bookings, err = service.GetTaskBookings(ctx, []uint{other.ID})
+		assert.NoError(t, err)
+		assert.Empty(t, bookings)
+	})
+
+	t.Run("Resource not allocated to the project", func(t *testing.T) {
+		_, err := service.CreateTaskAssignment(ctx, &entities.TaskAssignment{TaskID: task.ID, HumanResourceID: carol.ID})
+		assert.Equal(t, entities.ErrTaskAssignmentResourceNotAllocated, err)
+
+		_, err = service.CreateTaskAssignment(ctx, &entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: elsewhere.ID})
+		assert.Equal(t, entities.ErrTaskAssignmentResourceNotAllocated, err)
+	})
+
+	t.Run("Project resource of another human resource", func(t *testing.T) {
+		_, err := service.CreateTaskAssignment(ctx, &entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: allocated.ID, HumanResourceID: bob.ID})
+		assert.Equal(t, entities.ErrTaskAssignmentResourceMismatch, err)
+	})
+
+	t.Run("Inactive allocation", func(t *testing.T) {
+		inactive := &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: carol.ID, Allocation: 50, Status: entities.ProjectResourceStatusInactive}
+		assert.NoError(t, db.Create(inactive).Error)
+
+		_, err := service.CreateTaskAssignment(ctx, &entities.TaskAssignment{TaskID: task.ID, ProjectResourceID: inactive.ID})
+		assert.Equal(t, entities.ErrTaskAssignmentResourceNotAllocated, err)
+	})
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// TaskDependencyRepository defines the interface for task dependency data operations
+type TaskDependencyRepository interface {
+	Create(ctx context.Context, dependency *entities.TaskDependency) (*entities.TaskDependency, error)
+	GetOne(ctx context.Context, id uint) (*entities.TaskDependency, error)
+	GetMany(ctx context.Context, qParams *entities.TaskDependencyQueryParams) ([]*entities.TaskDependency, int64, error)
+	Update(ctx context.Context, dependency *entities.TaskDependency) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// TaskDependencyService handles task dependency business logic
+type TaskDependencyService struct {
+	repo     TaskDependencyRepository
+	taskRepo TaskRepository
+}
+
+// NewTaskDependencyService creates a new task dependency service
+func NewTaskDependencyService(repo TaskDependencyRepository, taskRepo TaskRepository) *TaskDependencyService {
+	return &TaskDependencyService{repo: repo, taskRepo: taskRepo}
+}
+
+// CreateTaskDependency creates a new task dependency after checking project boundaries and cycles
+func (s *TaskDependencyService) CreateTaskDependency(ctx context.Context, dependency *entities.TaskDependency) (*entities.TaskDependency, error) {
+	if dependency.Type == "" {
+		dependency.Type = entities.DependencyFinishToStart
+	}
+	if err := s.validateDependency(ctx, dependency); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, dependency)
+}
+
+// GetTaskDependency retrieves a single task dependency by ID
+func (s *TaskDependencyService) GetTaskDependency(ctx context.Context, id uint) (*entities.TaskDependency, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetTaskDependencies retrieves multiple task dependencies with optional query parameters
+func (s *TaskDependencyService) GetTaskDependencies(ctx context.Context, params *entities.TaskDependencyQueryParams) (*entities.TaskDependencyListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.TaskDependencyListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// GetTaskDependenciesByTask retrieves all dependencies where the task is either predecessor or successor
+func (s *TaskDependencyService) GetTaskDependenciesByTask(ctx context.Context, taskID uint) (*entities.TaskDependencyListResponse, error) {
+	params := &entities.TaskDependencyQueryParams{
+		TaskID: taskID,
+	}
+	return s.GetTaskDependencies(ctx, params)
+}
+
+// UpdateTaskDependency updates an existing task dependency after checking project boundaries and cycles
+func (s *TaskDependencyService) UpdateTaskDependency(ctx context.Context, dependency *entities.TaskDependency) (int64, error) {
+	if err := s.validateDependency(ctx, dependency); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, dependency)
+}
+
+// DeleteTaskDependency deletes a task dependency by ID
+func (s *TaskDependencyService) DeleteTaskDependency(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// validateDependency runs the entity validation and the checks that need the rest of the graph
+func (s *TaskDependencyService) validateDependency(ctx context.Context, dependency *entities.TaskDependency) error {
+	if err := dependency.Validate(); err != nil {
+		return err
+	}
+
+	predecessor, err := s.taskRepo.GetOne(ctx, dependency.PredecessorID)
+	if err != nil {
+		return err
+	}
+	successor, err := s.taskRepo.GetOne(ctx, dependency.SuccessorID)
+	if err != nil {
+		return err
+	}
+	if predecessor.ProjectID != successor.ProjectID && !dependency.AllowCrossProject {
+		return entities.ErrTaskDependencyCrossProject
+	}
+
+	// Cycles may span projects, so the graph is followed wherever the successor leads
+	tasks, dependencies, err := loadReachableTasks(ctx, s.taskRepo, s.repo, dependency.SuccessorID)
+	if err != nil {
+		return err
+	}
+
+	graph := newTaskEventGraph(tasks)
+	for _, existing := range dependencies {
+		if existing.ID != 0 && existing.ID == dependency.ID {
+			continue // Being replaced by the updated version
+		}
+		graph.addDependency(existing)
+	}
+	if graph.wouldCycle(dependency) {
+		return entities.ErrTaskDependencyCycle
+	}
+
+	return nil
+}
+
+// loadReachableTasks loads the tasks whose events can be reached from the events of the given
+// tasks, with the dependencies between them, so that cycle checks read only the part of the plan
+// they may walk. An event leads only to events of the same task, its parent, its subtasks and its
+// successors, so those are followed one step at a time.
+func loadReachableTasks(ctx context.Context, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, taskIDs ...uint) ([]*entities.Task, []*entities.TaskDependency, error) {
+	var (
+		tasks        []*entities.Task
+		dependencies []*entities.TaskDependency
+	)
+	seen := make(map[uint]bool)
+	frontier := make([]uint, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		if !seen[id] {
+			seen[id] = true
+			frontier = append(frontier, id)
+		}
+	}
+	for len(frontier) > 0 {
+		found, _, err := taskRepo.GetMany(ctx, &entities.TaskQueryParams{ID_In: frontier})
+		if err != nil {
+			return nil, nil, err
+		}
+		children, _, err := taskRepo.GetMany(ctx, &entities.TaskQueryParams{ParentID_In: frontier})
+		if err != nil {
+			return nil, nil, err
+		}
+		outgoing, _, err := dependencyRepo.GetMany(ctx, &entities.TaskDependencyQueryParams{PredecessorID_In: frontier})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, found...)
+		dependencies = append(dependencies, outgoing...)
+
+		var next []uint
+		visit := func(id uint) {
+			if !seen[id] {
+				seen[id] = true
+				next = append(next, id)
+			}
+		}
+		for _, t := range found {
+			if t.ParentID != nil {
+				visit(*t.ParentID)
+			}
+		}
+		for _, t := range children {
+			visit(t.ID)
+		}
+		for _, d := range outgoing {
+			visit(d.SuccessorID)
+		}
+		frontier = next
+	}
+	return tasks, dependencies, nil
+}
+
+// taskEvent identifies the start or finish event of a task
+type taskEvent struct {
+	taskID uint
+	finish bool
+}
+
+// taskEventGraph is a precedence graph over task start/finish events.
+// Every task contributes start -> finish, every summary task wraps its
+// children (parent start -> child start, child finish -> parent finish),
+// and every dependency links the events named by its type. A cycle in
+// this graph means the plan cannot be scheduled.
+type taskEventGraph struct {
+	edges map[taskEvent][]taskEvent
+}
+
+// newTaskEventGraph builds the graph with the implicit task and hierarchy edges
+func newTaskEventGraph(tasks []*entities.Task) *taskEventGraph {
+	g := &taskEventGraph{edges: make(map[taskEvent][]taskEvent)}
+	for _, t := range tasks {
+		g.addEdge(taskEvent{t.ID, false}, taskEvent{t.ID, true})
+		if t.ParentID != nil {
+			g.addEdge(taskEvent{*t.ParentID, false}, taskEvent{t.ID, false})
+			g.addEdge(taskEvent{t.ID, true}, taskEvent{*t.ParentID, true})
+		}
+	}
+	return g
+}
+
+func (g *taskEventGraph) addEdge(from, to taskEvent) {
+	g.edges[from] = append(g.edges[from], to)
+}
+
+// dependencyEvents returns the events linked by a dependency of the given type
+func dependencyEvents(d *entities.TaskDependency) (from, to taskEvent) {
+	switch d.Type {
+	case entities.DependencyStartToStart:
+		return taskEvent{d.PredecessorID, false}, taskEvent{d.SuccessorID, false}
+	case entities.DependencyFinishToFinish:
+		return taskEvent{d.PredecessorID, true}, taskEvent{d.SuccessorID, true}
+	case entities.DependencyStartToFinish:
+		return taskEvent{d.PredecessorID, false}, taskEvent{d.SuccessorID, true}
+	default:
+		return taskEvent{d.PredecessorID, true}, taskEvent{d.SuccessorID, false}
+	}
+}
+
+func (g *taskEventGraph) addDependency(d *entities.TaskDependency) {
+	from, to := dependencyEvents(d)
+	g.addEdge(from, to)
+}
+
+// wouldCycle reports whether adding the dependency closes a cycle,
+// i.e. whether its target event already reaches its source event
+func (g *taskEventGraph) wouldCycle(d *entities.TaskDependency) bool {
+	from, to := dependencyEvents(d)
+	return g.reaches(to, from)
+}
+
+// reaches reports whether a path of edges leads from one event to another
+func (g *taskEventGraph) reaches(from, to taskEvent) bool {
+	visited := make(map[taskEvent]bool)
+	stack := []taskEvent{from}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == to {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		stack = append(stack, g.edges[current]...)
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+// trackedTaskReads is a task repository that remembers the tasks it read and fails the test
+// on a read of every task
+type trackedTaskReads struct {
+	TaskRepository
+	t    *testing.T
+	read map[uint]bool
+}
+
+func (r trackedTaskReads) GetMany(ctx context.Context, qParams *entities.TaskQueryParams) ([]*entities.Task, int64, error) {
+	if qParams == nil || (len(qParams.ID_In) == 0 && len(qParams.ParentID_In) == 0 && qParams.ProjectID == 0) {
+		r.t.Errorf("unbounded task read: %+v", qParams)
+	}
+	tasks, total, err := r.TaskRepository.GetMany(ctx, qParams)
+	for _, task := range tasks {
+		r.read[task.ID] = true
+	}
+	return tasks, total, err
+}
+
+func TestTaskDependencyService_CreateTaskDependency(t *testing.T) {
+	db := setupServiceTestDB(t)
+	taskReads := trackedTaskReads{TaskRepository: repositories.NewTaskRepository(db), t: t, read: make(map[uint]bool)}
+	service := NewTaskDependencyService(repositories.NewTaskDependencyRepository(db), taskReads)
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Alpha")
+	other := createTestProjectForService(t, db, "Beta")
+	a := createTestTaskForService(t, db, project.ID, "A", nil)
+	b := createTestTaskForService(t, db, project.ID, "B", nil)
+	c := createTestTaskForService(t, db, project.ID, "C", nil)
+	summary := createTestTaskForService(t, db, project.ID, "Summary", nil)
+	child := createTestTaskForService(t, db, project.ID, "Child", &summary.ID)
+	foreign := createTestTaskForService(t, db, other.ID, "Foreign", nil)
+
+	t.Run("Chain is accepted", func(t *testing.T) {
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: a.ID, SuccessorID: b.ID})
+		assert.NoError(t, err)
+		_, err = service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: b.ID, SuccessorID: c.ID, LagHours: 8})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Closing the loop is rejected", func(t *testing.T) {
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: c.ID, SuccessorID: a.ID})
+		assert.Equal(t, entities.ErrTaskDependencyCycle, err)
+	})
+
+	t.Run("Start to start back edge is rejected", func(t *testing.T) {
+		// b.start >= c.start >= b.finish cannot hold
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: c.ID, SuccessorID: b.ID, Type: entities.DependencyStartToStart})
+		assert.Equal(t, entities.ErrTaskDependencyCycle, err)
+	})
+
+	t.Run("Link to a parallel branch is accepted", func(t *testing.T) {
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: a.ID, SuccessorID: summary.ID, Type: entities.DependencyStartToStart})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Link from a subtask to its summary is rejected", func(t *testing.T) {
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: child.ID, SuccessorID: summary.ID})
+		assert.Equal(t, entities.ErrTaskDependencyCycle, err)
+	})
+
+	t.Run("Cross project link requires opt in", func(t *testing.T) {
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: c.ID, SuccessorID: foreign.ID})
+		assert.Equal(t, entities.ErrTaskDependencyCrossProject, err)
+
+		_, err = service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: c.ID, SuccessorID: foreign.ID, AllowCrossProject: true})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Cycle through another project is rejected", func(t *testing.T) {
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: foreign.ID, SuccessorID: a.ID, AllowCrossProject: true})
+		assert.Equal(t, entities.ErrTaskDependencyCycle, err)
+	})
+
+	t.Run("Only tasks reachable from the successor are read", func(t *testing.T) {
+		before := createTestTaskForService(t, db, project.ID, "Before", nil)
+		unrelated := createTestTaskForService(t, db, project.ID, "Unrelated", nil)
+		clear(taskReads.read)
+
+		_, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: before.ID, SuccessorID: c.ID})
+		assert.NoError(t, err)
+		assert.True(t, taskReads.read[foreign.ID], "c leads to the foreign task")
+		assert.False(t, taskReads.read[a.ID])
+		assert.False(t, taskReads.read[unrelated.ID])
+	})
+}
+
+func TestTaskDependencyService_UpdateTaskDependency(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewTaskDependencyService(repositories.NewTaskDependencyRepository(db), repositories.NewTaskRepository(db))
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Alpha")
+	a := createTestTaskForService(t, db, project.ID, "A", nil)
+	b := createTestTaskForService(t, db, project.ID, "B", nil)
+
+	dependency, err := service.CreateTaskDependency(ctx, &entities.TaskDependency{PredecessorID: a.ID, SuccessorID: b.ID})
+	assert.NoError(t, err)
+
+	// Reversing the only edge is not a cycle because the old edge is replaced
+	dependency.PredecessorID, dependency.SuccessorID = b.ID, a.ID
+	rows, err := service.UpdateTaskDependency(ctx, dependency)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), rows)
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// TaskRoleEstimateRepository defines the interface for task role estimate data operations
+type TaskRoleEstimateRepository interface {
+	Create(ctx context.Context, estimate *entities.TaskRoleEstimate) (*entities.TaskRoleEstimate, error)
+	GetOne(ctx context.Context, id uint) (*entities.TaskRoleEstimate, error)
+	GetMany(ctx context.Context, qParams *entities.TaskRoleEstimateQueryParams) ([]*entities.TaskRoleEstimate, int64, error)
+	Update(ctx context.Context, estimate *entities.TaskRoleEstimate) (int64, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// TaskRoleEstimateService handles task estimates per project role and the role demand they add up to
+type TaskRoleEstimateService struct {
+	repo            TaskRoleEstimateRepository
+	projectRepo     ProjectRepository
+	taskRepo        TaskRepository
+	dependencyRepo  TaskDependencyRepository
+	calendarRepo    CalendarRepository
+	projectRoleRepo ProjectRoleRepository
+}
+
+// NewTaskRoleEstimateService creates a new task role estimate service
+func NewTaskRoleEstimateService(repo TaskRoleEstimateRepository, projectRepo ProjectRepository, taskRepo TaskRepository, dependencyRepo TaskDependencyRepository, calendarRepo CalendarRepository, projectRoleRepo ProjectRoleRepository) *TaskRoleEstimateService {
+	return &TaskRoleEstimateService{
+		repo:            repo,
+		projectRepo:     projectRepo,
+		taskRepo:        taskRepo,
+		dependencyRepo:  dependencyRepo,
+		calendarRepo:    calendarRepo,
+		projectRoleRepo: projectRoleRepo,
+	}
+}
+
+// CreateTaskRoleEstimate creates a new task role estimate
+func (s *TaskRoleEstimateService) CreateTaskRoleEstimate(ctx context.Context, estimate *entities.TaskRoleEstimate) (*entities.TaskRoleEstimate, error) {
+	if err := s.checkRole(ctx, estimate); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, estimate)
+}
+
+// GetTaskRoleEstimate retrieves a single task role estimate by ID
+func (s *TaskRoleEstimateService) GetTaskRoleEstimate(ctx context.Context, id uint) (*entities.TaskRoleEstimate, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetTaskRoleEstimates retrieves multiple task role estimates with optional query parameters
+func (s *TaskRoleEstimateService) GetTaskRoleEstimates(ctx context.Context, params *entities.TaskRoleEstimateQueryParams) (*entities.TaskRoleEstimateListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.TaskRoleEstimateListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateTaskRoleEstimate updates an existing task role estimate
+func (s *TaskRoleEstimateService) UpdateTaskRoleEstimate(ctx context.Context, estimate *entities.TaskRoleEstimate) (int64, error) {
+	if err := s.checkRole(ctx, estimate); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, estimate)
+}
+
+// DeleteTaskRoleEstimate deletes a task role estimate by ID
+func (s *TaskRoleEstimateService) DeleteTaskRoleEstimate(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// GetRoleDemand adds up the role estimates of a project's tasks per role and calendar month and
+// compares them with the roles' headcounts. Each estimate is spread over the working hours of its
+// task in the CPM schedule; a task without duration puts it all in the month it starts.
+// Every role is reported over the same months, from the first to the last month with demand.
+func (s *TaskRoleEstimateService) GetRoleDemand(ctx context.Context, projectID uint) (*entities.RoleDemandReport, error) {
+	project, err := s.projectRepo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := loadProjectPlan(ctx, s.taskRepo, s.dependencyRepo, s.calendarRepo, project)
+	if err != nil {
+		return nil, err
+	}
+	schedule := plan.schedule()
+	roles, _, err := s.projectRoleRepo.GetMany(ctx, &entities.ProjectRoleQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	estimates, _, err := s.repo.GetMany(ctx, &entities.TaskRoleEstimateQueryParams{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := make(map[uint]bool)
+	for _, t := range plan.network.tasks {
+		cancelled[t.ID] = t.IsCancelled()
+	}
+
+	// Demand hours per role and month
+	loc := project.Location()
+	demand := make(map[uint]map[time.Time]float64)
+	var first, last time.Time
+	for _, e := range estimates {
+		ts := schedule.GetTask(e.TaskID)
+		if ts == nil || cancelled[e.TaskID] || e.Effort <= 0 {
+			continue
+		}
+		if demand[e.ProjectRoleID] == nil {
+			demand[e.ProjectRoleID] = make(map[time.Time]float64)
+		}
+		for month, share := range monthlyShares(plan.calendar, ts.EarlyStart, ts.EarlyFinish, loc) {
+			demand[e.ProjectRoleID][month] += e.Effort * share
+			if first.IsZero() || month.Before(first) {
+				first = month
+			}
+			if month.After(last) {
+				last = month
+			}
+		}
+	}
+
+	report := &entities.RoleDemandReport{ProjectID: projectID, Roles: make([]*entities.RoleDemand, 0, len(roles))}
+	for _, role := range roles {
+		rd := &entities.RoleDemand{
+			ProjectRoleID: role.ID,
+			Name:          role.Name,
+			Level:         role.Level,
+			LevelName:     role.GetLevelName(),
+			Headcount:     role.Headcount,
+			Months:        []*entities.RoleMonthDemand{},
+		}
+		for month := first; !first.IsZero() && !month.After(last); month = month.AddDate(0, 1, 0) {
+			hours := plan.calendar.WorkingHoursBetween(month, month.AddDate(0, 1, 0))
+			md := &entities.RoleMonthDemand{
+				Month:         month,
+				DemandHours:   demand[role.ID][month],
+				CapacityHours: hours * float64(role.Headcount),
+			}
+			if hours > 0 {
+				md.RequiredHeadcount = md.DemandHours / hours
+			}
+			md.Gap = float64(role.Headcount) - md.RequiredHeadcount
+			md.IsShortfall = md.Gap < -scheduleEpsilon
+			rd.TotalHours += md.DemandHours
+			rd.PeakRequired = max(rd.PeakRequired, md.RequiredHeadcount)
+			rd.HasShortfall = rd.HasShortfall || md.IsShortfall
+			rd.Months = append(rd.Months, md)
+		}
+		report.Roles = append(report.Roles, rd)
+	}
+	return report, nil
+}
+
+// checkRole makes sure the estimated role belongs to the project of the task
+func (s *TaskRoleEstimateService) checkRole(ctx context.Context, estimate *entities.TaskRoleEstimate) error {
+	if estimate.TaskID == 0 {
+		return entities.ErrTaskRoleEstimateInvalidTaskID
+	}
+	if estimate.ProjectRoleID == 0 {
+		return entities.ErrTaskRoleEstimateInvalidProjectRoleID
+	}
+	task, err := s.taskRepo.GetOne(ctx, estimate.TaskID)
+	if err != nil {
+		return err
+	}
+	role, err := s.projectRoleRepo.GetOne(ctx, estimate.ProjectRoleID)
+	if err != nil {
+		return err
+	}
+	if role.ProjectID != task.ProjectID {
+		return entities.ErrTaskRoleEstimateRoleMismatch
+	}
+	return nil
+}
+
+// monthlyShares splits the working hours between start and finish by calendar month and returns
+// the share of each month, keyed by its first day in loc
+func monthlyShares(calendar *entities.Calendar, start, finish time.Time, loc *time.Location) map[time.Time]float64 {
+	monthOf := func(t time.Time) time.Time {
+		local := t.In(loc)
+		return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	}
+
+	total := calendar.WorkingHoursBetween(start, finish)
+	if total <= scheduleEpsilon {
+		return map[time.Time]float64{monthOf(start): 1}
+	}
+	shares := make(map[time.Time]float64)
+	for month := monthOf(start); month.Before(finish); month = month.AddDate(0, 1, 0) {
+		from, to := month, month.AddDate(0, 1, 0)
+		if start.After(from) {
+			from = start
+		}
+		if finish.Before(to) {
+			to = finish
+		}
+		if hours := calendar.WorkingHoursBetween(from, to); hours > 0 {
+			shares[month] = hours / total
+		}
+	}
+	return shares
+}
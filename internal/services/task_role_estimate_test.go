@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskRoleEstimateService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewTaskRoleEstimateService(
+		repositories.NewTaskRoleEstimateRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewTaskDependencyRepository(db),
+		repositories.NewCalendarRepository(db),
+		repositories.NewProjectRoleRepository(db),
+	)
+	ctx := context.Background()
+
+	project := createScheduledTestProject(t, db, "Staffing")
+	other := createTestProjectForService(t, db, "Other")
+	backend := &entities.ProjectRole{ProjectID: project.ID, Name: "Backend", Level: entities.RoleLevelSenior, Headcount: 1}
+	qa := &entities.ProjectRole{ProjectID: project.ID, Name: "QA", Level: entities.RoleLevelMid, Headcount: 1}
+	elsewhere := &entities.ProjectRole{ProjectID: other.ID, Name: "Backend", Level: entities.RoleLevelSenior, Headcount: 1}
+	for _, role := range []*entities.ProjectRole{backend, qa, elsewhere} {
+		assert.NoError(t, db.Create(role).Error)
+	}
+
+	// A runs on Jan 5-6; B runs 22 working days from Jan 7, 18 of them in January
+	a := createEffortTestTask(t, db, project.ID, "API", nil, 16)
+	b := createEffortTestTask(t, db, project.ID, "Migration", nil, 176)
+	createTestDependency(t, db, a.ID, b.ID, entities.DependencyFinishToStart, 0)
+
+	t.Run("Estimate per role", func(t *testing.T) {
+		for _, e := range []*entities.TaskRoleEstimate{
+			{TaskID: a.ID, ProjectRoleID: backend.ID, Effort: 40},
+			{TaskID: a.ID, ProjectRoleID: qa.ID, Effort: 16},
+			{TaskID: b.ID, ProjectRoleID: backend.ID, Effort: 352},
+		} {
+			_, err := service.CreateTaskRoleEstimate(ctx, e)
+			assert.NoError(t, err)
+		}
+
+		_, err := service.CreateTaskRoleEstimate(ctx, &entities.TaskRoleEstimate{TaskID: a.ID, ProjectRoleID: backend.ID, Effort: 8})
+		assert.Error(t, err) // One estimate per task and role
+
+		_, err = service.CreateTaskRoleEstimate(ctx, &entities.TaskRoleEstimate{TaskID: b.ID, ProjectRoleID: elsewhere.ID, Effort: 8})
+		assert.ErrorIs(t, err, entities.ErrTaskRoleEstimateRoleMismatch)
+
+		list, err := service.GetTaskRoleEstimates(ctx, &entities.TaskRoleEstimateQueryParams{ProjectID: project.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), list.Total)
+	})
+
+	t.Run("Monthly demand against headcount", func(t *testing.T) {
+		report, err := service.GetRoleDemand(ctx, project.ID)
+		if !assert.NoError(t, err) || !assert.Len(t, report.Roles, 2) {
+			return
+		}
+		january := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		february := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		rd := report.Roles[0]
+		assert.Equal(t, backend.ID, rd.ProjectRoleID)
+		assert.Equal(t, "Senior", rd.LevelName)
+		assert.InDelta(t, 392.0, rd.TotalHours, 1e-6)
+		assert.True(t, rd.HasShortfall)
+		if assert.Len(t, rd.Months, 2) {
+			// January has 22 working days of 8 hours, February 20
+			jan, feb := rd.Months[0], rd.Months[1]
+			assert.Equal(t, january, jan.Month)
+			assert.InDelta(t, 40+288.0, jan.DemandHours, 1e-6)
+			assert.Equal(t, 176.0, jan.CapacityHours)
+			assert.InDelta(t, 328.0/176, jan.RequiredHeadcount, 1e-6)
+			assert.True(t, jan.IsShortfall)
+
+			assert.Equal(t, february, feb.Month)
+			assert.InDelta(t, 64.0, feb.DemandHours, 1e-6)
+			assert.InDelta(t, 0.6, feb.Gap, 1e-6)
+			assert.False(t, feb.IsShortfall)
+		}
+
+		rd = report.Roles[1]
+		assert.Equal(t, qa.ID, rd.ProjectRoleID)
+		assert.False(t, rd.HasShortfall)
+		if assert.Len(t, rd.Months, 2) {
+			assert.InDelta(t, 16.0, rd.Months[0].DemandHours, 1e-6)
+			assert.Equal(t, 0.0, rd.Months[1].DemandHours)
+			assert.Equal(t, 1.0, rd.Months[1].Gap)
+		}
+	})
+
+	t.Run("Cancelled tasks are not demanded", func(t *testing.T) {
+		assert.NoError(t, db.Model(b).Update("status", entities.TaskWorkStatusCancelled).Error)
+		defer db.Model(b).Update("status", entities.TaskWorkStatusToDo)
+
+		report, err := service.GetRoleDemand(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.InDelta(t, 40.0, report.Roles[0].TotalHours, 1e-6)
+		assert.False(t, report.Roles[0].HasShortfall)
+	})
+}
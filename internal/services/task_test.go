@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskService_Levels(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewTaskService(repositories.NewTaskRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskDependencyRepository(db))
+	ctx := context.Background()
+	project := createTestProjectForService(t, db, "Levels")
+
+	root, err := service.CreateTask(ctx, &entities.Task{Name: "Root", ProjectID: project.ID, Level: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, root.Level)
+
+	child, err := service.CreateTask(ctx, &entities.Task{Name: "Child", ProjectID: project.ID, ParentID: &root.ID, Level: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, child.Level)
+
+	grandchild, err := service.CreateTask(ctx, &entities.Task{Name: "Grandchild", ProjectID: project.ID, ParentID: &child.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, grandchild.Level)
+
+	t.Run("Moving a task relevels its subtasks", func(t *testing.T) {
+		other, err := service.CreateTask(ctx, &entities.Task{Name: "Other", ProjectID: project.ID})
+		assert.NoError(t, err)
+		deeper, err := service.CreateTask(ctx, &entities.Task{Name: "Deeper", ProjectID: project.ID, ParentID: &other.ID})
+		assert.NoError(t, err)
+
+		child.ParentID = &deeper.ID
+		_, err = service.UpdateTask(ctx, child)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, child.Level)
+
+		got, err := service.GetTask(ctx, grandchild.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, got.Level)
+
+		child.ParentID = nil
+		_, err = service.UpdateTask(ctx, child)
+		assert.NoError(t, err)
+		got, err = service.GetTask(ctx, grandchild.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, got.Level)
+	})
+
+	t.Run("Parent must not be a subtask", func(t *testing.T) {
+		child.ParentID = &grandchild.ID
+		_, err := service.UpdateTask(ctx, child)
+		assert.Equal(t, entities.ErrTaskParentCycle, err)
+	})
+
+	t.Run("Parent must not close a cycle with dependencies", func(t *testing.T) {
+		design, err := service.CreateTask(ctx, &entities.Task{Name: "Design", ProjectID: project.ID})
+		assert.NoError(t, err)
+		build, err := service.CreateTask(ctx, &entities.Task{Name: "Build", ProjectID: project.ID})
+		assert.NoError(t, err)
+		createTestDependency(t, db, design.ID, build.ID, entities.DependencyFinishToStart, 0)
+
+		// Build cannot start before Design finishes, so Design cannot run inside Build
+		design.ParentID = &build.ID
+		_, err = service.UpdateTask(ctx, design)
+		assert.Equal(t, entities.ErrTaskParentDependencyCycle, err)
+
+		design.ParentID = nil
+		build.ParentID = &design.ID
+		_, err = service.UpdateTask(ctx, build)
+		assert.Equal(t, entities.ErrTaskParentDependencyCycle, err)
+	})
+
+	t.Run("Parent must be in the same project", func(t *testing.T) {
+		elsewhere := createTestProjectForService(t, db, "Elsewhere")
+		_, err := service.CreateTask(ctx, &entities.Task{Name: "Stray", ProjectID: elsewhere.ID, ParentID: &root.ID})
+		assert.Equal(t, entities.ErrTaskParentProjectMismatch, err)
+	})
+}
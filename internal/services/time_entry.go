@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// TimeEntryRepository defines the interface for time entry data operations
+type TimeEntryRepository interface {
+	Create(ctx context.Context, entry *entities.TimeEntry) (*entities.TimeEntry, error)
+	GetOne(ctx context.Context, id uint) (*entities.TimeEntry, error)
+	GetMany(ctx context.Context, qParams *entities.TimeEntryQueryParams) ([]*entities.TimeEntry, int64, error)
+	Update(ctx context.Context, entry *entities.TimeEntry) (int64, error)
+	Delete(ctx context.Context, id uint) error
+	SumHoursByTask(ctx context.Context, projectID uint) (map[uint]float64, error)
+}
+
+// TimeEntryService handles the hours actually worked on tasks
+type TimeEntryService struct {
+	repo        TimeEntryRepository
+	projectRepo ProjectRepository
+	taskRepo    TaskRepository
+	hrRepo      HumanResourceRepository
+}
+
+// NewTimeEntryService creates a new time entry service
+func NewTimeEntryService(repo TimeEntryRepository, projectRepo ProjectRepository, taskRepo TaskRepository, hrRepo HumanResourceRepository) *TimeEntryService {
+	return &TimeEntryService{
+		repo:        repo,
+		projectRepo: projectRepo,
+		taskRepo:    taskRepo,
+		hrRepo:      hrRepo,
+	}
+}
+
+// CreateTimeEntry creates a new time entry. Its date is stored as a calendar day in the task's project time zone.
+func (s *TimeEntryService) CreateTimeEntry(ctx context.Context, entry *entities.TimeEntry) (*entities.TimeEntry, error) {
+	zones := newTaskZones(s.projectRepo, s.taskRepo)
+	if err := zones.normalize(ctx, entry.TaskID, &entry.Date); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	if err := zones.localize(ctx, created.TaskID, &created.Date); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetTimeEntry retrieves a single time entry by ID
+func (s *TimeEntryService) GetTimeEntry(ctx context.Context, id uint) (*entities.TimeEntry, error) {
+	entry, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := newTaskZones(s.projectRepo, s.taskRepo).localize(ctx, entry.TaskID, &entry.Date); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetTimeEntries retrieves multiple time entries with optional query parameters
+func (s *TimeEntryService) GetTimeEntries(ctx context.Context, params *entities.TimeEntryQueryParams) (*entities.TimeEntryListResponse, error) {
+	zones := newTaskZones(s.projectRepo, s.taskRepo)
+	if params != nil {
+		if err := zones.normalizeFilters(ctx, params.ProjectID, params.TaskID, &params.Date_Gte, &params.Date_Lte); err != nil {
+			return nil, err
+		}
+	}
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range data {
+		if err := zones.localize(ctx, entry.TaskID, &entry.Date); err != nil {
+			return nil, err
+		}
+	}
+	return &entities.TimeEntryListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateTimeEntry updates an existing time entry. Its date is stored as a calendar day in the task's project time zone.
+func (s *TimeEntryService) UpdateTimeEntry(ctx context.Context, entry *entities.TimeEntry) (int64, error) {
+	if err := newTaskZones(s.projectRepo, s.taskRepo).normalize(ctx, entry.TaskID, &entry.Date); err != nil {
+		return 0, err
+	}
+	return s.repo.Update(ctx, entry)
+}
+
+// DeleteTimeEntry deletes a time entry by ID
+func (s *TimeEntryService) DeleteTimeEntry(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// GetTimeSummary totals the time entries of a project by week, person and task.
+// The other query parameters narrow the entries down, e.g. to a date range or billable time.
+func (s *TimeEntryService) GetTimeSummary(ctx context.Context, params *entities.TimeEntryQueryParams) (*entities.TimeSummary, error) {
+	if params == nil || params.ProjectID == 0 {
+		return nil, entities.ErrTimeEntryProjectRequired
+	}
+	project, err := s.projectRepo.GetOne(ctx, params.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	filter := *params
+	filter.QueryParams = nil
+	filter.Date_Gte, filter.Date_Lte = toProjectDate(project, filter.Date_Gte), toProjectDate(project, filter.Date_Lte)
+	entries, _, err := s.repo.GetMany(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+	tasks, _, err := s.taskRepo.GetMany(ctx, &entities.TaskQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &entities.TimeSummary{
+		ProjectID: project.ID,
+		ByWeek:    []*entities.WeekTime{},
+		ByPerson:  []*entities.PersonTime{},
+		ByTask:    []*entities.TaskTime{},
+	}
+	weeks := make(map[int64]*entities.WeekTime) // Keyed by Unix time of the week start
+	people := make(map[uint]*entities.PersonTime)
+	byTask := make(map[uint]*entities.TaskTime)
+	for _, e := range entries {
+		billable := 0.0
+		if e.Billable {
+			billable = e.Hours
+		}
+		summary.Hours += e.Hours
+		summary.BillableHours += billable
+
+		week := weekStart(project.DateOf(*e.Date))
+		wt := weeks[week.Unix()]
+		if wt == nil {
+			wt = &entities.WeekTime{WeekStart: week}
+			weeks[week.Unix()] = wt
+			summary.ByWeek = append(summary.ByWeek, wt)
+		}
+		wt.Hours += e.Hours
+		wt.BillableHours += billable
+
+		if people[e.HumanResourceID] == nil {
+			people[e.HumanResourceID] = &entities.PersonTime{HumanResourceID: e.HumanResourceID}
+			summary.ByPerson = append(summary.ByPerson, people[e.HumanResourceID])
+		}
+		people[e.HumanResourceID].Hours += e.Hours
+		people[e.HumanResourceID].BillableHours += billable
+
+		if byTask[e.TaskID] == nil {
+			byTask[e.TaskID] = &entities.TaskTime{TaskID: e.TaskID}
+			summary.ByTask = append(summary.ByTask, byTask[e.TaskID])
+		}
+		byTask[e.TaskID].Hours += e.Hours
+		byTask[e.TaskID].BillableHours += billable
+	}
+
+	if len(people) > 0 {
+		ids := make([]uint, 0, len(people))
+		for id := range people {
+			ids = append(ids, id)
+		}
+		resources, _, err := s.hrRepo.GetMany(ctx, &entities.HumanResourceQueryParams{ID_In: ids})
+		if err != nil {
+			return nil, err
+		}
+		for _, hr := range resources {
+			people[hr.ID].Name = hr.Name
+		}
+	}
+	for _, t := range tasks {
+		if tt := byTask[t.ID]; tt != nil {
+			tt.Name = t.Name
+		}
+	}
+
+	sort.Slice(summary.ByWeek, func(i, j int) bool { return summary.ByWeek[i].WeekStart.Before(summary.ByWeek[j].WeekStart) })
+	sort.Slice(summary.ByPerson, func(i, j int) bool { return summary.ByPerson[i].HumanResourceID < summary.ByPerson[j].HumanResourceID })
+	sort.Slice(summary.ByTask, func(i, j int) bool { return summary.ByTask[i].TaskID < summary.ByTask[j].TaskID })
+	return summary, nil
+}
+
+// weekStart returns the Monday of the week of a date, keeping its time zone
+func weekStart(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
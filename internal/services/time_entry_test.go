@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntryService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewTimeEntryService(
+		repositories.NewTimeEntryRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewHRRepository(db),
+	)
+	ctx := context.Background()
+
+	project := createTestProjectForService(t, db, "Timesheets")
+	assert.NoError(t, db.Model(project).Update("timezone", "Asia/Ho_Chi_Minh").Error)
+	loc, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	assert.NoError(t, err)
+	design := createTestTaskForService(t, db, project.ID, "Design", nil)
+	build := createTestTaskForService(t, db, project.ID, "Build", nil)
+	alice := createTestHumanResourceForService(t, db, "Alice")
+	bob := createTestHumanResourceForService(t, db, "Bob")
+
+	day := func(d int) *time.Time {
+		date := time.Date(2026, 1, d, 0, 0, 0, 0, loc)
+		return &date
+	}
+
+	t.Run("Create keeps the calendar day of the project", func(t *testing.T) {
+		entry, err := service.CreateTimeEntry(ctx, &entities.TimeEntry{TaskID: design.ID, HumanResourceID: alice.ID, Date: day(5), Hours: 6, Billable: true})
+		assert.NoError(t, err)
+		assert.True(t, day(5).Equal(*entry.Date))
+
+		fetched, err := service.GetTimeEntry(ctx, entry.ID)
+		assert.NoError(t, err)
+		assert.True(t, day(5).Equal(*fetched.Date))
+		assert.Equal(t, loc, fetched.Date.Location())
+
+		_, err = service.CreateTimeEntry(ctx, &entities.TimeEntry{TaskID: design.ID, HumanResourceID: alice.ID, Date: day(5)})
+		assert.Equal(t, entities.ErrTimeEntryInvalidHours, err)
+	})
+
+	t.Run("Summary by week, person and task", func(t *testing.T) {
+		for _, e := range []*entities.TimeEntry{
+			{TaskID: design.ID, HumanResourceID: bob.ID, Date: day(9), Hours: 4},
+			{TaskID: build.ID, HumanResourceID: alice.ID, Date: day(12), Hours: 8, Billable: true},
+			{TaskID: build.ID, HumanResourceID: bob.ID, Date: day(13), Hours: 2},
+		} {
+			_, err := service.CreateTimeEntry(ctx, e)
+			assert.NoError(t, err)
+		}
+
+		summary, err := service.GetTimeSummary(ctx, &entities.TimeEntryQueryParams{ProjectID: project.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, 20.0, summary.Hours)
+		assert.Equal(t, 14.0, summary.BillableHours)
+
+		if assert.Len(t, summary.ByWeek, 2) {
+			assert.True(t, day(5).Equal(summary.ByWeek[0].WeekStart))
+			assert.Equal(t, 10.0, summary.ByWeek[0].Hours)
+			assert.True(t, day(12).Equal(summary.ByWeek[1].WeekStart))
+			assert.Equal(t, 10.0, summary.ByWeek[1].Hours)
+			assert.Equal(t, 8.0, summary.ByWeek[1].BillableHours)
+		}
+		if assert.Len(t, summary.ByPerson, 2) {
+			assert.Equal(t, "Alice", summary.ByPerson[0].Name)
+			assert.Equal(t, 14.0, summary.ByPerson[0].Hours)
+			assert.Equal(t, "Bob", summary.ByPerson[1].Name)
+			assert.Equal(t, 6.0, summary.ByPerson[1].Hours)
+			assert.Equal(t, 0.0, summary.ByPerson[1].BillableHours)
+		}
+		if assert.Len(t, summary.ByTask, 2) {
+			assert.Equal(t, "Design", summary.ByTask[0].Name)
+			assert.Equal(t, 10.0, summary.ByTask[0].Hours)
+			assert.Equal(t, "Build", summary.ByTask[1].Name)
+			assert.Equal(t, 10.0, summary.ByTask[1].Hours)
+		}
+
+		// Narrowed down to one week of billable time
+		billable := true
+		summary, err = service.GetTimeSummary(ctx, &entities.TimeEntryQueryParams{ProjectID: project.ID, Billable: &billable, Date_Gte: day(12)})
+		assert.NoError(t, err)
+		assert.Equal(t, 8.0, summary.Hours)
+		assert.Len(t, summary.ByTask, 1)
+	})
+
+	t.Run("Summary requires a project", func(t *testing.T) {
+		_, err := service.GetTimeSummary(ctx, &entities.TimeEntryQueryParams{})
+		assert.Equal(t, entities.ErrTimeEntryProjectRequired, err)
+	})
+}
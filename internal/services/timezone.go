@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// Project, milestone and resource dates are calendar days in the project's time zone.
+// Clients send them as instants (e.g. local midnight serialized in UTC), so before a date
+// is stored it is moved to the start of its calendar day in the project's zone, and after
+// it is read it is shown in that zone. Day arithmetic then never drifts across the UTC
+// date line. Dates are stored in UTC, like timestamps such as CreatedAt, because SQLite
+// compares them as text: values written with different offsets would not sort in order.
+
+// toProjectDate returns the start of the calendar day of t in the project's time zone, in UTC
+func toProjectDate(project *entities.Project, t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	date := project.DateOf(*t).UTC()
+	return &date
+}
+
+// inUTC returns t expressed in UTC
+func inUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// inProjectZone returns t expressed in the project's time zone
+func inProjectZone(project *entities.Project, t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	local := t.In(project.Location())
+	return &local
+}
+
+// normalizeProjectDates moves the project's own dates to calendar days in its time zone
+func normalizeProjectDates(project *entities.Project) {
+	project.StartDate = toProjectDate(project, project.StartDate)
+	project.EndDate = toProjectDate(project, project.EndDate)
+}
+
+// localizeProjectDates expresses the project's own dates in its time zone
+func localizeProjectDates(project *entities.Project) {
+	project.StartDate = inProjectZone(project, project.StartDate)
+	project.EndDate = inProjectZone(project, project.EndDate)
+}
+
+// rebaseProjectDate returns a function that moves a date stored for the project as it was to the
+// same calendar day in the time zone of the project as it is
+func rebaseProjectDate(from, to *entities.Project) func(time.Time) time.Time {
+	return func(t time.Time) time.Time {
+		day := from.DateOf(t)
+		return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, to.Location()).UTC()
+	}
+}
+
+// rebaseDate applies rebase to t if it is set
+func rebaseDate(rebase func(time.Time) time.Time, t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	date := rebase(*t)
+	return &date
+}
+
+// projectZones resolves the projects owning dated records, loading each project once
+type projectZones struct {
+	repo     ProjectRepository
+	projects map[uint]*entities.Project
+}
+
+func newProjectZones(repo ProjectRepository) *projectZones {
+	return &projectZones{repo: repo, projects: make(map[uint]*entities.Project)}
+}
+
+// get returns the project with the given ID
+func (z *projectZones) get(ctx context.Context, projectID uint) (*entities.Project, error) {
+	if project, ok := z.projects[projectID]; ok {
+		return project, nil
+	}
+	project, err := z.repo.GetOne(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	z.projects[projectID] = project
+	return project, nil
+}
+
+// normalize moves the dates to calendar days in the time zone of the project.
+// Records without a project are left untouched for entity validation to reject.
+func (z *projectZones) normalize(ctx context.Context, projectID uint, dates ...**time.Time) error {
+	if projectID == 0 {
+		return nil
+	}
+	project, err := z.get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, d := range dates {
+		*d = toProjectDate(project, *d)
+	}
+	return nil
+}
+
+// normalizeFilters moves date filters to the form dates are stored in: calendar days in the
+// time zone of the project when the query is for one project, or the same instants in UTC.
+func (z *projectZones) normalizeFilters(ctx context.Context, projectID uint, dates ...**time.Time) error {
+	if projectID == 0 || !anyDate(dates) {
+		for _, d := range dates {
+			*d = inUTC(*d)
+		}
+		return nil
+	}
+	return z.normalize(ctx, projectID, dates...)
+}
+
+// anyDate returns true if any of the dates is set
+func anyDate(dates []**time.Time) bool {
+	for _, d := range dates {
+		if *d != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// localize expresses the dates in the time zone of the project
+func (z *projectZones) localize(ctx context.Context, projectID uint, dates ...**time.Time) error {
+	project, err := z.get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, d := range dates {
+		*d = inProjectZone(project, *d)
+	}
+	return nil
+}
+
+// taskZones resolves the project time zones of records that belong to tasks, loading each task once
+type taskZones struct {
+	zones    *projectZones
+	taskRepo TaskRepository
+	projects map[uint]uint // Project ID per task ID
+}
+
+func newTaskZones(projectRepo ProjectRepository, taskRepo TaskRepository) *taskZones {
+	return &taskZones{zones: newProjectZones(projectRepo), taskRepo: taskRepo, projects: make(map[uint]uint)}
+}
+
+// projectOf returns the project ID of a task
+func (z *taskZones) projectOf(ctx context.Context, taskID uint) (uint, error) {
+	if projectID, ok := z.projects[taskID]; ok {
+		return projectID, nil
+	}
+	task, err := z.taskRepo.GetOne(ctx, taskID)
+	if err != nil {
+		return 0, err
+	}
+	z.projects[taskID] = task.ProjectID
+	return task.ProjectID, nil
+}
+
+// normalize moves the dates to calendar days in the time zone of the task's project.
+// Records without a task are left untouched for entity validation to reject.
+func (z *taskZones) normalize(ctx context.Context, taskID uint, dates ...**time.Time) error {
+	if taskID == 0 {
+		return nil
+	}
+	projectID, err := z.projectOf(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return z.zones.normalize(ctx, projectID, dates...)
+}
+
+// normalizeFilters moves date filters to the form dates are stored in, for a query on
+// the given project or, failing that, the project of the given task
+func (z *taskZones) normalizeFilters(ctx context.Context, projectID, taskID uint, dates ...**time.Time) error {
+	if projectID == 0 && taskID != 0 && anyDate(dates) {
+		var err error
+		if projectID, err = z.projectOf(ctx, taskID); err != nil {
+			return err
+		}
+	}
+	return z.zones.normalizeFilters(ctx, projectID, dates...)
+}
+
+// localize expresses the dates in the time zone of the task's project
+func (z *taskZones) localize(ctx context.Context, taskID uint, dates ...**time.Time) error {
+	projectID, err := z.projectOf(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return z.zones.localize(ctx, projectID, dates...)
+}
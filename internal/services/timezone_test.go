@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectDatesAreCalendarDaysInProjectZone(t *testing.T) {
+	db := setupServiceTestDB(t)
+	projectRepo := repositories.NewProjectRepository(db)
+	projectService := NewProjectService(projectRepo, nil, nil)
+	milestoneService := NewMilestoneService(repositories.NewMilestoneRepository(db), projectRepo, repositories.NewTaskRepository(db), repositories.NewProjectResourceRepository(db), repositories.NewTaskAssignmentRepository(db), repositories.NewCalendarRepository(db))
+	resourceService := NewProjectResourceService(repositories.NewProjectResourceRepository(db), projectRepo)
+	taskService := NewTaskService(repositories.NewTaskRepository(db), projectRepo, repositories.NewTaskDependencyRepository(db))
+	ctx := context.Background()
+
+	loc, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	assert.NoError(t, err)
+	// A user in UTC+7 picking 2026-01-06 sends local midnight, which is 2026-01-05 in UTC
+	picked := time.Date(2026, 1, 5, 17, 0, 0, 0, time.UTC)
+	want := time.Date(2026, 1, 6, 0, 0, 0, 0, loc)
+
+	client := &entities.Client{Name: "Zone Client", Email: "zone@client.com", Status: entities.ClientStatusActive}
+	assert.NoError(t, db.Create(client).Error)
+
+	project, err := projectService.CreateProject(ctx, &entities.Project{Name: "Zoned", ClientID: client.ID, Timezone: "Asia/Ho_Chi_Minh", StartDate: &picked})
+	assert.NoError(t, err)
+
+	t.Run("Project dates", func(t *testing.T) {
+		got, err := projectService.GetProject(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.True(t, want.Equal(*got.StartDate))
+		assert.Equal(t, 6, got.StartDate.Day())
+		assert.Equal(t, loc, got.StartDate.Location())
+	})
+
+	t.Run("Milestone dates", func(t *testing.T) {
+		end := picked.Add(2 * time.Hour)
+		milestone, err := milestoneService.CreateMilestone(ctx, &entities.Milestone{Name: "M1", ProjectID: project.ID, StartDate: &picked, EndDate: &end})
+		assert.NoError(t, err)
+
+		got, err := milestoneService.GetMilestone(ctx, milestone.ID)
+		assert.NoError(t, err)
+		assert.True(t, want.Equal(*got.StartDate))
+		assert.True(t, want.Equal(*got.EndDate))
+
+		list, err := milestoneService.GetMilestones(ctx, &entities.MilestoneQueryParams{ProjectID: project.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, 6, list.Data[0].StartDate.Day())
+	})
+
+	t.Run("Resource dates", func(t *testing.T) {
+		hr := createTestHumanResourceForService(t, db, "Zone HR")
+		resource, err := resourceService.CreateProjectResource(ctx, &entities.ProjectResource{ProjectID: project.ID, HumanResourceID: hr.ID, StartDate: &picked})
+		assert.NoError(t, err)
+		assert.True(t, want.Equal(*resource.StartDate))
+	})
+
+	t.Run("Task dates", func(t *testing.T) {
+		task, err := taskService.CreateTask(ctx, &entities.Task{Name: "Planned", ProjectID: project.ID, PlannedStart: &picked, PlannedFinish: &picked})
+		assert.NoError(t, err)
+		assert.True(t, want.Equal(*task.PlannedStart))
+		assert.True(t, want.Equal(*task.PlannedFinish))
+		assert.Equal(t, uint(entities.TaskConstraintASAP), task.ConstraintType)
+
+		list, err := taskService.GetTasks(ctx, &entities.TaskQueryParams{ProjectID: project.ID, PlannedStart_Gte: &want, PlannedFinish_Lte: &want})
+		assert.NoError(t, err)
+		if assert.Len(t, list.Data, 1) {
+			assert.Equal(t, 6, list.Data[0].PlannedStart.Day())
+		}
+	})
+
+	t.Run("Schedule starts on the local day", func(t *testing.T) {
+		task := createTestTaskForService(t, db, project.ID, "Task", nil)
+		assert.NoError(t, db.Model(task).Update("estimated_effort", 8).Error)
+		scheduling := NewSchedulingService(projectRepo, repositories.NewTaskRepository(db), repositories.NewTaskDependencyRepository(db), repositories.NewCalendarRepository(db))
+
+		schedule, err := scheduling.GetProjectSchedule(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 6, 9, 0, 0, 0, loc), schedule.StartDate)
+		assert.Equal(t, time.Date(2026, 1, 6, 17, 0, 0, 0, loc), schedule.FinishDate)
+	})
+
+	t.Run("Dates are stored in UTC and filtered in order", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+		west, err := projectService.CreateProject(ctx, &entities.Project{Name: "West", ClientID: client.ID, Timezone: "America/New_York"})
+		assert.NoError(t, err)
+		// 2026-01-05 in New York starts at 05:00 UTC, 2026-01-06 in Ho Chi Minh City at 17:00 UTC the day before
+		westDay := time.Date(2026, 1, 5, 0, 0, 0, 0, newYork)
+		westMilestone, err := milestoneService.CreateMilestone(ctx, &entities.Milestone{Name: "West", ProjectID: west.ID, StartDate: &westDay})
+		assert.NoError(t, err)
+		eastMilestone, err := milestoneService.CreateMilestone(ctx, &entities.Milestone{Name: "East", ProjectID: project.ID, StartDate: &picked})
+		assert.NoError(t, err)
+
+		var stored string
+		assert.NoError(t, db.Raw("SELECT start_date || '' FROM milestones WHERE id = ?", westMilestone.ID).Row().Scan(&stored))
+		assert.Equal(t, "2026-01-05 05:00:00+00:00", stored)
+
+		from := time.Date(2026, 1, 5, 3, 0, 0, 0, time.UTC)
+		list, err := milestoneService.GetMilestones(ctx, &entities.MilestoneQueryParams{ID_In: []uint{westMilestone.ID, eastMilestone.ID}, StartDate_Gte: &from})
+		assert.NoError(t, err)
+		assert.Len(t, list.Data, 2)
+
+		// A filter for one project is a calendar day in its time zone
+		list, err = milestoneService.GetMilestones(ctx, &entities.MilestoneQueryParams{ProjectID: west.ID, StartDate_Lte: &westDay})
+		assert.NoError(t, err)
+		if assert.Len(t, list.Data, 1) {
+			assert.Equal(t, westDay, *list.Data[0].StartDate)
+		}
+	})
+
+	t.Run("Changing the time zone keeps the calendar days", func(t *testing.T) {
+		moved, err := projectService.CreateProject(ctx, &entities.Project{Name: "Moving", ClientID: client.ID, Timezone: "Asia/Ho_Chi_Minh", StartDate: &picked})
+		assert.NoError(t, err)
+		milestone, err := milestoneService.CreateMilestone(ctx, &entities.Milestone{Name: "M1", ProjectID: moved.ID, StartDate: &picked, EndDate: &picked})
+		assert.NoError(t, err)
+
+		moved.Timezone = "America/New_York"
+		_, err = projectService.UpdateProject(ctx, moved)
+		assert.NoError(t, err)
+
+		newYork, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+		wantNewYork := time.Date(2026, 1, 6, 0, 0, 0, 0, newYork)
+		got, err := projectService.GetProject(ctx, moved.ID)
+		assert.NoError(t, err)
+		assert.True(t, wantNewYork.Equal(*got.StartDate))
+		gotMilestone, err := milestoneService.GetMilestone(ctx, milestone.ID)
+		assert.NoError(t, err)
+		assert.True(t, wantNewYork.Equal(*gotMilestone.StartDate))
+		assert.True(t, wantNewYork.Equal(*gotMilestone.EndDate))
+		assert.Equal(t, 6, gotMilestone.StartDate.Day())
+	})
+
+	t.Run("Invalid time zone is rejected", func(t *testing.T) {
+		_, err := projectService.CreateProject(ctx, &entities.Project{Name: "Bad zone", ClientID: client.ID, Timezone: "Nowhere/Special"})
+		assert.Equal(t, entities.ErrProjectInvalidTimezone, err)
+	})
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+)
+
+// WBSTemplateRepository defines the interface for WBS template data operations
+type WBSTemplateRepository interface {
+	Create(ctx context.Context, template *entities.WBSTemplate) (*entities.WBSTemplate, error)
+	GetOne(ctx context.Context, id uint) (*entities.WBSTemplate, error)
+	GetMany(ctx context.Context, qParams *entities.WBSTemplateQueryParams) ([]*entities.WBSTemplate, int64, error)
+	Update(ctx context.Context, template *entities.WBSTemplate) (int64, error)
+	Delete(ctx context.Context, id uint) error
+	Instantiate(ctx context.Context, instance *entities.WBSTemplateInstance) error
+}
+
+// WBSTemplateService handles the library of reusable task hierarchies
+type WBSTemplateService struct {
+	repo            WBSTemplateRepository
+	projectRepo     ProjectRepository
+	taskRepo        TaskRepository
+	milestoneRepo   MilestoneRepository
+	projectRoleRepo ProjectRoleRepository
+}
+
+// NewWBSTemplateService creates a new WBS template service
+func NewWBSTemplateService(repo WBSTemplateRepository, projectRepo ProjectRepository, taskRepo TaskRepository, milestoneRepo MilestoneRepository, projectRoleRepo ProjectRoleRepository) *WBSTemplateService {
+	return &WBSTemplateService{
+		repo:            repo,
+		projectRepo:     projectRepo,
+		taskRepo:        taskRepo,
+		milestoneRepo:   milestoneRepo,
+		projectRoleRepo: projectRoleRepo,
+	}
+}
+
+// CreateWBSTemplate creates a new WBS template
+func (s *WBSTemplateService) CreateWBSTemplate(ctx context.Context, template *entities.WBSTemplate) (*entities.WBSTemplate, error) {
+	return s.repo.Create(ctx, template)
+}
+
+// GetWBSTemplate retrieves a single WBS template by ID
+func (s *WBSTemplateService) GetWBSTemplate(ctx context.Context, id uint) (*entities.WBSTemplate, error) {
+	return s.repo.GetOne(ctx, id)
+}
+
+// GetWBSTemplates retrieves multiple WBS templates with optional query parameters
+func (s *WBSTemplateService) GetWBSTemplates(ctx context.Context, params *entities.WBSTemplateQueryParams) (*entities.WBSTemplateListResponse, error) {
+	data, total, err := s.repo.GetMany(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.WBSTemplateListResponse{
+		Data:  data,
+		Total: total,
+	}, nil
+}
+
+// UpdateWBSTemplate updates an existing WBS template. Tasks instantiated earlier are not affected.
+func (s *WBSTemplateService) UpdateWBSTemplate(ctx context.Context, template *entities.WBSTemplate) (int64, error) {
+	return s.repo.Update(ctx, template)
+}
+
+// DeleteWBSTemplate deletes a WBS template by ID. Tasks instantiated from it are kept.
+func (s *WBSTemplateService) DeleteWBSTemplate(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// InstantiateWBSTemplate creates the task tree of a template in a project, under the chosen parent
+// task and milestone, in one transaction. Efforts are scaled by the chosen scale or total effort.
+// Milestone groups of the template are matched to the project's milestones by name and created
+// when missing. Role demand is matched to the project's roles by name and level; demand without
+// a matching role is reported and left out.
+func (s *WBSTemplateService) InstantiateWBSTemplate(ctx context.Context, options *entities.WBSTemplateInstantiation) (*entities.WBSTemplateInstance, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	template, err := s.repo.GetOne(ctx, options.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectRepo.GetOne(ctx, options.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	level := 1
+	if options.ParentID != nil {
+		parent, err := s.taskRepo.GetOne(ctx, *options.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ProjectID != project.ID {
+			return nil, entities.ErrTaskParentProjectMismatch
+		}
+		level = parent.Level + 1
+	}
+	if options.MilestoneID != nil {
+		milestone, err := s.milestoneRepo.GetOne(ctx, *options.MilestoneID)
+		if err != nil {
+			return nil, err
+		}
+		if milestone.ProjectID != project.ID {
+			return nil, entities.ErrWBSTemplateMilestoneMismatch
+		}
+	}
+	milestones, _, err := s.milestoneRepo.GetMany(ctx, &entities.MilestoneQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	roles, _, err := s.projectRoleRepo.GetMany(ctx, &entities.ProjectRoleQueryParams{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	scale := 1.0
+	if total := template.TotalEffort(); options.TotalEffort > 0 && total > 0 {
+		scale = options.TotalEffort / total
+	} else if options.Scale > 0 {
+		scale = options.Scale
+	}
+
+	b := &wbsBuilder{
+		instance: &entities.WBSTemplateInstance{
+			TemplateID:     template.ID,
+			ProjectID:      project.ID,
+			Milestones:     []*entities.Milestone{},
+			Tasks:          []*entities.Task{},
+			RoleEstimates:  []*entities.TaskRoleEstimate{},
+			UnmatchedRoles: []string{},
+		},
+		projectID:   project.ID,
+		level:       level,
+		parentID:    options.ParentID,
+		milestoneID: options.MilestoneID,
+		scale:       scale,
+		milestones:  make(map[string]*entities.Milestone),
+		roles:       roles,
+		unmatched:   make(map[string]bool),
+	}
+	for _, m := range milestones {
+		if b.milestones[m.Name] == nil {
+			b.milestones[m.Name] = m
+		}
+	}
+	b.add(template.Tasks, nil)
+
+	if err := s.repo.Instantiate(ctx, b.instance); err != nil {
+		return nil, err
+	}
+	for _, t := range b.instance.Tasks {
+		t.Parent = nil
+		t.Milestone = nil
+	}
+	for _, e := range b.instance.RoleEstimates {
+		e.Task = nil
+	}
+	return b.instance, nil
+}
+
+// wbsBuilder turns a template's task hierarchy into the rows of an instance
+type wbsBuilder struct {
+	instance    *entities.WBSTemplateInstance
+	projectID   uint
+	level       int   // Level of the top-level tasks
+	parentID    *uint // Parent of the top-level tasks
+	milestoneID *uint // Milestone of the tasks the template does not group
+	scale       float64
+	milestones  map[string]*entities.Milestone // Existing and new milestones of the project by name
+	roles       []*entities.ProjectRole
+	unmatched   map[string]bool
+}
+
+// add adds the template tasks under a new parent task, or at the top of the instance when parent is nil.
+// Tasks without a milestone group of their own inherit the milestone of their parent.
+func (b *wbsBuilder) add(nodes []*entities.WBSTemplateTask, parent *entities.Task) {
+	for _, node := range nodes {
+		task := &entities.Task{
+			Name:            node.Name,
+			Description:     node.Description,
+			ProjectID:       b.projectID,
+			Level:           b.level,
+			ParentID:        b.parentID,
+			MilestoneID:     b.milestoneID,
+			Priority:        node.Priority,
+			EstimatedEffort: node.Effort * b.scale,
+		}
+		if parent != nil {
+			task.Level = parent.Level + 1
+			task.ParentID = nil
+			task.Parent = parent
+			task.MilestoneID, task.Milestone = parent.MilestoneID, parent.Milestone
+		}
+		if node.Milestone != "" {
+			task.MilestoneID, task.Milestone = b.milestone(node.Milestone)
+		}
+		b.instance.Tasks = append(b.instance.Tasks, task)
+
+		demand := make(map[uint]*entities.TaskRoleEstimate)
+		for _, r := range node.Roles {
+			role := b.role(r)
+			if role == nil {
+				continue
+			}
+			if demand[role.ID] == nil {
+				demand[role.ID] = &entities.TaskRoleEstimate{ProjectRoleID: role.ID, Task: task}
+				b.instance.RoleEstimates = append(b.instance.RoleEstimates, demand[role.ID])
+			}
+			demand[role.ID].Effort += r.Effort * b.scale
+		}
+
+		b.add(node.Children, task)
+	}
+}
+
+// milestone returns the ID of the project's milestone with the given name, or a new milestone
+func (b *wbsBuilder) milestone(name string) (*uint, *entities.Milestone) {
+	m := b.milestones[name]
+	if m == nil {
+		m = &entities.Milestone{Name: name, ProjectID: b.projectID, Status: entities.MilestoneStatusActive}
+		b.milestones[name] = m
+		b.instance.Milestones = append(b.instance.Milestones, m)
+	}
+	if m.ID != 0 {
+		return &m.ID, nil
+	}
+	return nil, m
+}
+
+// role returns the project role matching a template role demand, recording it as unmatched when there is none
+func (b *wbsBuilder) role(demand *entities.WBSTemplateRole) *entities.ProjectRole {
+	for _, role := range b.roles {
+		if strings.EqualFold(role.Name, demand.Role) && (demand.Level == entities.RoleLevelUnknown || role.Level == demand.Level) {
+			return role
+		}
+	}
+	name := demand.Role
+	if demand.Level != entities.RoleLevelUnknown {
+		name = fmt.Sprintf("%s (%s)", demand.Role, entities.RoleLevelName(demand.Level))
+	}
+	if !b.unmatched[name] {
+		b.unmatched[name] = true
+		b.instance.UnmatchedRoles = append(b.instance.UnmatchedRoles, name)
+	}
+	return nil
+}
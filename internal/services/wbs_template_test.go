@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ducminhgd/plan-craft/internal/entities"
+	"github.com/ducminhgd/plan-craft/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWBSTemplateService(t *testing.T) {
+	db := setupServiceTestDB(t)
+	service := NewWBSTemplateService(
+		repositories.NewWBSTemplateRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewTaskRepository(db),
+		repositories.NewMilestoneRepository(db),
+		repositories.NewProjectRoleRepository(db),
+	)
+	ctx := context.Background()
+
+	template, err := service.CreateWBSTemplate(ctx, &entities.WBSTemplate{
+		Name: "Mobile release",
+		Tasks: entities.WBSTemplateTasks{
+			{Name: "Build", Children: []*entities.WBSTemplateTask{
+				{Name: "Feature work", Effort: 60, Priority: entities.TaskPriorityHigh, Roles: []*entities.WBSTemplateRole{
+					{Role: "Mobile", Level: entities.RoleLevelSenior, Effort: 40},
+					{Role: "mobile", Level: entities.RoleLevelSenior, Effort: 20},
+				}},
+				{Name: "QA pass", Effort: 20, Roles: []*entities.WBSTemplateRole{{Role: "QA", Effort: 20}}},
+			}},
+			{Name: "Store submission", Effort: 20, Milestone: "Launch", Roles: []*entities.WBSTemplateRole{{Role: "Release manager", Level: entities.RoleLevelLead, Effort: 4}}},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	project := createTestProjectForService(t, db, "App")
+	epic := createTestTaskForService(t, db, project.ID, "Epic", nil)
+	beta := createTestMilestoneForService(t, db, project.ID, "Beta", nil)
+	mobile := &entities.ProjectRole{ProjectID: project.ID, Name: "Mobile", Level: entities.RoleLevelSenior, Headcount: 1}
+	qa := &entities.ProjectRole{ProjectID: project.ID, Name: "QA", Level: entities.RoleLevelMid, Headcount: 1}
+	for _, role := range []*entities.ProjectRole{mobile, qa} {
+		assert.NoError(t, db.Create(role).Error)
+	}
+
+	t.Run("Instantiate under a parent and milestone", func(t *testing.T) {
+		instance, err := service.InstantiateWBSTemplate(ctx, &entities.WBSTemplateInstantiation{
+			TemplateID:  template.ID,
+			ProjectID:   project.ID,
+			MilestoneID: &beta.ID,
+			ParentID:    &epic.ID,
+			TotalEffort: 200,
+		})
+		if !assert.NoError(t, err) || !assert.Len(t, instance.Tasks, 4) {
+			return
+		}
+
+		build, feature, qaPass, store := instance.Tasks[0], instance.Tasks[1], instance.Tasks[2], instance.Tasks[3]
+		assert.Equal(t, epic.ID, *build.ParentID)
+		assert.Equal(t, 2, build.Level)
+		assert.Equal(t, build.ID, *feature.ParentID)
+		assert.Equal(t, 3, feature.Level)
+		assert.Equal(t, 120.0, feature.EstimatedEffort)
+		assert.Equal(t, uint(entities.TaskPriorityHigh), feature.Priority)
+		assert.Equal(t, uint(entities.TaskPriorityMedium), qaPass.Priority)
+		assert.Equal(t, beta.ID, *feature.MilestoneID)
+
+		// The launch group gets a new milestone
+		if assert.Len(t, instance.Milestones, 1) {
+			assert.Equal(t, "Launch", instance.Milestones[0].Name)
+			assert.Equal(t, instance.Milestones[0].ID, *store.MilestoneID)
+		}
+
+		// Role demand is merged per role and scaled like the efforts
+		if assert.Len(t, instance.RoleEstimates, 2) {
+			assert.Equal(t, feature.ID, instance.RoleEstimates[0].TaskID)
+			assert.Equal(t, mobile.ID, instance.RoleEstimates[0].ProjectRoleID)
+			assert.Equal(t, 120.0, instance.RoleEstimates[0].Effort)
+			assert.Equal(t, qa.ID, instance.RoleEstimates[1].ProjectRoleID)
+		}
+		assert.Equal(t, []string{"Release manager (Lead)"}, instance.UnmatchedRoles)
+
+		var count int64
+		assert.NoError(t, db.Model(&entities.Task{}).Where("project_id = ?", project.ID).Count(&count).Error)
+		assert.Equal(t, int64(5), count)
+	})
+
+	t.Run("Instantiate again reuses the milestone group", func(t *testing.T) {
+		instance, err := service.InstantiateWBSTemplate(ctx, &entities.WBSTemplateInstantiation{TemplateID: template.ID, ProjectID: project.ID, Scale: 0.5})
+		assert.NoError(t, err)
+		assert.Empty(t, instance.Milestones)
+		assert.Nil(t, instance.Tasks[0].ParentID)
+		assert.Equal(t, 1, instance.Tasks[0].Level)
+		assert.Nil(t, instance.Tasks[1].MilestoneID)
+		assert.Equal(t, 30.0, instance.Tasks[1].EstimatedEffort)
+		assert.NotNil(t, instance.Tasks[3].MilestoneID)
+	})
+
+	t.Run("Parent and milestone must belong to the project", func(t *testing.T) {
+		other := createTestProjectForService(t, db, "Other")
+		_, err := service.InstantiateWBSTemplate(ctx, &entities.WBSTemplateInstantiation{TemplateID: template.ID, ProjectID: other.ID, ParentID: &epic.ID})
+		assert.Equal(t, entities.ErrTaskParentProjectMismatch, err)
+
+		_, err = service.InstantiateWBSTemplate(ctx, &entities.WBSTemplateInstantiation{TemplateID: template.ID, ProjectID: other.ID, MilestoneID: &beta.ID})
+		assert.Equal(t, entities.ErrWBSTemplateMilestoneMismatch, err)
+
+		_, err = service.InstantiateWBSTemplate(ctx, &entities.WBSTemplateInstantiation{ProjectID: other.ID})
+		assert.Equal(t, entities.ErrWBSTemplateInvalidID, err)
+	})
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"embed"
+	_ "time/tzdata" // Embed the IANA time zone database for systems without one
 
 	"github.com/ducminhgd/plan-craft/config"
 	"github.com/ducminhgd/plan-craft/internal"
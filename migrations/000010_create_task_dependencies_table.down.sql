@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_task_dependencies_updated_at;
+DROP INDEX IF EXISTS idx_task_dependencies_created_at;
+DROP INDEX IF EXISTS idx_task_dependencies_type;
+DROP INDEX IF EXISTS idx_task_dependencies_successor_id;
+DROP INDEX IF EXISTS idx_task_dependencies_predecessor_id;
+DROP INDEX IF EXISTS idx_task_dependency_pair;
+
+-- Drop task_dependencies table
+DROP TABLE IF EXISTS task_dependencies;
@@ -0,0 +1,31 @@
+-- Create task_dependencies table
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    predecessor_id INTEGER NOT NULL,
+    successor_id INTEGER NOT NULL,
+    type TEXT NOT NULL DEFAULT 'finish_to_start',
+    lag_hours REAL NOT NULL DEFAULT 0,
+    allow_cross_project INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (predecessor_id <> successor_id),
+    CHECK (type IN ('finish_to_start', 'start_to_start', 'finish_to_finish', 'start_to_finish')),
+    CHECK (allow_cross_project IN (0, 1)),
+
+    -- Foreign key constraints
+    FOREIGN KEY (predecessor_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (successor_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+-- Create unique index on predecessor_id and successor_id
+-- Two tasks can only be linked once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_dependency_pair ON task_dependencies(predecessor_id, successor_id);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_predecessor_id ON task_dependencies(predecessor_id);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_successor_id ON task_dependencies(successor_id);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_type ON task_dependencies(type);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_created_at ON task_dependencies(created_at);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_updated_at ON task_dependencies(updated_at);
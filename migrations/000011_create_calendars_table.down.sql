@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_calendars_updated_at;
+DROP INDEX IF EXISTS idx_calendars_created_at;
+DROP INDEX IF EXISTS idx_calendars_name;
+
+-- Drop calendars table
+DROP TABLE IF EXISTS calendars;
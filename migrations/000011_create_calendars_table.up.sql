@@ -0,0 +1,20 @@
+-- Create calendars table
+CREATE TABLE IF NOT EXISTS calendars (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    description TEXT,
+    working_days TEXT NOT NULL DEFAULT '[1,2,3,4,5]',
+    hours_per_day REAL NOT NULL DEFAULT 8,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (hours_per_day > 0 AND hours_per_day <= 24)
+);
+
+-- Create unique index on name
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendars_name ON calendars(name);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_calendars_created_at ON calendars(created_at);
+CREATE INDEX IF NOT EXISTS idx_calendars_updated_at ON calendars(updated_at);
@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_calendar_exceptions_updated_at;
+DROP INDEX IF EXISTS idx_calendar_exceptions_created_at;
+DROP INDEX IF EXISTS idx_calendar_exceptions_end_date;
+DROP INDEX IF EXISTS idx_calendar_exceptions_start_date;
+DROP INDEX IF EXISTS idx_calendar_exceptions_type;
+DROP INDEX IF EXISTS idx_calendar_exceptions_calendar_id;
+
+-- Drop calendar_exceptions table
+DROP TABLE IF EXISTS calendar_exceptions;
@@ -0,0 +1,28 @@
+-- Create calendar_exceptions table
+CREATE TABLE IF NOT EXISTS calendar_exceptions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    calendar_id INTEGER NOT NULL,
+    name TEXT,
+    type TEXT NOT NULL DEFAULT 'holiday',
+    start_date INTEGER NOT NULL,
+    end_date INTEGER NOT NULL,
+    hours REAL NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (type IN ('holiday', 'non_working', 'custom_hours')),
+    CHECK (end_date >= start_date),
+    CHECK (hours >= 0 AND hours <= 24),
+
+    -- Foreign key constraints
+    FOREIGN KEY (calendar_id) REFERENCES calendars(id) ON DELETE CASCADE
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_calendar_exceptions_calendar_id ON calendar_exceptions(calendar_id);
+CREATE INDEX IF NOT EXISTS idx_calendar_exceptions_type ON calendar_exceptions(type);
+CREATE INDEX IF NOT EXISTS idx_calendar_exceptions_start_date ON calendar_exceptions(start_date);
+CREATE INDEX IF NOT EXISTS idx_calendar_exceptions_end_date ON calendar_exceptions(end_date);
+CREATE INDEX IF NOT EXISTS idx_calendar_exceptions_created_at ON calendar_exceptions(created_at);
+CREATE INDEX IF NOT EXISTS idx_calendar_exceptions_updated_at ON calendar_exceptions(updated_at);
@@ -0,0 +1,45 @@
+-- Drop calendar_id index
+DROP INDEX IF EXISTS idx_projects_calendar_id;
+
+-- Remove calendar_id column from projects table
+-- Note: SQLite cannot drop a column that is part of a foreign key, so we need to recreate the table
+CREATE TABLE projects_backup (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    description TEXT,
+    client_id INTEGER NOT NULL,
+    start_date INTEGER,
+    end_date INTEGER,
+    status INTEGER NOT NULL DEFAULT 2,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    hours_per_day INTEGER NOT NULL DEFAULT 8,
+    days_per_week INTEGER NOT NULL DEFAULT 5,
+    working_days_per_week TEXT NOT NULL DEFAULT '[1,2,3,4,5]',
+    timezone TEXT NOT NULL DEFAULT '',
+    currency TEXT NOT NULL DEFAULT '',
+
+    CHECK (status IN (1, 2)),
+
+    FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE RESTRICT
+);
+
+-- Copy data to backup table
+INSERT INTO projects_backup (id, name, description, client_id, start_date, end_date, status, created_at, updated_at, hours_per_day, days_per_week, working_days_per_week, timezone, currency)
+SELECT id, name, description, client_id, start_date, end_date, status, created_at, updated_at, hours_per_day, days_per_week, working_days_per_week, timezone, currency
+FROM projects;
+
+-- Drop the original table
+DROP TABLE projects;
+
+-- Rename backup to original
+ALTER TABLE projects_backup RENAME TO projects;
+
+-- Recreate indexes
+CREATE INDEX IF NOT EXISTS idx_projects_name ON projects(name);
+CREATE INDEX IF NOT EXISTS idx_projects_client_id ON projects(client_id);
+CREATE INDEX IF NOT EXISTS idx_projects_status ON projects(status);
+CREATE INDEX IF NOT EXISTS idx_projects_start_date ON projects(start_date);
+CREATE INDEX IF NOT EXISTS idx_projects_end_date ON projects(end_date);
+CREATE INDEX IF NOT EXISTS idx_projects_created_at ON projects(created_at);
+CREATE INDEX IF NOT EXISTS idx_projects_updated_at ON projects(updated_at);
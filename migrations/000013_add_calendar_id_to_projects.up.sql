@@ -0,0 +1,6 @@
+-- Add calendar_id column to projects table
+-- NULL means the project uses its own working days and hours per day
+ALTER TABLE projects ADD COLUMN calendar_id INTEGER REFERENCES calendars(id) ON DELETE SET NULL;
+
+-- Create index for calendar_id column
+CREATE INDEX IF NOT EXISTS idx_projects_calendar_id ON projects(calendar_id);
@@ -0,0 +1,3 @@
+-- Remove leveling_delay column from tasks table
+-- Note: DROP COLUMN requires SQLite 3.35 or later; the column has no index or foreign key
+ALTER TABLE tasks DROP COLUMN leveling_delay;
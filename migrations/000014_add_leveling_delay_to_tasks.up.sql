@@ -0,0 +1,3 @@
+-- Add leveling_delay column to tasks table
+-- Working hours by which resource leveling pushes the task back; 0 means not leveled
+ALTER TABLE tasks ADD COLUMN leveling_delay REAL NOT NULL DEFAULT 0 CHECK (leveling_delay >= 0);
@@ -0,0 +1,12 @@
+-- Drop scheduling indexes
+DROP INDEX IF EXISTS idx_tasks_planned_start;
+DROP INDEX IF EXISTS idx_tasks_planned_finish;
+DROP INDEX IF EXISTS idx_tasks_constraint_date;
+
+-- Remove scheduling columns from tasks table
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE tasks DROP COLUMN constraint_date;
+ALTER TABLE tasks DROP COLUMN constraint_type;
+ALTER TABLE tasks DROP COLUMN duration;
+ALTER TABLE tasks DROP COLUMN planned_finish;
+ALTER TABLE tasks DROP COLUMN planned_start;
@@ -0,0 +1,14 @@
+-- Add scheduling columns to tasks table
+-- duration is in working days; 0 means the duration follows from estimated_effort
+-- constraint_type: 1 = as soon as possible, 2 = as late as possible, 3 = must start on,
+-- 4 = start no earlier than, 5 = finish no later than
+ALTER TABLE tasks ADD COLUMN planned_start INTEGER;
+ALTER TABLE tasks ADD COLUMN planned_finish INTEGER;
+ALTER TABLE tasks ADD COLUMN duration REAL NOT NULL DEFAULT 0 CHECK (duration >= 0);
+ALTER TABLE tasks ADD COLUMN constraint_type INTEGER NOT NULL DEFAULT 1 CHECK (constraint_type IN (1, 2, 3, 4, 5));
+ALTER TABLE tasks ADD COLUMN constraint_date INTEGER;
+
+-- Create indexes for date filters
+CREATE INDEX IF NOT EXISTS idx_tasks_planned_start ON tasks(planned_start);
+CREATE INDEX IF NOT EXISTS idx_tasks_planned_finish ON tasks(planned_finish);
+CREATE INDEX IF NOT EXISTS idx_tasks_constraint_date ON tasks(constraint_date);
@@ -0,0 +1,3 @@
+-- Remove percent_complete column from tasks table
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE tasks DROP COLUMN percent_complete;
@@ -0,0 +1,2 @@
+-- Add percent_complete column to tasks table
+ALTER TABLE tasks ADD COLUMN percent_complete REAL NOT NULL DEFAULT 0 CHECK (percent_complete >= 0 AND percent_complete <= 100);
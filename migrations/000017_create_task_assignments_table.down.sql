@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_task_assignments_updated_at;
+DROP INDEX IF EXISTS idx_task_assignments_created_at;
+DROP INDEX IF EXISTS idx_task_assignments_human_resource_id;
+DROP INDEX IF EXISTS idx_task_assignments_project_resource_id;
+DROP INDEX IF EXISTS idx_task_assignments_task_id;
+DROP INDEX IF EXISTS idx_task_assignment_task_human_resource;
+
+-- Drop task_assignments table
+DROP TABLE IF EXISTS task_assignments;
@@ -0,0 +1,32 @@
+-- Create task_assignments table
+CREATE TABLE IF NOT EXISTS task_assignments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id INTEGER NOT NULL,
+    project_resource_id INTEGER NOT NULL,
+    human_resource_id INTEGER NOT NULL,
+    planned_hours REAL NOT NULL DEFAULT 0,
+    units REAL NOT NULL DEFAULT 100,
+    notes TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (planned_hours >= 0),
+    CHECK (units > 0 AND units <= 100),
+
+    -- Foreign key constraints
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (project_resource_id) REFERENCES project_resources(id) ON DELETE CASCADE,
+    FOREIGN KEY (human_resource_id) REFERENCES human_resources(id)
+);
+
+-- Create unique index on task_id and human_resource_id
+-- A human resource can only be assigned to a task once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_assignment_task_human_resource ON task_assignments(task_id, human_resource_id);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_task_assignments_task_id ON task_assignments(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_assignments_project_resource_id ON task_assignments(project_resource_id);
+CREATE INDEX IF NOT EXISTS idx_task_assignments_human_resource_id ON task_assignments(human_resource_id);
+CREATE INDEX IF NOT EXISTS idx_task_assignments_created_at ON task_assignments(created_at);
+CREATE INDEX IF NOT EXISTS idx_task_assignments_updated_at ON task_assignments(updated_at);
@@ -0,0 +1,32 @@
+-- Drop scenario_milestones table
+DROP INDEX IF EXISTS idx_scenario_milestones_milestone_id;
+DROP INDEX IF EXISTS idx_scenario_milestones_scenario_id;
+DROP INDEX IF EXISTS idx_scenario_milestone;
+DROP TABLE IF EXISTS scenario_milestones;
+
+-- Drop scenario_assignments table
+DROP INDEX IF EXISTS idx_scenario_assignments_human_resource_id;
+DROP INDEX IF EXISTS idx_scenario_assignments_task_id;
+DROP INDEX IF EXISTS idx_scenario_assignments_task_assignment_id;
+DROP INDEX IF EXISTS idx_scenario_assignments_scenario_id;
+DROP INDEX IF EXISTS idx_scenario_assignment;
+DROP TABLE IF EXISTS scenario_assignments;
+
+-- Drop scenario_resources table
+DROP INDEX IF EXISTS idx_scenario_resources_human_resource_id;
+DROP INDEX IF EXISTS idx_scenario_resources_project_resource_id;
+DROP INDEX IF EXISTS idx_scenario_resources_scenario_id;
+DROP INDEX IF EXISTS idx_scenario_resource;
+DROP TABLE IF EXISTS scenario_resources;
+
+-- Drop scenario_tasks table
+DROP INDEX IF EXISTS idx_scenario_tasks_task_id;
+DROP INDEX IF EXISTS idx_scenario_tasks_scenario_id;
+DROP INDEX IF EXISTS idx_scenario_task;
+DROP TABLE IF EXISTS scenario_tasks;
+
+-- Drop scenarios table
+DROP INDEX IF EXISTS idx_scenarios_status;
+DROP INDEX IF EXISTS idx_scenarios_project_id;
+DROP INDEX IF EXISTS idx_scenario_project_name;
+DROP TABLE IF EXISTS scenarios;
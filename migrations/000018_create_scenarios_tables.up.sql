@@ -0,0 +1,125 @@
+-- Create scenarios table
+CREATE TABLE IF NOT EXISTS scenarios (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT,
+    status INTEGER NOT NULL DEFAULT 1,
+    promoted_at INTEGER,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (status IN (1, 2)),
+
+    -- Foreign key constraints
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- A project cannot have two scenarios with the same name
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scenario_project_name ON scenarios(project_id, name);
+CREATE INDEX IF NOT EXISTS idx_scenarios_project_id ON scenarios(project_id);
+CREATE INDEX IF NOT EXISTS idx_scenarios_status ON scenarios(status);
+
+-- Create scenario_tasks table
+CREATE TABLE IF NOT EXISTS scenario_tasks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scenario_id INTEGER NOT NULL,
+    task_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    milestone_id INTEGER,
+    estimated_effort REAL NOT NULL DEFAULT 0,
+    duration REAL NOT NULL DEFAULT 0,
+    constraint_type INTEGER NOT NULL DEFAULT 1,
+    constraint_date INTEGER,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (estimated_effort >= 0),
+    CHECK (duration >= 0),
+    CHECK (constraint_type IN (1, 2, 3, 4, 5)),
+
+    -- Foreign key constraints
+    FOREIGN KEY (scenario_id) REFERENCES scenarios(id) ON DELETE CASCADE,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scenario_task ON scenario_tasks(scenario_id, task_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_tasks_scenario_id ON scenario_tasks(scenario_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_tasks_task_id ON scenario_tasks(task_id);
+
+-- Create scenario_resources table
+CREATE TABLE IF NOT EXISTS scenario_resources (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scenario_id INTEGER NOT NULL,
+    project_resource_id INTEGER NOT NULL,
+    human_resource_id INTEGER NOT NULL,
+    allocation REAL NOT NULL DEFAULT 100,
+    cost REAL NOT NULL DEFAULT 0,
+    headcount INTEGER NOT NULL DEFAULT 1,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (allocation >= 0 AND allocation <= 100),
+    CHECK (cost >= 0),
+    CHECK (headcount >= 0),
+
+    -- Foreign key constraints
+    FOREIGN KEY (scenario_id) REFERENCES scenarios(id) ON DELETE CASCADE,
+    FOREIGN KEY (project_resource_id) REFERENCES project_resources(id) ON DELETE CASCADE,
+    FOREIGN KEY (human_resource_id) REFERENCES human_resources(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scenario_resource ON scenario_resources(scenario_id, project_resource_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_resources_scenario_id ON scenario_resources(scenario_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_resources_project_resource_id ON scenario_resources(project_resource_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_resources_human_resource_id ON scenario_resources(human_resource_id);
+
+-- Create scenario_assignments table
+CREATE TABLE IF NOT EXISTS scenario_assignments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scenario_id INTEGER NOT NULL,
+    task_assignment_id INTEGER NOT NULL,
+    task_id INTEGER NOT NULL,
+    human_resource_id INTEGER NOT NULL,
+    planned_hours REAL NOT NULL DEFAULT 0,
+    units REAL NOT NULL DEFAULT 100,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (planned_hours >= 0),
+    CHECK (units > 0 AND units <= 100),
+
+    -- Foreign key constraints
+    FOREIGN KEY (scenario_id) REFERENCES scenarios(id) ON DELETE CASCADE,
+    FOREIGN KEY (task_assignment_id) REFERENCES task_assignments(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scenario_assignment ON scenario_assignments(scenario_id, task_assignment_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_assignments_scenario_id ON scenario_assignments(scenario_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_assignments_task_assignment_id ON scenario_assignments(task_assignment_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_assignments_task_id ON scenario_assignments(task_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_assignments_human_resource_id ON scenario_assignments(human_resource_id);
+
+-- Create scenario_milestones table
+CREATE TABLE IF NOT EXISTS scenario_milestones (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scenario_id INTEGER NOT NULL,
+    milestone_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    start_date INTEGER,
+    end_date INTEGER,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Foreign key constraints
+    FOREIGN KEY (scenario_id) REFERENCES scenarios(id) ON DELETE CASCADE,
+    FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scenario_milestone ON scenario_milestones(scenario_id, milestone_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_milestones_scenario_id ON scenario_milestones(scenario_id);
+CREATE INDEX IF NOT EXISTS idx_scenario_milestones_milestone_id ON scenario_milestones(milestone_id);
@@ -0,0 +1,6 @@
+-- Remove three-point estimate columns from tasks table
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE tasks DROP COLUMN effort_distribution;
+ALTER TABLE tasks DROP COLUMN pessimistic_effort;
+ALTER TABLE tasks DROP COLUMN most_likely_effort;
+ALTER TABLE tasks DROP COLUMN optimistic_effort;
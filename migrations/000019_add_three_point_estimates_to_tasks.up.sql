@@ -0,0 +1,7 @@
+-- Add three-point estimate columns to tasks table
+-- Efforts are in hours; pessimistic_effort = 0 means the task has no three-point estimate
+-- effort_distribution: 1 = PERT, 2 = triangular
+ALTER TABLE tasks ADD COLUMN optimistic_effort REAL NOT NULL DEFAULT 0 CHECK (optimistic_effort >= 0);
+ALTER TABLE tasks ADD COLUMN most_likely_effort REAL NOT NULL DEFAULT 0 CHECK (most_likely_effort >= 0);
+ALTER TABLE tasks ADD COLUMN pessimistic_effort REAL NOT NULL DEFAULT 0 CHECK (pessimistic_effort >= 0);
+ALTER TABLE tasks ADD COLUMN effort_distribution INTEGER NOT NULL DEFAULT 1 CHECK (effort_distribution IN (1, 2));
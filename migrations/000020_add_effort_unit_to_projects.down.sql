@@ -0,0 +1,3 @@
+-- Remove effort display unit from projects table
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE projects DROP COLUMN effort_unit;
@@ -0,0 +1,3 @@
+-- Add effort display unit to projects table
+-- Effort is stored in hours; effort_unit only changes how it is displayed
+ALTER TABLE projects ADD COLUMN effort_unit TEXT NOT NULL DEFAULT 'hours' CHECK (effort_unit IN ('hours', 'days', 'man_weeks', 'man_months'));
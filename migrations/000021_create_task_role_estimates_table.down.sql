@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_task_role_estimates_updated_at;
+DROP INDEX IF EXISTS idx_task_role_estimates_created_at;
+DROP INDEX IF EXISTS idx_task_role_estimates_project_role_id;
+DROP INDEX IF EXISTS idx_task_role_estimates_task_id;
+DROP INDEX IF EXISTS idx_task_role_estimate_task_role;
+
+-- Drop task_role_estimates table
+DROP TABLE IF EXISTS task_role_estimates;
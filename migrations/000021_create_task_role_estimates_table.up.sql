@@ -0,0 +1,28 @@
+-- Create task_role_estimates table
+-- The effort in hours a task needs from one role of its project
+CREATE TABLE IF NOT EXISTS task_role_estimates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id INTEGER NOT NULL,
+    project_role_id INTEGER NOT NULL,
+    effort REAL NOT NULL DEFAULT 0,
+    notes TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (effort >= 0),
+
+    -- Foreign key constraints
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (project_role_id) REFERENCES project_roles(id) ON DELETE CASCADE
+);
+
+-- Create unique index on task_id and project_role_id
+-- A task is estimated once per role
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_role_estimate_task_role ON task_role_estimates(task_id, project_role_id);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_task_role_estimates_task_id ON task_role_estimates(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_role_estimates_project_role_id ON task_role_estimates(project_role_id);
+CREATE INDEX IF NOT EXISTS idx_task_role_estimates_created_at ON task_role_estimates(created_at);
+CREATE INDEX IF NOT EXISTS idx_task_role_estimates_updated_at ON task_role_estimates(updated_at);
@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_time_entries_updated_at;
+DROP INDEX IF EXISTS idx_time_entries_created_at;
+DROP INDEX IF EXISTS idx_time_entries_date;
+DROP INDEX IF EXISTS idx_time_entries_human_resource_id;
+DROP INDEX IF EXISTS idx_time_entries_task_id;
+
+-- Drop time_entries table
+DROP TABLE IF EXISTS time_entries;
@@ -0,0 +1,28 @@
+-- Create time_entries table
+-- The hours a human resource actually worked on a task on one day
+CREATE TABLE IF NOT EXISTS time_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id INTEGER NOT NULL,
+    human_resource_id INTEGER NOT NULL,
+    date INTEGER NOT NULL,
+    hours REAL NOT NULL,
+    billable INTEGER NOT NULL DEFAULT 0,
+    notes TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (hours > 0 AND hours <= 24),
+    CHECK (billable IN (0, 1)),
+
+    -- Foreign key constraints
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (human_resource_id) REFERENCES human_resources(id)
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_time_entries_task_id ON time_entries(task_id);
+CREATE INDEX IF NOT EXISTS idx_time_entries_human_resource_id ON time_entries(human_resource_id);
+CREATE INDEX IF NOT EXISTS idx_time_entries_date ON time_entries(date);
+CREATE INDEX IF NOT EXISTS idx_time_entries_created_at ON time_entries(created_at);
+CREATE INDEX IF NOT EXISTS idx_time_entries_updated_at ON time_entries(updated_at);
@@ -0,0 +1,5 @@
+-- Remove project type from projects table
+DROP INDEX IF EXISTS idx_projects_type;
+
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE projects DROP COLUMN type;
@@ -0,0 +1,6 @@
+-- Add project type to projects table
+-- Kind of project (product, service, internal, consulting, research or maintenance),
+-- used to group the accuracy of past estimates
+ALTER TABLE projects ADD COLUMN type TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_projects_type ON projects(type);
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_wbs_templates_updated_at;
+DROP INDEX IF EXISTS idx_wbs_templates_created_at;
+DROP INDEX IF EXISTS idx_wbs_templates_name;
+
+-- Drop wbs_templates table
+DROP TABLE IF EXISTS wbs_templates;
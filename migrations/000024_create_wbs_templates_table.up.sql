@@ -0,0 +1,17 @@
+-- Create wbs_templates table
+-- A reusable task hierarchy; tasks holds the tree with efforts, priorities, role demand and milestone groups as JSON
+CREATE TABLE IF NOT EXISTS wbs_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    description TEXT,
+    tasks TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+-- Create unique index on name
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wbs_templates_name ON wbs_templates(name);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_wbs_templates_created_at ON wbs_templates(created_at);
+CREATE INDEX IF NOT EXISTS idx_wbs_templates_updated_at ON wbs_templates(updated_at);
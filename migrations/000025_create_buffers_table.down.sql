@@ -0,0 +1,8 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_buffers_updated_at;
+DROP INDEX IF EXISTS idx_buffers_created_at;
+DROP INDEX IF EXISTS idx_buffers_milestone_id;
+DROP INDEX IF EXISTS idx_buffers_project_id;
+
+-- Drop buffers table
+DROP TABLE IF EXISTS buffers;
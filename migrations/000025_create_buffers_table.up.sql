@@ -0,0 +1,28 @@
+-- Create buffers table
+-- Contingency and management reserve held on top of the base estimate of a project or milestone
+CREATE TABLE IF NOT EXISTS buffers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL,
+    milestone_id INTEGER,
+    kind TEXT NOT NULL,
+    basis TEXT NOT NULL,
+    value REAL NOT NULL DEFAULT 0,
+    notes TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (kind IN ('contingency', 'management_reserve')),
+    CHECK (basis IN ('percent', 'hours', 'money')),
+    CHECK (value >= 0),
+
+    -- Foreign key constraints
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE CASCADE
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_buffers_project_id ON buffers(project_id);
+CREATE INDEX IF NOT EXISTS idx_buffers_milestone_id ON buffers(milestone_id);
+CREATE INDEX IF NOT EXISTS idx_buffers_created_at ON buffers(created_at);
+CREATE INDEX IF NOT EXISTS idx_buffers_updated_at ON buffers(updated_at);
@@ -0,0 +1,5 @@
+-- Add methodology to projects table
+-- Waterfall, agile or hybrid; agile and hybrid projects plan their work in sprints
+ALTER TABLE projects ADD COLUMN methodology TEXT NOT NULL DEFAULT 'waterfall' CHECK (methodology IN ('waterfall', 'agile', 'hybrid'));
+
+CREATE INDEX IF NOT EXISTS idx_projects_methodology ON projects(methodology);
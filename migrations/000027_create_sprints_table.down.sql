@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_sprints_updated_at;
+DROP INDEX IF EXISTS idx_sprints_created_at;
+DROP INDEX IF EXISTS idx_sprints_status;
+DROP INDEX IF EXISTS idx_sprints_start_date;
+DROP INDEX IF EXISTS idx_sprints_project_id;
+
+-- Drop sprints table
+DROP TABLE IF EXISTS sprints;
@@ -0,0 +1,29 @@
+-- Create sprints table
+-- Time-boxed iterations of agile and hybrid projects
+CREATE TABLE IF NOT EXISTS sprints (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    goal TEXT,
+    project_id INTEGER NOT NULL,
+    start_date INTEGER NOT NULL,
+    end_date INTEGER NOT NULL,
+    capacity REAL NOT NULL DEFAULT 0,
+    status INTEGER NOT NULL DEFAULT 1,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (end_date >= start_date),
+    CHECK (capacity >= 0),
+    CHECK (status IN (1, 2, 3)),
+
+    -- Foreign key constraints
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_sprints_project_id ON sprints(project_id);
+CREATE INDEX IF NOT EXISTS idx_sprints_start_date ON sprints(start_date);
+CREATE INDEX IF NOT EXISTS idx_sprints_status ON sprints(status);
+CREATE INDEX IF NOT EXISTS idx_sprints_created_at ON sprints(created_at);
+CREATE INDEX IF NOT EXISTS idx_sprints_updated_at ON sprints(updated_at);
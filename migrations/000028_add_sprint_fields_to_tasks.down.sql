@@ -0,0 +1,6 @@
+-- Remove story points and sprint assignment from tasks table
+DROP INDEX IF EXISTS idx_tasks_sprint_id;
+
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE tasks DROP COLUMN sprint_id;
+ALTER TABLE tasks DROP COLUMN story_points;
@@ -0,0 +1,6 @@
+-- Add story points and sprint assignment to tasks table
+-- Tasks without a sprint are in the project backlog
+ALTER TABLE tasks ADD COLUMN story_points REAL NOT NULL DEFAULT 0 CHECK (story_points >= 0);
+ALTER TABLE tasks ADD COLUMN sprint_id INTEGER REFERENCES sprints(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_sprint_id ON tasks(sprint_id);
@@ -0,0 +1,7 @@
+-- Remove T-shirt size, function points and effort override from tasks table
+DROP INDEX IF EXISTS idx_tasks_size;
+
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE tasks DROP COLUMN size;
+ALTER TABLE tasks DROP COLUMN function_points;
+ALTER TABLE tasks DROP COLUMN effort_override;
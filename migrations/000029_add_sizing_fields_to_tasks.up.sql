@@ -0,0 +1,7 @@
+-- Add T-shirt size, function points and effort override to tasks table
+-- The effort of a sized task follows the project's sizing table unless it is overridden
+ALTER TABLE tasks ADD COLUMN size TEXT NOT NULL DEFAULT '' CHECK (size IN ('', 'xs', 's', 'm', 'l', 'xl', 'xxl'));
+ALTER TABLE tasks ADD COLUMN function_points REAL NOT NULL DEFAULT 0 CHECK (function_points >= 0);
+ALTER TABLE tasks ADD COLUMN effort_override INTEGER NOT NULL DEFAULT 0 CHECK (effort_override IN (0, 1));
+
+CREATE INDEX IF NOT EXISTS idx_tasks_size ON tasks(size);
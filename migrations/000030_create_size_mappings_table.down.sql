@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_size_mappings_updated_at;
+DROP INDEX IF EXISTS idx_size_mappings_created_at;
+DROP INDEX IF EXISTS idx_size_mappings_project_role_id;
+DROP INDEX IF EXISTS idx_size_mappings_project_id;
+DROP INDEX IF EXISTS idx_size_mapping_role_unit;
+
+-- Drop size_mappings table
+DROP TABLE IF EXISTS size_mappings;
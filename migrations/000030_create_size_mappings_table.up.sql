@@ -0,0 +1,28 @@
+-- Create size_mappings table
+-- Each project's sizing table: hours per role for each T-shirt size, or per function point
+CREATE TABLE IF NOT EXISTS size_mappings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL,
+    project_role_id INTEGER NOT NULL,
+    unit TEXT NOT NULL,
+    hours REAL NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (unit IN ('xs', 's', 'm', 'l', 'xl', 'xxl', 'function_point')),
+    CHECK (hours >= 0),
+
+    -- Foreign key constraints
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (project_role_id) REFERENCES project_roles(id) ON DELETE CASCADE
+);
+
+-- A role has one rate per size unit
+CREATE UNIQUE INDEX IF NOT EXISTS idx_size_mapping_role_unit ON size_mappings(project_role_id, unit);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_size_mappings_project_id ON size_mappings(project_id);
+CREATE INDEX IF NOT EXISTS idx_size_mappings_project_role_id ON size_mappings(project_role_id);
+CREATE INDEX IF NOT EXISTS idx_size_mappings_created_at ON size_mappings(created_at);
+CREATE INDEX IF NOT EXISTS idx_size_mappings_updated_at ON size_mappings(updated_at);
@@ -0,0 +1,11 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_rate_cards_updated_at;
+DROP INDEX IF EXISTS idx_rate_cards_created_at;
+DROP INDEX IF EXISTS idx_rate_cards_effective_to;
+DROP INDEX IF EXISTS idx_rate_cards_effective_from;
+DROP INDEX IF EXISTS idx_rate_cards_role_name;
+DROP INDEX IF EXISTS idx_rate_cards_human_resource_id;
+DROP INDEX IF EXISTS idx_rate_cards_project_id;
+
+-- Drop rate_cards table
+DROP TABLE IF EXISTS rate_cards;
@@ -0,0 +1,40 @@
+-- Create rate_cards table
+-- Effective-dated cost and bill rates of a person or of a role at a level,
+-- standard for all projects or specific to one
+CREATE TABLE IF NOT EXISTS rate_cards (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER,
+    human_resource_id INTEGER,
+    role_name TEXT NOT NULL DEFAULT '',
+    role_level INTEGER NOT NULL DEFAULT 0,
+    rate_type TEXT NOT NULL DEFAULT 'hourly',
+    cost_rate REAL NOT NULL DEFAULT 0,
+    bill_rate REAL NOT NULL DEFAULT 0,
+    currency TEXT NOT NULL DEFAULT '',
+    effective_from INTEGER NOT NULL,
+    effective_to INTEGER,
+    notes TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK ((human_resource_id IS NOT NULL AND role_name = '' AND role_level = 0) OR
+           (human_resource_id IS NULL AND role_name != '' AND role_level BETWEEN 1 AND 8)),
+    CHECK (rate_type IN ('hourly', 'daily', 'monthly', 'fixed')),
+    CHECK (cost_rate >= 0),
+    CHECK (bill_rate >= 0),
+    CHECK (effective_to IS NULL OR effective_to >= effective_from),
+
+    -- Foreign key constraints
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (human_resource_id) REFERENCES human_resources(id) ON DELETE CASCADE
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_rate_cards_project_id ON rate_cards(project_id);
+CREATE INDEX IF NOT EXISTS idx_rate_cards_human_resource_id ON rate_cards(human_resource_id);
+CREATE INDEX IF NOT EXISTS idx_rate_cards_role_name ON rate_cards(role_name);
+CREATE INDEX IF NOT EXISTS idx_rate_cards_effective_from ON rate_cards(effective_from);
+CREATE INDEX IF NOT EXISTS idx_rate_cards_effective_to ON rate_cards(effective_to);
+CREATE INDEX IF NOT EXISTS idx_rate_cards_created_at ON rate_cards(created_at);
+CREATE INDEX IF NOT EXISTS idx_rate_cards_updated_at ON rate_cards(updated_at);
@@ -0,0 +1,3 @@
+-- Remove cost override flag from project_resources table
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE project_resources DROP COLUMN cost_override;
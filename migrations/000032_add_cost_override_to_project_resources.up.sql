@@ -0,0 +1,6 @@
+-- Add cost override flag to project_resources table
+-- The cost of an allocation is calculated from its rate cards unless it is entered by hand
+ALTER TABLE project_resources ADD COLUMN cost_override INTEGER NOT NULL DEFAULT 0 CHECK (cost_override IN (0, 1));
+
+-- Costs typed in before they were calculated are kept as overrides
+UPDATE project_resources SET cost_override = 1 WHERE cost > 0;
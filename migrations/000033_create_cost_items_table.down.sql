@@ -0,0 +1,11 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_cost_items_updated_at;
+DROP INDEX IF EXISTS idx_cost_items_created_at;
+DROP INDEX IF EXISTS idx_cost_items_start_date;
+DROP INDEX IF EXISTS idx_cost_items_cost_type;
+DROP INDEX IF EXISTS idx_cost_items_task_id;
+DROP INDEX IF EXISTS idx_cost_items_milestone_id;
+DROP INDEX IF EXISTS idx_cost_items_project_id;
+
+-- Drop cost_items table
+DROP TABLE IF EXISTS cost_items;
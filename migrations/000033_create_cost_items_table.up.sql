@@ -0,0 +1,41 @@
+-- Create cost_items table
+-- Non-labor costs of a project, such as hosting or licences, paid once or every month
+-- and optionally linked to a milestone or a task
+CREATE TABLE IF NOT EXISTS cost_items (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    description TEXT,
+    project_id INTEGER NOT NULL,
+    milestone_id INTEGER,
+    task_id INTEGER,
+    cost_type TEXT NOT NULL DEFAULT 'other',
+    amount REAL NOT NULL DEFAULT 0,
+    quantity REAL NOT NULL DEFAULT 1,
+    frequency TEXT NOT NULL DEFAULT 'one_off',
+    start_date INTEGER NOT NULL,
+    end_date INTEGER,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (cost_type IN ('labor', 'material', 'equipment', 'overhead', 'infrastructure', 'service', 'other')),
+    CHECK (frequency IN ('one_off', 'monthly')),
+    CHECK (amount >= 0),
+    CHECK (quantity > 0),
+    CHECK (milestone_id IS NULL OR task_id IS NULL),
+    CHECK (end_date IS NULL OR (frequency = 'monthly' AND end_date >= start_date)),
+
+    -- Foreign key constraints
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (milestone_id) REFERENCES milestones(id) ON DELETE SET NULL,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_cost_items_project_id ON cost_items(project_id);
+CREATE INDEX IF NOT EXISTS idx_cost_items_milestone_id ON cost_items(milestone_id);
+CREATE INDEX IF NOT EXISTS idx_cost_items_task_id ON cost_items(task_id);
+CREATE INDEX IF NOT EXISTS idx_cost_items_cost_type ON cost_items(cost_type);
+CREATE INDEX IF NOT EXISTS idx_cost_items_start_date ON cost_items(start_date);
+CREATE INDEX IF NOT EXISTS idx_cost_items_created_at ON cost_items(created_at);
+CREATE INDEX IF NOT EXISTS idx_cost_items_updated_at ON cost_items(updated_at);
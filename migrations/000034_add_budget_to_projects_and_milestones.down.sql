@@ -0,0 +1,4 @@
+-- Remove approved budget from projects and milestones tables
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE projects DROP COLUMN budget;
+ALTER TABLE milestones DROP COLUMN budget;
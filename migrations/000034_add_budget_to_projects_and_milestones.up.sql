@@ -0,0 +1,4 @@
+-- Add approved budget to projects and milestones tables
+-- Earned value is measured against the budget; 0 means the baseline cost is the budget
+ALTER TABLE projects ADD COLUMN budget REAL NOT NULL DEFAULT 0 CHECK (budget >= 0);
+ALTER TABLE milestones ADD COLUMN budget REAL NOT NULL DEFAULT 0 CHECK (budget >= 0);
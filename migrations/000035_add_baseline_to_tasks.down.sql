@@ -0,0 +1,7 @@
+-- Remove baseline from tasks table
+-- Note: DROP COLUMN requires SQLite 3.35 or later
+ALTER TABLE tasks DROP COLUMN baseline_start;
+ALTER TABLE tasks DROP COLUMN baseline_finish;
+ALTER TABLE tasks DROP COLUMN baseline_effort;
+ALTER TABLE tasks DROP COLUMN baseline_cost;
+ALTER TABLE tasks DROP COLUMN baselined_at;
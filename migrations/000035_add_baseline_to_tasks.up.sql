@@ -0,0 +1,7 @@
+-- Add baseline to tasks table
+-- The planned dates, effort and cost of a task saved when the project baseline is set
+ALTER TABLE tasks ADD COLUMN baseline_start INTEGER;
+ALTER TABLE tasks ADD COLUMN baseline_finish INTEGER;
+ALTER TABLE tasks ADD COLUMN baseline_effort REAL NOT NULL DEFAULT 0 CHECK (baseline_effort >= 0);
+ALTER TABLE tasks ADD COLUMN baseline_cost REAL NOT NULL DEFAULT 0 CHECK (baseline_cost >= 0);
+ALTER TABLE tasks ADD COLUMN baselined_at INTEGER;
@@ -0,0 +1,8 @@
+-- Store money as decimal amounts of the currency unit again
+UPDATE projects SET budget = budget / 10000.0;
+UPDATE milestones SET budget = budget / 10000.0;
+UPDATE project_resources SET cost = cost / 10000.0;
+UPDATE scenario_resources SET cost = cost / 10000.0;
+UPDATE rate_cards SET cost_rate = cost_rate / 10000.0, bill_rate = bill_rate / 10000.0;
+UPDATE cost_items SET amount = amount / 10000.0;
+UPDATE tasks SET baseline_cost = baseline_cost / 10000.0;
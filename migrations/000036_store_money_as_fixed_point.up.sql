@@ -0,0 +1,9 @@
+-- Store money as exact fixed-point amounts: whole numbers of ten-thousandths of the currency unit
+-- SQLite cannot change a column's declared type; the scaled values are whole numbers and are read back exactly
+UPDATE projects SET budget = CAST(ROUND(budget * 10000) AS INTEGER);
+UPDATE milestones SET budget = CAST(ROUND(budget * 10000) AS INTEGER);
+UPDATE project_resources SET cost = CAST(ROUND(cost * 10000) AS INTEGER);
+UPDATE scenario_resources SET cost = CAST(ROUND(cost * 10000) AS INTEGER);
+UPDATE rate_cards SET cost_rate = CAST(ROUND(cost_rate * 10000) AS INTEGER), bill_rate = CAST(ROUND(bill_rate * 10000) AS INTEGER);
+UPDATE cost_items SET amount = CAST(ROUND(amount * 10000) AS INTEGER);
+UPDATE tasks SET baseline_cost = CAST(ROUND(baseline_cost * 10000) AS INTEGER);
@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_exchange_rates_updated_at;
+DROP INDEX IF EXISTS idx_exchange_rates_created_at;
+DROP INDEX IF EXISTS idx_exchange_rates_effective_from;
+DROP INDEX IF EXISTS idx_exchange_rates_to_currency;
+DROP INDEX IF EXISTS idx_exchange_rates_from_currency;
+
+-- Drop exchange_rates table
+DROP TABLE IF EXISTS exchange_rates;
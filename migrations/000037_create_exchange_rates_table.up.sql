@@ -0,0 +1,24 @@
+-- Create exchange_rates table
+-- Effective-dated rates between two currencies, used to convert project costs into a reporting currency
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    from_currency TEXT NOT NULL,
+    to_currency TEXT NOT NULL,
+    rate REAL NOT NULL,
+    effective_from INTEGER NOT NULL,
+    notes TEXT,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+
+    -- Add CHECK constraints for validation
+    CHECK (length(from_currency) = 3 AND length(to_currency) = 3),
+    CHECK (from_currency != to_currency),
+    CHECK (rate > 0)
+);
+
+-- Create indexes for frequently queried fields
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_from_currency ON exchange_rates(from_currency);
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_to_currency ON exchange_rates(to_currency);
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_effective_from ON exchange_rates(effective_from);
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_created_at ON exchange_rates(created_at);
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_updated_at ON exchange_rates(updated_at);